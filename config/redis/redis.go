@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+)
+
+// Connection returns a redis client when REDIS_ADDR is set, otherwise nil.
+func Connection() redis.UniversalClient {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		return nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: os.Getenv("REDIS_PASSWORD"),
+	})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		logrus.Fatalf("Failed to connect to redis: %v", err)
+	}
+
+	return client
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Diff returns the fields which differ between the before and after snapshot of an entity as
+// {"field": {"before": ..., "after": ...}}, using the proto field names. before is nil for a
+// created entity and after is nil for a deleted one.
+func Diff(before, after proto.Message) (string, error) {
+	beforeFields, err := snapshot(before)
+	if err != nil {
+		return "", err
+	}
+	afterFields, err := snapshot(after)
+	if err != nil {
+		return "", err
+	}
+
+	changes := make(map[string]map[string]interface{})
+	for field, value := range afterFields {
+		if old := beforeFields[field]; !reflect.DeepEqual(old, value) {
+			changes[field] = map[string]interface{}{"before": old, "after": value}
+		}
+	}
+	for field, value := range beforeFields {
+		if _, ok := afterFields[field]; !ok {
+			changes[field] = map[string]interface{}{"before": value, "after": nil}
+		}
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func snapshot(m proto.Message) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return fields, nil
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+const (
+	EntityStore            = "store"
+	EntityProduct          = "product"
+	EntityUnitOfMeasure    = "unit_of_measure"
+	EntityProductCategory  = "product_category"
+	EntityProductType      = "product_type"
+	EntityProductDiscount  = "product_discount"
+	EntityProductAttribute = "product_attribute"
+	EntityProductLot       = "product_lot"
+)
+
+// AuditEvent records a change made to an entity. Events are only appended, they are written in the
+// transaction of the change they describe.
+type AuditEvent struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ActorID    uuid.UUID `gorm:"type:uuid"`
+	Action     string    `gorm:"type:varchar(50);not null"`
+	EntityType string    `gorm:"type:varchar(50);not null"`
+	EntityID   string    `gorm:"type:varchar(255);not null"`
+	// Changes holds the changed fields as {"field": {"before": ..., "after": ...}}
+	Changes   string    `gorm:"type:jsonb;not null"`
+	RequestID string    `gorm:"type:varchar(255)"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// AuditEventFilter selects the audit events to list, zero fields are not filtered on.
+type AuditEventFilter struct {
+	EntityType string
+	EntityID   string
+	ActorID    uuid.UUID
+	// From is inclusive and To exclusive
+	From  time.Time
+	To    time.Time
+	Page  int
+	Limit int
+}
+
+func (e *AuditEvent) ToProto() *pb.AuditEvent {
+	event := &pb.AuditEvent{
+		Id:         e.ID.String(),
+		Action:     e.Action,
+		EntityType: e.EntityType,
+		EntityId:   e.EntityID,
+		RequestId:  e.RequestID,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	if e.ActorID != uuid.Nil {
+		event.ActorId = e.ActorID.String()
+	}
+
+	var changes map[string]interface{}
+	if err := json.Unmarshal([]byte(e.Changes), &changes); err == nil {
+		event.Changes, _ = structpb.NewStruct(changes)
+	}
+	return event
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/audit/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.AuditRepository {
+	return &postgres{db}
+}
+
+func (p *postgres) CreateAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	return transaction.DB(ctx, p.db).Create(event).Error
+}
+
+func (p *postgres) ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter) ([]*entity.AuditEvent, error) {
+	tx := transaction.DB(ctx, p.db).Order("created_at DESC")
+	if filter.EntityType != "" {
+		tx = tx.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		tx = tx.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.ActorID != uuid.Nil {
+		tx = tx.Where("actor_id = ?", filter.ActorID)
+	}
+	if !filter.From.IsZero() {
+		tx = tx.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		tx = tx.Where("created_at < ?", filter.To)
+	}
+
+	events := []*entity.AuditEvent{}
+	offset := (filter.Page - 1) * filter.Limit
+	if err := tx.Offset(offset).Limit(filter.Limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+)
+
+// AuditRepository stores the audit events of changes.
+type AuditRepository interface {
+	// CreateAuditEvent appends an event, it takes part in the transaction of ctx so the event is
+	// only kept when the change it describes is committed.
+	CreateAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+
+	// ListAuditEvents lists the events matching the filter, the latest first.
+	ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter) ([]*entity.AuditEvent, error)
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey records a call made with an Idempotency-Key, so retries of the call get the response
+// of the first one instead of running it again.
+type IdempotencyKey struct {
+	UserID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Method string    `gorm:"type:varchar(255);primaryKey"`
+	Key    string    `gorm:"type:varchar(255);primaryKey"`
+	// RequestHash identifies the payload of the call, a key may not be reused for another payload
+	RequestHash string `gorm:"type:varchar(64);not null"`
+	// Response is the response of the call, it is nil while the call is in progress
+	Response  []byte    `gorm:"type:bytea"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null"`
+	ExpiresAt time.Time `gorm:"type:timestamptz;not null"`
+}
+
+// IsPending reports whether the first call made with the key has not completed.
+func (k *IdempotencyKey) IsPending() bool {
+	return k.Response == nil
+}
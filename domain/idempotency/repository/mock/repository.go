@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/idempotency/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/idempotency/repository/repository.go -destination=domain/idempotency/repository/mock/repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Mitra-Apps/be-store-service/domain/idempotency/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) CreateIdempotencyKey(ctx context.Context, key *entity.IdempotencyKey) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIdempotencyKey indicates an expected call of CreateIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) CreateIdempotencyKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).CreateIdempotencyKey), ctx, key)
+}
+
+// DeleteIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKey", ctx, userID, method, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyKey indicates an expected call of DeleteIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteIdempotencyKey(ctx, userID, method, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteIdempotencyKey), ctx, userID, method, key)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, userID, method, key)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) GetIdempotencyKey(ctx, userID, method, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).GetIdempotencyKey), ctx, userID, method, key)
+}
+
+// PurgeIdempotencyKeys mocks base method.
+func (m *MockIdempotencyRepository) PurgeIdempotencyKeys(ctx context.Context, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeIdempotencyKeys", ctx, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeIdempotencyKeys indicates an expected call of PurgeIdempotencyKeys.
+func (mr *MockIdempotencyRepositoryMockRecorder) PurgeIdempotencyKeys(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeIdempotencyKeys", reflect.TypeOf((*MockIdempotencyRepository)(nil).PurgeIdempotencyKeys), ctx, now)
+}
+
+// SaveIdempotencyResponse mocks base method.
+func (m *MockIdempotencyRepository) SaveIdempotencyResponse(ctx context.Context, userID uuid.UUID, method, key string, response []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIdempotencyResponse", ctx, userID, method, key, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIdempotencyResponse indicates an expected call of SaveIdempotencyResponse.
+func (mr *MockIdempotencyRepositoryMockRecorder) SaveIdempotencyResponse(ctx, userID, method, key, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotencyResponse", reflect.TypeOf((*MockIdempotencyRepository)(nil).SaveIdempotencyResponse), ctx, userID, method, key, response)
+}
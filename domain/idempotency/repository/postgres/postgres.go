@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.IdempotencyRepository {
+	return &postgres{db}
+}
+
+// the keys are written outside of the transaction of the call, so they are kept when the call fails
+
+func (p *postgres) CreateIdempotencyKey(ctx context.Context, key *entity.IdempotencyKey) (bool, error) {
+	res := p.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (p *postgres) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) (*entity.IdempotencyKey, error) {
+	record := &entity.IdempotencyKey{}
+	err := p.db.WithContext(ctx).
+		Where("user_id = ? AND method = ? AND key = ?", userID, method, key).
+		First(record).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+func (p *postgres) SaveIdempotencyResponse(ctx context.Context, userID uuid.UUID, method, key string, response []byte) error {
+	return p.db.WithContext(ctx).
+		Model(&entity.IdempotencyKey{}).
+		Where("user_id = ? AND method = ? AND key = ?", userID, method, key).
+		UpdateColumn("response", response).
+		Error
+}
+
+func (p *postgres) DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) error {
+	return p.db.WithContext(ctx).
+		Where("user_id = ? AND method = ? AND key = ?", userID, method, key).
+		Delete(&entity.IdempotencyKey{}).
+		Error
+}
+
+func (p *postgres) PurgeIdempotencyKeys(ctx context.Context, now time.Time) error {
+	return p.db.WithContext(ctx).
+		Where("expires_at < ?", now).
+		Delete(&entity.IdempotencyKey{}).
+		Error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/entity"
+	"github.com/google/uuid"
+)
+
+// IdempotencyRepository stores the idempotency keys of the calls.
+type IdempotencyRepository interface {
+	// CreateIdempotencyKey adds the key unless it already exists, and reports whether it was added.
+	CreateIdempotencyKey(ctx context.Context, key *entity.IdempotencyKey) (bool, error)
+	// GetIdempotencyKey returns nil when the key does not exist.
+	GetIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) (*entity.IdempotencyKey, error)
+	// SaveIdempotencyResponse stores the response of the call made with the key.
+	SaveIdempotencyResponse(ctx context.Context, userID uuid.UUID, method, key string, response []byte) error
+	DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, method, key string) error
+	// PurgeIdempotencyKeys deletes the keys which expired before now.
+	PurgeIdempotencyKeys(ctx context.Context, now time.Time) error
+}
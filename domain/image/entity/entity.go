@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	"github.com/google/uuid"
+)
+
+// ImageObject maps the content hash of an uploaded image to the object stored
+// in the utility service. RefCount tracks how many product images point to the
+// object so the blob is only removed once nothing references it anymore.
+type ImageObject struct {
+	base_model.BaseModel
+	ContentHash string    `gorm:"type:varchar(64);not null;uniqueIndex"`
+	ImageId     uuid.UUID `gorm:"type:uuid;not null;index"`
+	GroupName   string    `gorm:"type:varchar(50);not null"`
+	RefCount    int64     `gorm:"type:int;not null;default:0"`
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/image/repository"
+	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
+	"github.com/google/uuid"
+)
+
+const keyPrefix = "store-service:image-path:"
+
+// Store keeps image paths by key. Implementations must be safe for concurrent use.
+type Store interface {
+	// GetMulti returns the values of the keys which are present, missing keys are left out.
+	GetMulti(ctx context.Context, keys []string) (map[string]string, error)
+	SetMulti(ctx context.Context, values map[string]string, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// ImageRepository is a read-through cache of image paths in front of another image repository.
+type ImageRepository struct {
+	next  repository.ImageRepository
+	store Store
+	ttl   time.Duration
+}
+
+func New(next repository.ImageRepository, store Store, ttl time.Duration) *ImageRepository {
+	return &ImageRepository{
+		next:  next,
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+func (c *ImageRepository) UploadImage(ctx context.Context, imageBase64Str, groupName, userID string) (*uuid.UUID, error) {
+	return c.next.UploadImage(ctx, imageBase64Str, groupName, userID)
+}
+
+// GetImagesByIds returns cached images and only asks the next repository for the missing ones.
+// A failing cache store is logged and bypassed.
+func (c *ImageRepository) GetImagesByIds(ctx context.Context, ids []string) ([]*utilityPb.Image, error) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = key(id)
+	}
+
+	cached, err := c.store.GetMulti(ctx, keys)
+	if err != nil {
+		log.Printf("Error when getting image paths from cache : %v \n", err)
+		cached = map[string]string{}
+	}
+
+	images := []*utilityPb.Image{}
+	missingIds := []string{}
+	for i, id := range ids {
+		if path, ok := cached[keys[i]]; ok {
+			images = append(images, &utilityPb.Image{Id: id, Path: path})
+		} else {
+			missingIds = append(missingIds, id)
+		}
+	}
+	if len(missingIds) == 0 {
+		return images, nil
+	}
+
+	fetched, err := c.next.GetImagesByIds(ctx, missingIds)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for _, i := range fetched {
+		if i == nil {
+			continue
+		}
+		values[key(i.Id)] = i.Path
+		images = append(images, i)
+	}
+	if len(values) > 0 {
+		if err := c.store.SetMulti(ctx, values, c.ttl); err != nil {
+			log.Printf("Error when saving image paths to cache : %v \n", err)
+		}
+	}
+
+	return images, nil
+}
+
+// RemoveImage removes the images and drops them from the cache, even when the removal failed.
+func (c *ImageRepository) RemoveImage(ctx context.Context, ids []string, groupName, userID string) error {
+	err := c.next.RemoveImage(ctx, ids, groupName, userID)
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = key(id)
+	}
+	if delErr := c.store.Delete(ctx, keys...); delErr != nil {
+		log.Printf("Error when removing image paths from cache : %v \n", delErr)
+	}
+
+	return err
+}
+
+func key(id string) string {
+	return keyPrefix + id
+}
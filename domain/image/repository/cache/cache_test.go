@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_repository "github.com/Mitra-Apps/be-store-service/domain/image/repository/mock"
+	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	imageID      = "7d56be32-70a2-4f49-b66b-63e6f8e719e7"
+	otherImageID = "7d56be32-70a2-4f49-b66b-63e6f8e719e9"
+	userID       = "8b15140c-f6d0-4f2f-8302-57383a51adaf"
+)
+
+func TestImageRepository_GetImagesByIds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockImageRepo := mock_repository.NewMockImageRepository(ctrl)
+	ctx := context.Background()
+	c := New(mockImageRepo, NewLRU(10), time.Minute)
+
+	// first read goes to the utility service
+	mockImageRepo.EXPECT().GetImagesByIds(ctx, []string{imageID}).Return([]*utilityPb.Image{
+		{Id: imageID, Path: "http://image/1.png"},
+	}, nil)
+	images, err := c.GetImagesByIds(ctx, []string{imageID})
+	assert.Nil(t, err)
+	assert.Len(t, images, 1)
+
+	// cached images are served from the cache, only missing ones are requested
+	mockImageRepo.EXPECT().GetImagesByIds(ctx, []string{otherImageID}).Return([]*utilityPb.Image{
+		{Id: otherImageID, Path: "http://image/2.png"},
+	}, nil)
+	images, err = c.GetImagesByIds(ctx, []string{imageID, otherImageID})
+	assert.Nil(t, err)
+	assert.Equal(t, []*utilityPb.Image{
+		{Id: imageID, Path: "http://image/1.png"},
+		{Id: otherImageID, Path: "http://image/2.png"},
+	}, images)
+
+	// removed images are requested again, errors of the utility service are returned
+	mockImageRepo.EXPECT().RemoveImage(ctx, []string{imageID}, "store", userID).Return(nil)
+	assert.Nil(t, c.RemoveImage(ctx, []string{imageID}, "store", userID))
+	mockImageRepo.EXPECT().GetImagesByIds(ctx, []string{imageID}).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+	_, err = c.GetImagesByIds(ctx, []string{imageID, otherImageID})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestImageRepository_RemoveImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockImageRepo := mock_repository.NewMockImageRepository(ctrl)
+	ctx := context.Background()
+	store := NewLRU(10)
+	c := New(mockImageRepo, store, time.Minute)
+	store.SetMulti(ctx, map[string]string{key(imageID): "http://image/1.png"}, time.Minute)
+
+	mockImageRepo.EXPECT().RemoveImage(ctx, []string{imageID}, "store", userID).Return(status.Error(codes.Internal, "error"))
+	err := c.RemoveImage(ctx, []string{imageID}, "store", userID)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	values, _ := store.GetMulti(ctx, []string{key(imageID)})
+	assert.Empty(t, values)
+}
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	l := NewLRU(2)
+	l.now = func() time.Time { return now }
+
+	l.SetMulti(ctx, map[string]string{"a": "1"}, time.Minute)
+	l.SetMulti(ctx, map[string]string{"b": "2"}, time.Minute)
+	// reading a makes b the least recently used entry
+	l.GetMulti(ctx, []string{"a"})
+	l.SetMulti(ctx, map[string]string{"c": "3"}, time.Minute)
+
+	values, err := l.GetMulti(ctx, []string{"a", "b", "c"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "c": "3"}, values)
+
+	now = now.Add(2 * time.Minute)
+	values, err = l.GetMulti(ctx, []string{"a", "c"})
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key       string
+	value     string
+	expiresAt time.Time
+}
+
+// LRU is an in-process Store which evicts the least recently used entry once it is full.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+func (l *LRU) GetMulti(ctx context.Context, keys []string) (map[string]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	values := make(map[string]string)
+	now := l.now()
+	for _, k := range keys {
+		el, ok := l.items[k]
+		if !ok {
+			continue
+		}
+		entry := el.Value.(*lruEntry)
+		if !entry.expiresAt.IsZero() && now.After(entry.expiresAt) {
+			l.removeElement(el)
+			continue
+		}
+		l.order.MoveToFront(el)
+		values[k] = entry.value
+	}
+	return values, nil
+}
+
+func (l *LRU) SetMulti(ctx context.Context, values map[string]string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = l.now().Add(ttl)
+	}
+	for k, v := range values {
+		if el, ok := l.items[k]; ok {
+			entry := el.Value.(*lruEntry)
+			entry.value = v
+			entry.expiresAt = expiresAt
+			l.order.MoveToFront(el)
+			continue
+		}
+		l.items[k] = l.order.PushFront(&lruEntry{key: k, value: v, expiresAt: expiresAt})
+		if l.capacity > 0 && l.order.Len() > l.capacity {
+			l.removeElement(l.order.Back())
+		}
+	}
+	return nil
+}
+
+func (l *LRU) Delete(ctx context.Context, keys ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, k := range keys {
+		if el, ok := l.items[k]; ok {
+			l.removeElement(el)
+		}
+	}
+	return nil
+}
+
+func (l *LRU) removeElement(el *list.Element) {
+	l.order.Remove(el)
+	delete(l.items, el.Value.(*lruEntry).key)
+}
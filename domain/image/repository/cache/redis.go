@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Redis is a Store backed by redis or any server speaking its protocol, shared between service instances.
+type Redis struct {
+	client redis.UniversalClient
+}
+
+func NewRedis(client redis.UniversalClient) *Redis {
+	return &Redis{client: client}
+}
+
+func (r *Redis) GetMulti(ctx context.Context, keys []string) (map[string]string, error) {
+	values := make(map[string]string)
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	res, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range res {
+		if s, ok := v.(string); ok {
+			values[keys[i]] = s
+		}
+	}
+	return values, nil
+}
+
+func (r *Redis) SetMulti(ctx context.Context, values map[string]string, ttl time.Duration) error {
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for k, v := range values {
+			pipe.Set(ctx, k, v, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
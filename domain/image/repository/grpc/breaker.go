@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned without calling the utility service while the circuit breaker is open.
+var ErrCircuitOpen = status.Error(codes.Unavailable, "image service is temporarily unavailable")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops calling the utility service after a number of consecutive failures.
+// Once the cool down has passed a single trial call is let through, closing the circuit again when it succeeds.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	threshold int
+	coolDown  time.Duration
+	openedAt  time.Time
+	now       func() time.Time
+}
+
+func newCircuitBreaker(threshold int, coolDown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		coolDown:  coolDown,
+		now:       time.Now,
+	}
+}
+
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.coolDown {
+			return ErrCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		return nil
+	case breakerHalfOpen:
+		// only the trial call is allowed until its result is known
+		return ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+		if b.state != breakerOpen {
+			circuitOpenedTotal.Add(1)
+		}
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
@@ -2,6 +2,10 @@ package grpc
 
 import (
 	"context"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
 
 	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
 	"github.com/google/uuid"
@@ -9,33 +13,103 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// Config controls how the utility service is called.
+type Config struct {
+	// Timeout is the deadline of a single call, zero means the caller deadline is used as is.
+	Timeout time.Duration
+	// MaxRetries is the number of retries of idempotent calls after the first attempt failed.
+	MaxRetries int
+	// BaseBackoff is the wait before the first retry, it doubles on every following retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration
+	// FailureThreshold is the number of consecutive failures which opens the circuit breaker.
+	FailureThreshold int
+	// CoolDown is how long the circuit breaker stays open before a trial call is allowed.
+	CoolDown time.Duration
+}
+
+// DefaultConfig returns the configuration used when nothing is set in the environment.
+func DefaultConfig() Config {
+	return Config{
+		Timeout:          3 * time.Second,
+		MaxRetries:       2,
+		BaseBackoff:      100 * time.Millisecond,
+		MaxBackoff:       time.Second,
+		FailureThreshold: 5,
+		CoolDown:         30 * time.Second,
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by the IMAGE_SERVICE_* environment variables.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if d, err := time.ParseDuration(os.Getenv("IMAGE_SERVICE_TIMEOUT")); err == nil {
+		cfg.Timeout = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("IMAGE_SERVICE_MAX_RETRIES")); err == nil {
+		cfg.MaxRetries = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("IMAGE_SERVICE_BASE_BACKOFF")); err == nil {
+		cfg.BaseBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("IMAGE_SERVICE_MAX_BACKOFF")); err == nil {
+		cfg.MaxBackoff = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("IMAGE_SERVICE_FAILURE_THRESHOLD")); err == nil {
+		cfg.FailureThreshold = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("IMAGE_SERVICE_COOL_DOWN")); err == nil {
+		cfg.CoolDown = d
+	}
+	return cfg
+}
+
 type GrpcClient struct {
-	pb utilityPb.ImageServiceClient
+	pb      utilityPb.ImageServiceClient
+	cfg     Config
+	breaker *circuitBreaker
 }
 
-func New(pb utilityPb.ImageServiceClient) *GrpcClient {
-	return &GrpcClient{pb: pb}
+func New(pb utilityPb.ImageServiceClient, cfg Config) *GrpcClient {
+	return &GrpcClient{
+		pb:      pb,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(cfg.FailureThreshold, cfg.CoolDown),
+	}
 }
 
+// UploadImage is not retried, the utility service would store the image once for every attempt.
 func (g *GrpcClient) UploadImage(ctx context.Context, imageBase64Str, groupName, userID string) (*uuid.UUID, error) {
-	res, err := g.pb.UploadImage(ctx, &utilityPb.UploadImageRequest{
-		ImageBase64Str: imageBase64Str,
-		UserId:         userID,
-		GroupName:      groupName,
+	var res *utilityPb.UploadImageResponse
+	err := g.call(ctx, "upload_image", false, func(ctx context.Context) (err error) {
+		res, err = g.pb.UploadImage(ctx, &utilityPb.UploadImageRequest{
+			ImageBase64Str: imageBase64Str,
+			UserId:         userID,
+			GroupName:      groupName,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if res == nil {
+	if res == nil || res.GetData() == nil {
 		return nil, status.Errorf(codes.DataLoss, "Failed to upload image")
 	}
-	imageID := uuid.MustParse(res.Data.GetId())
+	imageID, err := uuid.Parse(res.GetData().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "Invalid uploaded image id : %s", res.GetData().GetId())
+	}
 	return &imageID, nil
 }
 
 func (g *GrpcClient) GetImagesByIds(ctx context.Context, ids []string) ([]*utilityPb.Image, error) {
-	res, err := g.pb.GetImagesByIds(ctx, &utilityPb.GetImagesByIdsRequest{
-		Ids: ids,
+	var res *utilityPb.GetImagesByIdsResponse
+	err := g.call(ctx, "get_images_by_ids", true, func(ctx context.Context) (err error) {
+		res, err = g.pb.GetImagesByIds(ctx, &utilityPb.GetImagesByIdsRequest{
+			Ids: ids,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -44,10 +118,92 @@ func (g *GrpcClient) GetImagesByIds(ctx context.Context, ids []string) ([]*utili
 }
 
 func (g *GrpcClient) RemoveImage(ctx context.Context, ids []string, groupName, userID string) error {
-	_, err := g.pb.DeleteImages(ctx, &utilityPb.DeleteImagesRequest{
-		UserId:    userID,
-		GroupName: groupName,
-		ImageIds:  ids,
+	return g.call(ctx, "delete_images", true, func(ctx context.Context) error {
+		_, err := g.pb.DeleteImages(ctx, &utilityPb.DeleteImagesRequest{
+			UserId:    userID,
+			GroupName: groupName,
+			ImageIds:  ids,
+		})
+		return err
 	})
+}
+
+// call runs fn with the configured timeout behind the circuit breaker.
+// Idempotent calls are retried with exponential backoff while the error is transient.
+func (g *GrpcClient) call(ctx context.Context, method string, idempotent bool, fn func(ctx context.Context) error) error {
+	attempts := 1
+	if idempotent {
+		attempts += g.cfg.MaxRetries
+	}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			recordRetry(method)
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(g.backoff(attempt)):
+			}
+		}
+
+		if allowErr := g.breaker.allow(); allowErr != nil {
+			recordRejected(method)
+			return allowErr
+		}
+
+		recordCall(method)
+		callCtx, cancel := ctx, context.CancelFunc(func() {})
+		if g.cfg.Timeout > 0 {
+			callCtx, cancel = context.WithTimeout(ctx, g.cfg.Timeout)
+		}
+		err = fn(callCtx)
+		cancel()
+		if err == nil {
+			g.breaker.success()
+			return nil
+		}
+
+		recordFailure(method)
+		if !isServiceFailure(err) {
+			// the service answered, the request itself is wrong
+			g.breaker.success()
+			return err
+		}
+		g.breaker.failure()
+		if !isRetryable(err) || ctx.Err() != nil {
+			return err
+		}
+	}
 	return err
 }
+
+func (g *GrpcClient) backoff(attempt int) time.Duration {
+	d := g.cfg.BaseBackoff << (attempt - 1)
+	if g.cfg.MaxBackoff > 0 && (d > g.cfg.MaxBackoff || d <= 0) {
+		d = g.cfg.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// jitter the wait between half and the whole backoff
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// isServiceFailure reports whether the error means the utility service is unhealthy.
+func isServiceFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// isRetryable reports whether the same request may succeed when sent again.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const imageID = "7d56be32-70a2-4f49-b66b-63e6f8e719e7"
+
+type fakeImageServiceClient struct {
+	utilityPb.ImageServiceClient
+	uploadImage    func(ctx context.Context) (*utilityPb.UploadImageResponse, error)
+	getImagesByIds func(ctx context.Context) (*utilityPb.GetImagesByIdsResponse, error)
+	deleteImages   func(ctx context.Context) (*utilityPb.DeleteImagesResponse, error)
+	calls          int
+}
+
+func (f *fakeImageServiceClient) UploadImage(ctx context.Context, in *utilityPb.UploadImageRequest, opts ...grpc.CallOption) (*utilityPb.UploadImageResponse, error) {
+	f.calls++
+	return f.uploadImage(ctx)
+}
+
+func (f *fakeImageServiceClient) GetImagesByIds(ctx context.Context, in *utilityPb.GetImagesByIdsRequest, opts ...grpc.CallOption) (*utilityPb.GetImagesByIdsResponse, error) {
+	f.calls++
+	return f.getImagesByIds(ctx)
+}
+
+func (f *fakeImageServiceClient) DeleteImages(ctx context.Context, in *utilityPb.DeleteImagesRequest, opts ...grpc.CallOption) (*utilityPb.DeleteImagesResponse, error) {
+	f.calls++
+	return f.deleteImages(ctx)
+}
+
+func testConfig() Config {
+	return Config{
+		Timeout:          50 * time.Millisecond,
+		MaxRetries:       2,
+		BaseBackoff:      time.Millisecond,
+		MaxBackoff:       2 * time.Millisecond,
+		FailureThreshold: 3,
+		CoolDown:         time.Minute,
+	}
+}
+
+func TestGrpcClient_UploadImage(t *testing.T) {
+	tests := []struct {
+		name          string
+		res           *utilityPb.UploadImageResponse
+		err           error
+		expectedCode  codes.Code
+		expectedCalls int
+	}{
+		{
+			name:          "UploadImage_InvalidImageId_ReturnDataLoss",
+			res:           &utilityPb.UploadImageResponse{Data: &utilityPb.Image{Id: "not-a-uuid"}},
+			expectedCode:  codes.DataLoss,
+			expectedCalls: 1,
+		},
+		{
+			name:          "UploadImage_EmptyResponse_ReturnDataLoss",
+			res:           &utilityPb.UploadImageResponse{},
+			expectedCode:  codes.DataLoss,
+			expectedCalls: 1,
+		},
+		{
+			name:          "UploadImage_Unavailable_NotRetried",
+			err:           status.Error(codes.Unavailable, "unavailable"),
+			expectedCode:  codes.Unavailable,
+			expectedCalls: 1,
+		},
+		{
+			name:          "UploadImage_NoError_Success",
+			res:           &utilityPb.UploadImageResponse{Data: &utilityPb.Image{Id: imageID}},
+			expectedCode:  codes.OK,
+			expectedCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeImageServiceClient{
+				uploadImage: func(ctx context.Context) (*utilityPb.UploadImageResponse, error) {
+					return tt.res, tt.err
+				},
+			}
+			g := New(fake, testConfig())
+			id, err := g.UploadImage(context.Background(), "YWFh", "store", imageID)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedCalls, fake.calls)
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, imageID, id.String())
+			} else {
+				assert.Nil(t, id)
+			}
+		})
+	}
+}
+
+func TestGrpcClient_GetImagesByIds(t *testing.T) {
+	t.Run("GetImagesByIds_TransientError_Retried", func(t *testing.T) {
+		fake := &fakeImageServiceClient{}
+		fake.getImagesByIds = func(ctx context.Context) (*utilityPb.GetImagesByIdsResponse, error) {
+			if fake.calls < 3 {
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			}
+			return &utilityPb.GetImagesByIdsResponse{Data: []*utilityPb.Image{{Id: imageID}}}, nil
+		}
+		g := New(fake, testConfig())
+		images, err := g.GetImagesByIds(context.Background(), []string{imageID})
+		assert.Nil(t, err)
+		assert.Len(t, images, 1)
+		assert.Equal(t, 3, fake.calls)
+	})
+
+	t.Run("GetImagesByIds_InvalidArgument_NotRetried", func(t *testing.T) {
+		fake := &fakeImageServiceClient{
+			getImagesByIds: func(ctx context.Context) (*utilityPb.GetImagesByIdsResponse, error) {
+				return nil, status.Error(codes.InvalidArgument, "invalid")
+			},
+		}
+		g := New(fake, testConfig())
+		_, err := g.GetImagesByIds(context.Background(), []string{imageID})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 1, fake.calls)
+	})
+
+	t.Run("GetImagesByIds_SlowService_TimedOut", func(t *testing.T) {
+		fake := &fakeImageServiceClient{
+			getImagesByIds: func(ctx context.Context) (*utilityPb.GetImagesByIdsResponse, error) {
+				<-ctx.Done()
+				return nil, status.FromContextError(ctx.Err()).Err()
+			},
+		}
+		cfg := testConfig()
+		cfg.MaxRetries = 0
+		g := New(fake, cfg)
+		_, err := g.GetImagesByIds(context.Background(), []string{imageID})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
+
+func TestGrpcClient_CircuitBreaker(t *testing.T) {
+	fake := &fakeImageServiceClient{}
+	fake.deleteImages = func(ctx context.Context) (*utilityPb.DeleteImagesResponse, error) {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	g := New(fake, testConfig())
+	now := time.Now()
+	g.breaker.now = func() time.Time { return now }
+
+	// three attempts trip the breaker
+	err := g.RemoveImage(context.Background(), []string{imageID}, "store", imageID)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, fake.calls)
+
+	// calls are rejected without reaching the service while the circuit is open
+	err = g.RemoveImage(context.Background(), []string{imageID}, "store", imageID)
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Equal(t, 3, fake.calls)
+
+	// after the cool down a successful trial call closes the circuit
+	now = now.Add(time.Minute)
+	fake.deleteImages = func(ctx context.Context) (*utilityPb.DeleteImagesResponse, error) {
+		return &utilityPb.DeleteImagesResponse{}, nil
+	}
+	err = g.RemoveImage(context.Background(), []string{imageID}, "store", imageID)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, fake.calls)
+	assert.Equal(t, breakerClosed, g.breaker.state)
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import "expvar"
+
+// Metrics of the utility service client, published through expvar under "image_service_client".
+var (
+	metrics            = expvar.NewMap("image_service_client")
+	circuitOpenedTotal = new(expvar.Int)
+)
+
+func init() {
+	metrics.Set("circuit_opened_total", circuitOpenedTotal)
+}
+
+func recordCall(method string) {
+	metrics.Add(method+"_calls_total", 1)
+}
+
+func recordFailure(method string) {
+	metrics.Add(method+"_failures_total", 1)
+}
+
+func recordRetry(method string) {
+	metrics.Add(method+"_retries_total", 1)
+}
+
+func recordRejected(method string) {
+	metrics.Add(method+"_rejected_total", 1)
+}
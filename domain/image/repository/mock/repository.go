@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: domain/image/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/image/repository/repository.go -destination=domain/image/repository/mock/repository.go -package=mock_repository
+//
 
 // Package mock_repository is a generated GoMock package.
 package mock_repository
@@ -8,9 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/Mitra-Apps/be-store-service/domain/image/entity"
 	utility "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
-	gomock "go.uber.org/mock/gomock"
 	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockImageRepository is a mock of ImageRepository interface.
@@ -46,7 +52,7 @@ func (m *MockImageRepository) GetImagesByIds(ctx context.Context, ids []string)
 }
 
 // GetImagesByIds indicates an expected call of GetImagesByIds.
-func (mr *MockImageRepositoryMockRecorder) GetImagesByIds(ctx, ids interface{}) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) GetImagesByIds(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByIds", reflect.TypeOf((*MockImageRepository)(nil).GetImagesByIds), ctx, ids)
 }
@@ -60,7 +66,7 @@ func (m *MockImageRepository) RemoveImage(ctx context.Context, ids []string, gro
 }
 
 // RemoveImage indicates an expected call of RemoveImage.
-func (mr *MockImageRepositoryMockRecorder) RemoveImage(ctx, ids, groupName, userID interface{}) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) RemoveImage(ctx, ids, groupName, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockImageRepository)(nil).RemoveImage), ctx, ids, groupName, userID)
 }
@@ -75,7 +81,75 @@ func (m *MockImageRepository) UploadImage(ctx context.Context, imageBase64Str, g
 }
 
 // UploadImage indicates an expected call of UploadImage.
-func (mr *MockImageRepositoryMockRecorder) UploadImage(ctx, imageBase64Str, groupName, userID interface{}) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) UploadImage(ctx, imageBase64Str, groupName, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockImageRepository)(nil).UploadImage), ctx, imageBase64Str, groupName, userID)
 }
+
+// MockImageObjectRepository is a mock of ImageObjectRepository interface.
+type MockImageObjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageObjectRepositoryMockRecorder
+}
+
+// MockImageObjectRepositoryMockRecorder is the mock recorder for MockImageObjectRepository.
+type MockImageObjectRepositoryMockRecorder struct {
+	mock *MockImageObjectRepository
+}
+
+// NewMockImageObjectRepository creates a new mock instance.
+func NewMockImageObjectRepository(ctrl *gomock.Controller) *MockImageObjectRepository {
+	mock := &MockImageObjectRepository{ctrl: ctrl}
+	mock.recorder = &MockImageObjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageObjectRepository) EXPECT() *MockImageObjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcquireImageObject mocks base method.
+func (m *MockImageObjectRepository) AcquireImageObject(ctx context.Context, contentHash string) (*entity.ImageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireImageObject", ctx, contentHash)
+	ret0, _ := ret[0].(*entity.ImageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireImageObject indicates an expected call of AcquireImageObject.
+func (mr *MockImageObjectRepositoryMockRecorder) AcquireImageObject(ctx, contentHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireImageObject", reflect.TypeOf((*MockImageObjectRepository)(nil).AcquireImageObject), ctx, contentHash)
+}
+
+// CreateImageObject mocks base method.
+func (m *MockImageObjectRepository) CreateImageObject(ctx context.Context, imageObject *entity.ImageObject) (*entity.ImageObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageObject", ctx, imageObject)
+	ret0, _ := ret[0].(*entity.ImageObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImageObject indicates an expected call of CreateImageObject.
+func (mr *MockImageObjectRepositoryMockRecorder) CreateImageObject(ctx, imageObject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageObject", reflect.TypeOf((*MockImageObjectRepository)(nil).CreateImageObject), ctx, imageObject)
+}
+
+// ReleaseImageObjects mocks base method.
+func (m *MockImageObjectRepository) ReleaseImageObjects(ctx context.Context, imageIds []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseImageObjects", ctx, imageIds)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseImageObjects indicates an expected call of ReleaseImageObjects.
+func (mr *MockImageObjectRepositoryMockRecorder) ReleaseImageObjects(ctx, imageIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseImageObjects", reflect.TypeOf((*MockImageObjectRepository)(nil).ReleaseImageObjects), ctx, imageIds)
+}
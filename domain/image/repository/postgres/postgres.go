@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/image/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/image/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.ImageObjectRepository {
+	return &postgres{db}
+}
+
+func (p *postgres) AcquireImageObject(ctx context.Context, contentHash string) (*entity.ImageObject, error) {
+	imageObject := entity.ImageObject{}
+	tx := transaction.DB(ctx, p.db).
+		Model(&imageObject).
+		Clauses(clause.Returning{}).
+		Where("content_hash = ?", contentHash).
+		UpdateColumn("ref_count", gorm.Expr("ref_count + 1"))
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &imageObject, nil
+}
+
+func (p *postgres) CreateImageObject(ctx context.Context, imageObject *entity.ImageObject) (*entity.ImageObject, error) {
+	if imageObject.RefCount == 0 {
+		imageObject.RefCount = 1
+	}
+
+	err := transaction.DB(ctx, p.db).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "content_hash"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"ref_count": gorm.Expr("image_objects.ref_count + 1"),
+			}),
+		}).
+		Create(imageObject).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stored := entity.ImageObject{}
+	if err := transaction.DB(ctx, p.db).Where("content_hash = ?", imageObject.ContentHash).First(&stored).Error; err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+func (p *postgres) ReleaseImageObjects(ctx context.Context, imageIds []uuid.UUID) ([]uuid.UUID, error) {
+	unreferenced := []uuid.UUID{}
+	seen := make(map[uuid.UUID]bool)
+
+	err := transaction.DB(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		for _, id := range imageIds {
+			imageObject := entity.ImageObject{}
+			res := tx.Model(&imageObject).
+				Clauses(clause.Returning{}).
+				Where("image_id = ?", id).
+				UpdateColumn("ref_count", gorm.Expr("ref_count - 1"))
+			if res.Error != nil {
+				return res.Error
+			}
+
+			// images uploaded before content addressing was introduced are not tracked,
+			// they are only referenced by a single product image.
+			if res.RowsAffected > 0 && imageObject.RefCount > 0 {
+				continue
+			}
+
+			if res.RowsAffected > 0 {
+				if err := tx.Unscoped().Delete(&imageObject).Error; err != nil {
+					return err
+				}
+			}
+
+			if !seen[id] {
+				seen[id] = true
+				unreferenced = append(unreferenced, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unreferenced, nil
+}
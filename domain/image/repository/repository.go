@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/Mitra-Apps/be-store-service/domain/image/entity"
 	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
 	"github.com/google/uuid"
 )
@@ -12,3 +13,18 @@ type ImageRepository interface {
 	GetImagesByIds(ctx context.Context, ids []string) ([]*utilityPb.Image, error)
 	RemoveImage(ctx context.Context, ids []string, groupName, userID string) error
 }
+
+// ImageObjectRepository keeps track of content addressed image objects and their reference counts.
+type ImageObjectRepository interface {
+	// AcquireImageObject increments the reference count of the object with the given content hash.
+	// It returns nil when no object with that hash has been registered yet.
+	AcquireImageObject(ctx context.Context, contentHash string) (*entity.ImageObject, error)
+
+	// CreateImageObject registers a newly uploaded object with a reference count of one.
+	// When another upload registered the same content hash first, that object is referenced and returned instead.
+	CreateImageObject(ctx context.Context, imageObject *entity.ImageObject) (*entity.ImageObject, error)
+
+	// ReleaseImageObjects decrements the reference count of the given image ids and returns the ids
+	// which are not referenced anymore and can be removed from storage.
+	ReleaseImageObjects(ctx context.Context, imageIds []uuid.UUID) ([]uuid.UUID, error)
+}
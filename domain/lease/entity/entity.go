@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Lease lets a single replica run a job at a time, e.g. the product scheduler. The holder keeps the
+// lease by renewing it before it expires, another replica takes it over once it has expired.
+type Lease struct {
+	Name      string    `gorm:"type:varchar(255);primaryKey"`
+	Holder    string    `gorm:"type:varchar(255);not null"`
+	ExpiresAt time.Time `gorm:"type:timestamptz;not null"`
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/lease/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/lease/repository/repository.go -destination=domain/lease/repository/mock/repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLeaseRepository is a mock of LeaseRepository interface.
+type MockLeaseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaseRepositoryMockRecorder
+}
+
+// MockLeaseRepositoryMockRecorder is the mock recorder for MockLeaseRepository.
+type MockLeaseRepositoryMockRecorder struct {
+	mock *MockLeaseRepository
+}
+
+// NewMockLeaseRepository creates a new mock instance.
+func NewMockLeaseRepository(ctrl *gomock.Controller) *MockLeaseRepository {
+	mock := &MockLeaseRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaseRepository) EXPECT() *MockLeaseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcquireLease mocks base method.
+func (m *MockLeaseRepository) AcquireLease(ctx context.Context, name, holder string, now time.Time, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLease", ctx, name, holder, now, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLease indicates an expected call of AcquireLease.
+func (mr *MockLeaseRepositoryMockRecorder) AcquireLease(ctx, name, holder, now, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLease", reflect.TypeOf((*MockLeaseRepository)(nil).AcquireLease), ctx, name, holder, now, ttl)
+}
+
+// ReleaseLease mocks base method.
+func (m *MockLeaseRepository) ReleaseLease(ctx context.Context, name, holder string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLease", ctx, name, holder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLease indicates an expected call of ReleaseLease.
+func (mr *MockLeaseRepositoryMockRecorder) ReleaseLease(ctx, name, holder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLease", reflect.TypeOf((*MockLeaseRepository)(nil).ReleaseLease), ctx, name, holder)
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/lease/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/lease/repository"
+	"gorm.io/gorm"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.LeaseRepository {
+	return &postgres{db}
+}
+
+// the leases are written outside of any transaction, so they are seen by the other replicas at once
+
+func (p *postgres) AcquireLease(ctx context.Context, name, holder string, now time.Time, ttl time.Duration) (bool, error) {
+	res := p.db.WithContext(ctx).Exec(`INSERT INTO leases (name, holder, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET holder = excluded.holder, expires_at = excluded.expires_at
+		WHERE leases.holder = excluded.holder OR leases.expires_at <= ?`, name, holder, now.Add(ttl), now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (p *postgres) ReleaseLease(ctx context.Context, name, holder string) error {
+	return p.db.WithContext(ctx).
+		Where("name = ? AND holder = ?", name, holder).
+		Delete(&entity.Lease{}).
+		Error
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestPostgres_AcquireLease(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(t.TempDir()+"/lease.db"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(`CREATE TABLE leases (name text PRIMARY KEY, holder text NOT NULL, expires_at datetime NOT NULL)`).Error)
+	repo := NewPostgres(db)
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	held, err := repo.AcquireLease(ctx, "job", "a", now, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, held)
+
+	// the lease is held by a until it expires, a renews it
+	held, err = repo.AcquireLease(ctx, "job", "b", now.Add(30*time.Second), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, held)
+	held, err = repo.AcquireLease(ctx, "job", "a", now.Add(30*time.Second), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, held)
+	held, err = repo.AcquireLease(ctx, "job", "b", now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, held)
+
+	// b takes the lease over once it has expired
+	held, err = repo.AcquireLease(ctx, "job", "b", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, held)
+
+	// a released lease is taken at once, releasing a lease held by another holder does nothing
+	assert.NoError(t, repo.ReleaseLease(ctx, "job", "a"))
+	held, err = repo.AcquireLease(ctx, "job", "a", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, held)
+	assert.NoError(t, repo.ReleaseLease(ctx, "job", "b"))
+	held, err = repo.AcquireLease(ctx, "job", "a", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, held)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// LeaseRepository stores the leases of the jobs run by a single replica.
+type LeaseRepository interface {
+	// AcquireLease takes or renews the lease until now plus ttl, and reports whether holder holds it.
+	// A lease held by another holder is only taken once it has expired.
+	AcquireLease(ctx context.Context, name, holder string, now time.Time, ttl time.Duration) (bool, error)
+	// ReleaseLease gives up the lease when holder holds it, so another replica can take it at once.
+	ReleaseLease(ctx context.Context, name, holder string) error
+}
@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Domain event types published for the changes of stores and products.
+const (
+	StoreCreated    = "StoreCreated"
+	StoreUpdated    = "StoreUpdated"
+	StoreOpened     = "StoreOpened"
+	StoreClosed     = "StoreClosed"
+	StoreDeleted    = "StoreDeleted"
+	ProductUpserted = "ProductUpserted"
+	ProductDeleted  = "ProductDeleted"
+	StockChanged    = "StockChanged"
+)
+
+// Event types streamed to the watchers of a store and of its products.
+var (
+	StoreEventTypes   = []string{StoreCreated, StoreUpdated, StoreOpened, StoreClosed, StoreDeleted}
+	ProductEventTypes = []string{ProductUpserted, ProductDeleted, StockChanged}
+)
+
+// IsEventType reports whether t is one of the domain event types.
+func IsEventType(t string) bool {
+	switch t {
+	case StoreCreated, StoreUpdated, StoreOpened, StoreClosed, StoreDeleted, ProductUpserted, ProductDeleted, StockChanged:
+		return true
+	}
+	return false
+}
+
+const (
+	AggregateStore   = "store"
+	AggregateProduct = "product"
+)
+
+// OutboxEvent is a domain event waiting to be published. It is written in the transaction of the
+// change it describes and published by the relay afterwards, at least once.
+type OutboxEvent struct {
+	ID uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	// Sequence orders the events, the events of a store are committed in sequence order
+	Sequence      int64  `gorm:"type:bigserial;->"`
+	EventType     string `gorm:"type:varchar(100);not null"`
+	AggregateType string `gorm:"type:varchar(50);not null"`
+	AggregateID   string `gorm:"type:varchar(255);not null"`
+	// StoreID is the store the event belongs to, the event is delivered to the webhooks of this store
+	StoreID  uuid.UUID `gorm:"type:uuid"`
+	Payload  string    `gorm:"type:jsonb;not null"`
+	Attempts int       `gorm:"type:int;not null;default:0"`
+	// NextAttemptAt is when the event may be published, it is pushed back after a failed attempt
+	NextAttemptAt time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+	// LockedUntil is when the lease of the relay publishing the event ends
+	LockedUntil *time.Time `gorm:"type:timestamptz"`
+	LastError   string     `gorm:"type:text"`
+	PublishedAt *time.Time `gorm:"type:timestamptz"`
+	CreatedAt   time.Time  `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// StockChange is the payload of a StockChanged event.
+type StockChange struct {
+	ProductID     string `json:"product_id"`
+	StoreID       string `json:"store_id"`
+	PreviousStock int64  `json:"previous_stock"`
+	Stock         int64  `json:"stock"`
+	// the stock in the base unit of the unit of measure of the product
+	PreviousBaseStock int64 `json:"previous_base_stock"`
+	BaseStock         int64 `json:"base_stock"`
+}
+
+// NewOutboxEvent returns an event with the payload encoded as JSON, proto messages are encoded with
+// their proto field names.
+func NewOutboxEvent(storeID uuid.UUID, eventType, aggregateType, aggregateID string, payload interface{}) (*OutboxEvent, error) {
+	var data []byte
+	var err error
+	if m, ok := payload.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	} else {
+		data, err = json.Marshal(payload)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{
+		StoreID:       storeID,
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(data),
+	}, nil
+}
+
+// Envelope is the message published for an event. Consumers use the id to drop the duplicates of
+// the at least once delivery.
+type Envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	StoreID       string          `json:"store_id"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+// Envelope returns the JSON encoded envelope of the event.
+func (e *OutboxEvent) Envelope() ([]byte, error) {
+	return json.Marshal(Envelope{
+		ID:            e.ID.String(),
+		Type:          e.EventType,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		StoreID:       e.StoreID.String(),
+		Payload:       json.RawMessage(e.Payload),
+		OccurredAt:    e.CreatedAt,
+	})
+}
+
+// ToStoreEvent returns the event as streamed to the watchers of its store.
+func (e *OutboxEvent) ToStoreEvent() *pb.StoreEvent {
+	event := &pb.StoreEvent{
+		Sequence:      e.Sequence,
+		Id:            e.ID.String(),
+		Type:          e.EventType,
+		AggregateType: e.AggregateType,
+		AggregateId:   e.AggregateID,
+		StoreId:       e.StoreID.String(),
+		OccurredAt:    timestamppb.New(e.CreatedAt),
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(e.Payload), &payload); err == nil {
+		event.Payload, _ = structpb.NewStruct(payload)
+	}
+	return event
+}
@@ -0,0 +1,24 @@
+package publisher
+
+import (
+	"context"
+	"log"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+)
+
+type logPublisher struct{}
+
+// NewLog returns a publisher which only logs the events, it is used when no broker is configured.
+func NewLog() Publisher {
+	return &logPublisher{}
+}
+
+func (p *logPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	envelope, err := event.Envelope()
+	if err != nil {
+		return err
+	}
+	log.Printf("Domain event %s : %s \n", event.EventType, envelope)
+	return nil
+}
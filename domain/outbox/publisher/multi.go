@@ -0,0 +1,26 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+)
+
+type multiPublisher struct {
+	publishers []Publisher
+}
+
+// NewMulti returns a publisher which publishes every event through each of the publishers in turn.
+// It fails on the first publisher which fails, the event is then published again to all of them.
+func NewMulti(publishers ...Publisher) Publisher {
+	return &multiPublisher{publishers: publishers}
+}
+
+func (p *multiPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+)
+
+// NATSConn is the part of a NATS connection used to publish, *nats.Conn satisfies it.
+type NATSConn interface {
+	Publish(subject string, data []byte) error
+	FlushWithContext(ctx context.Context) error
+}
+
+type natsPublisher struct {
+	conn          NATSConn
+	subjectPrefix string
+}
+
+// NewNATS returns a publisher which publishes every event to the subject <subjectPrefix>.<event type>.
+func NewNATS(conn NATSConn, subjectPrefix string) Publisher {
+	return &natsPublisher{conn: conn, subjectPrefix: subjectPrefix}
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	envelope, err := event.Envelope()
+	if err != nil {
+		return err
+	}
+	if err := p.conn.Publish(p.subjectPrefix+"."+event.EventType, envelope); err != nil {
+		return err
+	}
+	// the event is only buffered by Publish, the flush waits for the server to have received it
+	return p.conn.FlushWithContext(ctx)
+}
@@ -0,0 +1,48 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeNATSConn struct {
+	subject  string
+	data     []byte
+	flushErr error
+}
+
+func (c *fakeNATSConn) Publish(subject string, data []byte) error {
+	c.subject, c.data = subject, data
+	return nil
+}
+
+func (c *fakeNATSConn) FlushWithContext(ctx context.Context) error {
+	return c.flushErr
+}
+
+func TestNATS_Publish(t *testing.T) {
+	event := &entity.OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     entity.StoreClosed,
+		AggregateType: entity.AggregateStore,
+		AggregateID:   "store-1",
+		Payload:       `{"is_active":false}`,
+	}
+
+	conn := &fakeNATSConn{}
+	assert.NoError(t, NewNATS(conn, "store").Publish(context.Background(), event))
+	assert.Equal(t, "store.StoreClosed", conn.subject)
+	var got entity.Envelope
+	assert.NoError(t, json.Unmarshal(conn.data, &got))
+	assert.Equal(t, event.ID.String(), got.ID)
+
+	// an event the server may not have received is published again
+	conn = &fakeNATSConn{flushErr: errors.New("nats: timeout")}
+	assert.Error(t, NewNATS(conn, "store").Publish(context.Background(), event))
+}
@@ -0,0 +1,13 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+)
+
+// Publisher delivers domain events to their consumers. Publish returns an error when the event may not
+// have been delivered, the relay then publishes it again later, so consumers can receive an event twice.
+type Publisher interface {
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
@@ -0,0 +1,50 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+)
+
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook returns a publisher which posts the envelope of every event to url. Any response other
+// than 2xx is a failed delivery.
+func NewWebhook(url string, client *http.Client) Publisher {
+	return &webhookPublisher{url: url, client: client}
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	envelope, err := event.Envelope()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(envelope))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", event.ID.String())
+	req.Header.Set("X-Event-Type", event.EventType)
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", res.Status)
+	}
+	return nil
+}
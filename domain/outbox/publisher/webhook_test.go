@@ -0,0 +1,51 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook_Publish(t *testing.T) {
+	event := &entity.OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     entity.StoreClosed,
+		AggregateType: entity.AggregateStore,
+		AggregateID:   "store-1",
+		Payload:       `{"is_active":false}`,
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "delivered", status: http.StatusNoContent},
+		{name: "rejected", status: http.StatusServiceUnavailable, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got entity.Envelope
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, event.ID.String(), r.Header.Get("X-Event-Id"))
+				assert.Equal(t, entity.StoreClosed, r.Header.Get("X-Event-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhook(server.URL, server.Client()).Publish(context.Background(), event)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, event.ID.String(), got.ID)
+			assert.Equal(t, "store-1", got.AggregateID)
+			assert.JSONEq(t, event.Payload, string(got.Payload))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package relay
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/publisher"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+)
+
+// Config controls how the relay publishes the outbox.
+type Config struct {
+	// Interval is the wait between polls once the outbox has been drained.
+	Interval time.Duration
+	// BatchSize is the number of events claimed by a poll.
+	BatchSize int
+	// PublishTimeout is the deadline of publishing a single event.
+	PublishTimeout time.Duration
+	// Lease is how long the claimed events are kept from the other relays, it should outlast publishing
+	// a whole batch.
+	Lease time.Duration
+	// BaseBackoff is the wait before the first retry of a failed event, it doubles on every following retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration
+	// Retention is how long published events are kept before they are purged.
+	Retention time.Duration
+}
+
+// DefaultConfig returns the configuration used when nothing is set in the environment.
+func DefaultConfig() Config {
+	return Config{
+		Interval:       time.Second,
+		BatchSize:      100,
+		PublishTimeout: 5 * time.Second,
+		Lease:          10 * time.Minute,
+		BaseBackoff:    time.Second,
+		MaxBackoff:     10 * time.Minute,
+		Retention:      7 * 24 * time.Hour,
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by the OUTBOX_* environment variables.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_INTERVAL")); err == nil {
+		cfg.Interval = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("OUTBOX_BATCH_SIZE")); err == nil {
+		cfg.BatchSize = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_PUBLISH_TIMEOUT")); err == nil {
+		cfg.PublishTimeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_LEASE")); err == nil {
+		cfg.Lease = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_BASE_BACKOFF")); err == nil {
+		cfg.BaseBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_MAX_BACKOFF")); err == nil {
+		cfg.MaxBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("OUTBOX_RETENTION")); err == nil {
+		cfg.Retention = d
+	}
+	return cfg
+}
+
+// Relay publishes the events of the outbox. Every replica may run one, an event is leased by a
+// single relay at a time and is published outside of the transaction which claimed it, so an event
+// is published again when the relay stops before recording the result or the lease runs out.
+type Relay struct {
+	repo      repository.OutboxRepository
+	trx       transaction.Manager
+	publisher publisher.Publisher
+	cfg       Config
+	now       func() time.Time
+}
+
+func New(repo repository.OutboxRepository, trx transaction.Manager, publisher publisher.Publisher, cfg Config) *Relay {
+	return &Relay{
+		repo:      repo,
+		trx:       trx,
+		publisher: publisher,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// Run publishes the outbox until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	var lastPurge time.Time
+	for {
+		claimed, err := r.RelayBatch(ctx)
+		if err != nil {
+			log.Printf("Failed to relay outbox events : %v \n", err)
+		}
+
+		if r.cfg.Retention > 0 && r.now().Sub(lastPurge) > time.Hour {
+			if err := r.repo.PurgeOutboxEvents(ctx, r.now().Add(-r.cfg.Retention)); err != nil {
+				log.Printf("Failed to purge published outbox events : %v \n", err)
+			}
+			lastPurge = r.now()
+		}
+
+		// a full batch means more events are waiting
+		if err == nil && claimed == r.cfg.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.Interval):
+		}
+	}
+}
+
+// RelayBatch publishes a batch of due events and returns how many were claimed. Events which fail to
+// publish are retried after a backoff, the following events of their aggregate wait for the retry.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	var events []*entity.OutboxEvent
+	err := r.trx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		events, err = r.repo.ClaimOutboxEvents(ctx, r.now(), r.now().Add(r.cfg.Lease), r.cfg.BatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	failed := make(map[string]bool)
+	var held []uuid.UUID
+	for _, event := range events {
+		aggregate := event.AggregateType + "/" + event.AggregateID
+		if failed[aggregate] {
+			held = append(held, event.ID)
+			continue
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, r.cfg.PublishTimeout)
+		err := r.publisher.Publish(publishCtx, event)
+		cancel()
+
+		if err != nil {
+			failed[aggregate] = true
+			attempts := event.Attempts + 1
+			log.Printf("Failed to publish outbox event %s, attempt %d : %v \n", event.ID, attempts, err)
+			if err := r.repo.ScheduleOutboxEventRetry(ctx, event.ID, attempts, r.now().Add(r.backoff(attempts)), err.Error()); err != nil {
+				return len(events), err
+			}
+			continue
+		}
+		if err := r.repo.MarkOutboxEventPublished(ctx, event.ID, r.now()); err != nil {
+			return len(events), err
+		}
+	}
+
+	// the held events are claimed again once the failed event of their aggregate is published
+	if len(held) > 0 {
+		if err := r.repo.ReleaseOutboxEvents(ctx, held); err != nil {
+			return len(events), err
+		}
+	}
+	return len(events), nil
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.cfg.BaseBackoff << (attempts - 1)
+	if r.cfg.MaxBackoff > 0 && (d > r.cfg.MaxBackoff || d <= 0) {
+		d = r.cfg.MaxBackoff
+	}
+	return d
+}
@@ -0,0 +1,106 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	outboxRepoMock "github.com/Mitra-Apps/be-store-service/domain/outbox/repository/mock"
+	trxMock "github.com/Mitra-Apps/be-store-service/domain/transaction/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type publisherFunc func(ctx context.Context, event *entity.OutboxEvent) error
+
+func (f publisherFunc) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+func TestRelay_RelayBatch(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(10 * time.Minute)
+	published := &entity.OutboxEvent{ID: uuid.New(), EventType: entity.StoreCreated, AggregateType: entity.AggregateStore, AggregateID: "a"}
+	failed := &entity.OutboxEvent{ID: uuid.New(), EventType: entity.StoreUpdated, AggregateType: entity.AggregateStore, AggregateID: "b", Attempts: 2}
+	afterFailed := &entity.OutboxEvent{ID: uuid.New(), EventType: entity.StoreClosed, AggregateType: entity.AggregateStore, AggregateID: "b"}
+
+	tests := []struct {
+		name        string
+		mock        func(repo *outboxRepoMock.MockOutboxRepository)
+		wantClaimed int
+		wantErr     bool
+	}{
+		{
+			name: "publish and retry",
+			mock: func(repo *outboxRepoMock.MockOutboxRepository) {
+				repo.EXPECT().ClaimOutboxEvents(gomock.Any(), now, lockedUntil, 10).Return([]*entity.OutboxEvent{published, failed}, nil)
+				repo.EXPECT().MarkOutboxEventPublished(gomock.Any(), published.ID, now).Return(nil)
+				repo.EXPECT().ScheduleOutboxEventRetry(gomock.Any(), failed.ID, 3, now.Add(4*time.Second), "broker down").Return(nil)
+			},
+			wantClaimed: 2,
+		},
+		{
+			name: "later events of a failed aggregate wait for its retry",
+			mock: func(repo *outboxRepoMock.MockOutboxRepository) {
+				repo.EXPECT().ClaimOutboxEvents(gomock.Any(), now, lockedUntil, 10).Return([]*entity.OutboxEvent{failed, published, afterFailed}, nil)
+				repo.EXPECT().ScheduleOutboxEventRetry(gomock.Any(), failed.ID, 3, now.Add(4*time.Second), "broker down").Return(nil)
+				repo.EXPECT().MarkOutboxEventPublished(gomock.Any(), published.ID, now).Return(nil)
+				repo.EXPECT().MarkOutboxEventPublished(gomock.Any(), afterFailed.ID, gomock.Any()).Times(0)
+				repo.EXPECT().ReleaseOutboxEvents(gomock.Any(), []uuid.UUID{afterFailed.ID}).Return(nil)
+			},
+			wantClaimed: 3,
+		},
+		{
+			name: "claim error",
+			mock: func(repo *outboxRepoMock.MockOutboxRepository) {
+				repo.EXPECT().ClaimOutboxEvents(gomock.Any(), now, lockedUntil, 10).Return(nil, errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+			trx := trxMock.NewMockManager(ctrl)
+			inTransaction := false
+			trx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				inTransaction = true
+				defer func() { inTransaction = false }()
+				return fn(ctx)
+			})
+			tt.mock(repo)
+
+			pub := publisherFunc(func(ctx context.Context, event *entity.OutboxEvent) error {
+				// the events are published outside of the transaction which claimed them
+				assert.False(t, inTransaction)
+				assert.NotEqual(t, afterFailed.ID, event.ID)
+				if event.ID == failed.ID {
+					return errors.New("broker down")
+				}
+				return nil
+			})
+			cfg := DefaultConfig()
+			cfg.BatchSize = 10
+			r := New(repo, trx, pub, cfg)
+			r.now = func() time.Time { return now }
+
+			claimed, err := r.RelayBatch(context.Background())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantClaimed, claimed)
+		})
+	}
+}
+
+func TestRelay_backoff(t *testing.T) {
+	r := New(nil, nil, nil, Config{BaseBackoff: time.Second, MaxBackoff: time.Minute})
+
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 32*time.Second, r.backoff(6))
+	assert.Equal(t, time.Minute, r.backoff(7))
+	assert.Equal(t, time.Minute, r.backoff(100))
+}
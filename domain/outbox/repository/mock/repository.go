@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/outbox/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/outbox/repository/repository.go -destination=domain/outbox/repository/mock/repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimOutboxEvents mocks base method.
+func (m *MockOutboxRepository) ClaimOutboxEvents(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimOutboxEvents(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimOutboxEvents), ctx, now, lockedUntil, limit)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockOutboxRepository) CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockOutboxRepositoryMockRecorder) CreateOutboxEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockOutboxRepository)(nil).CreateOutboxEvent), ctx, event)
+}
+
+// GetLastStoreOutboxSequence mocks base method.
+func (m *MockOutboxRepository) GetLastStoreOutboxSequence(ctx context.Context, storeID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastStoreOutboxSequence", ctx, storeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastStoreOutboxSequence indicates an expected call of GetLastStoreOutboxSequence.
+func (mr *MockOutboxRepositoryMockRecorder) GetLastStoreOutboxSequence(ctx, storeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastStoreOutboxSequence", reflect.TypeOf((*MockOutboxRepository)(nil).GetLastStoreOutboxSequence), ctx, storeID)
+}
+
+// GetOldestOutboxSequence mocks base method.
+func (m *MockOutboxRepository) GetOldestOutboxSequence(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestOutboxSequence", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestOutboxSequence indicates an expected call of GetOldestOutboxSequence.
+func (mr *MockOutboxRepositoryMockRecorder) GetOldestOutboxSequence(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestOutboxSequence", reflect.TypeOf((*MockOutboxRepository)(nil).GetOldestOutboxSequence), ctx)
+}
+
+// ListStoreOutboxEvents mocks base method.
+func (m *MockOutboxRepository) ListStoreOutboxEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, limit int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoreOutboxEvents", ctx, storeID, eventTypes, afterSequence, limit)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoreOutboxEvents indicates an expected call of ListStoreOutboxEvents.
+func (mr *MockOutboxRepositoryMockRecorder) ListStoreOutboxEvents(ctx, storeID, eventTypes, afterSequence, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoreOutboxEvents", reflect.TypeOf((*MockOutboxRepository)(nil).ListStoreOutboxEvents), ctx, storeID, eventTypes, afterSequence, limit)
+}
+
+// MarkOutboxEventPublished mocks base method.
+func (m *MockOutboxRepository) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventPublished", ctx, id, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventPublished indicates an expected call of MarkOutboxEventPublished.
+func (mr *MockOutboxRepositoryMockRecorder) MarkOutboxEventPublished(ctx, id, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventPublished", reflect.TypeOf((*MockOutboxRepository)(nil).MarkOutboxEventPublished), ctx, id, publishedAt)
+}
+
+// PurgeOutboxEvents mocks base method.
+func (m *MockOutboxRepository) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOutboxEvents", ctx, publishedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeOutboxEvents indicates an expected call of PurgeOutboxEvents.
+func (mr *MockOutboxRepositoryMockRecorder) PurgeOutboxEvents(ctx, publishedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOutboxEvents", reflect.TypeOf((*MockOutboxRepository)(nil).PurgeOutboxEvents), ctx, publishedBefore)
+}
+
+// ReleaseOutboxEvents mocks base method.
+func (m *MockOutboxRepository) ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseOutboxEvents", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseOutboxEvents indicates an expected call of ReleaseOutboxEvents.
+func (mr *MockOutboxRepositoryMockRecorder) ReleaseOutboxEvents(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutboxEvents", reflect.TypeOf((*MockOutboxRepository)(nil).ReleaseOutboxEvents), ctx, ids)
+}
+
+// ScheduleOutboxEventRetry mocks base method.
+func (m *MockOutboxRepository) ScheduleOutboxEventRetry(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleOutboxEventRetry", ctx, id, attempts, nextAttemptAt, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleOutboxEventRetry indicates an expected call of ScheduleOutboxEventRetry.
+func (mr *MockOutboxRepositoryMockRecorder) ScheduleOutboxEventRetry(ctx, id, attempts, nextAttemptAt, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleOutboxEventRetry", reflect.TypeOf((*MockOutboxRepository)(nil).ScheduleOutboxEventRetry), ctx, id, attempts, nextAttemptAt, lastError)
+}
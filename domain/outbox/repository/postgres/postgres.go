@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.OutboxRepository {
+	return &postgres{db}
+}
+
+func (p *postgres) CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	tx := transaction.DB(ctx, p.db)
+	if event.StoreID != uuid.Nil {
+		// the sequence is taken on insert, a concurrent transaction could otherwise commit a lower
+		// sequence after a watcher has read past it
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", event.StoreID.String()).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Create(event).Error
+}
+
+func (p *postgres) ListStoreOutboxEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, limit int) ([]*entity.OutboxEvent, error) {
+	tx := transaction.DB(ctx, p.db).Where("store_id = ? AND sequence > ?", storeID, afterSequence)
+	if len(eventTypes) > 0 {
+		tx = tx.Where("event_type IN ?", eventTypes)
+	}
+
+	events := []*entity.OutboxEvent{}
+	if err := tx.Order("sequence").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (p *postgres) GetLastStoreOutboxSequence(ctx context.Context, storeID uuid.UUID) (int64, error) {
+	var sequence int64
+	err := transaction.DB(ctx, p.db).
+		Model(&entity.OutboxEvent{}).
+		Select("COALESCE(MAX(sequence), 0)").
+		Where("store_id = ?", storeID).
+		Scan(&sequence).
+		Error
+	return sequence, err
+}
+
+func (p *postgres) GetOldestOutboxSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := transaction.DB(ctx, p.db).
+		Model(&entity.OutboxEvent{}).
+		Select("COALESCE(MIN(sequence), 0)").
+		Scan(&sequence).
+		Error
+	return sequence, err
+}
+
+func (p *postgres) ClaimOutboxEvents(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	tx := transaction.DB(ctx, p.db)
+	// the claims are serialized, a concurrent claim could otherwise lease a later event of an aggregate
+	// while the earlier one is locked by the other claim
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('outbox_events_claim'))").Error; err != nil {
+		return nil, err
+	}
+
+	events := []*entity.OutboxEvent{}
+	err := tx.Raw(`UPDATE outbox_events SET locked_until = ? WHERE id IN (
+			SELECT e.id FROM outbox_events e
+			WHERE e.published_at IS NULL AND e.next_attempt_at <= ?
+				AND (e.locked_until IS NULL OR e.locked_until <= ?)
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox_events prev
+					WHERE prev.aggregate_type = e.aggregate_type AND prev.aggregate_id = e.aggregate_id
+						AND prev.published_at IS NULL AND prev.sequence < e.sequence
+						AND (prev.next_attempt_at > ? OR prev.locked_until > ?)
+				)
+			ORDER BY e.sequence
+			LIMIT ?
+		) RETURNING *`, lockedUntil, now, now, now, now, limit).
+		Scan(&events).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Sequence < events[j].Sequence })
+	return events, nil
+}
+
+func (p *postgres) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"published_at": publishedAt,
+			"locked_until": nil,
+		}).
+		Error
+}
+
+func (p *postgres) ScheduleOutboxEventRetry(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, lastError string) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+			"locked_until":    nil,
+		}).
+		Error
+}
+
+func (p *postgres) ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id IN ?", ids).
+		UpdateColumn("locked_until", nil).
+		Error
+}
+
+func (p *postgres) PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) error {
+	return transaction.DB(ctx, p.db).
+		Where("published_at < ?", publishedBefore).
+		Delete(&entity.OutboxEvent{}).
+		Error
+}
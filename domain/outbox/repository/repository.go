@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/google/uuid"
+)
+
+// OutboxRepository stores the domain events waiting to be published.
+type OutboxRepository interface {
+	// CreateOutboxEvent adds an event, it takes part in the transaction of ctx so the event is only
+	// published when the change it describes is committed. The events of a store are serialized until
+	// the transaction ends, so they are committed in sequence order.
+	CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error
+
+	// ListStoreOutboxEvents returns up to limit events of a store after the given sequence, in sequence
+	// order. Only the given event types are returned when eventTypes is not empty.
+	ListStoreOutboxEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, limit int) ([]*entity.OutboxEvent, error)
+
+	// GetLastStoreOutboxSequence returns the sequence of the latest event of a store, 0 when there is none.
+	GetLastStoreOutboxSequence(ctx context.Context, storeID uuid.UUID) (int64, error)
+
+	// GetOldestOutboxSequence returns the sequence of the oldest event which has not been purged, 0 when
+	// there is none.
+	GetOldestOutboxSequence(ctx context.Context) (int64, error)
+
+	// ClaimOutboxEvents leases up to limit unpublished events which are due at now until lockedUntil, in
+	// sequence order. Events leased by another relay are skipped, as are the events of an aggregate
+	// following an earlier event which is leased or waiting for a retry, so the events of an aggregate
+	// are published in order.
+	ClaimOutboxEvents(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.OutboxEvent, error)
+
+	// MarkOutboxEventPublished records that the event has been published and ends its lease.
+	MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+
+	// ScheduleOutboxEventRetry records a failed publish attempt and when the next attempt is due, and ends
+	// the lease of the event.
+	ScheduleOutboxEventRetry(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, lastError string) error
+
+	// ReleaseOutboxEvents ends the lease of events which were claimed but not published.
+	ReleaseOutboxEvents(ctx context.Context, ids []uuid.UUID) error
+
+	// PurgeOutboxEvents deletes the events published before the given time.
+	PurgeOutboxEvents(ctx context.Context, publishedBefore time.Time) error
+}
@@ -0,0 +1,76 @@
+package watch
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Notifier wakes the watchers of a store when new events of the store may be available.
+type Notifier interface {
+	// Subscribe returns a channel receiving a value when the store has new events and a function
+	// ending the subscription. Wake ups are coalesced, a watcher reads every event after its last
+	// sequence when it wakes.
+	Subscribe(storeID uuid.UUID) (<-chan struct{}, func())
+}
+
+// Broker fans the notifications of the events out to the watchers of this process.
+type Broker struct {
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{watchers: map[uuid.UUID]map[chan struct{}]struct{}{}}
+}
+
+func (b *Broker) Subscribe(storeID uuid.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	if b.watchers[storeID] == nil {
+		b.watchers[storeID] = map[chan struct{}]struct{}{}
+	}
+	b.watchers[storeID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.watchers[storeID], ch)
+			if len(b.watchers[storeID]) == 0 {
+				delete(b.watchers, storeID)
+			}
+		})
+	}
+}
+
+// Notify wakes the watchers of the store.
+func (b *Broker) Notify(storeID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.watchers[storeID] {
+		wake(ch)
+	}
+}
+
+// NotifyAll wakes every watcher, it is used when notifications may have been missed.
+func (b *Broker) NotifyAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, watchers := range b.watchers {
+		for ch := range watchers {
+			wake(ch)
+		}
+	}
+}
+
+func wake(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+		// a wake up is already pending
+	}
+}
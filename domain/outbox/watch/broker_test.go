@@ -0,0 +1,43 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func woken(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestBroker(t *testing.T) {
+	b := NewBroker()
+	store, otherStore := uuid.New(), uuid.New()
+
+	first, unsubscribeFirst := b.Subscribe(store)
+	second, unsubscribeSecond := b.Subscribe(store)
+	other, unsubscribeOther := b.Subscribe(otherStore)
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	// wake ups of a store are coalesced until the watcher reads them
+	b.Notify(store)
+	b.Notify(store)
+	assert.True(t, woken(first))
+	assert.False(t, woken(first))
+	assert.True(t, woken(second))
+	assert.False(t, woken(other))
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	b.NotifyAll()
+	assert.False(t, woken(first))
+	assert.True(t, woken(second))
+	assert.True(t, woken(other))
+}
@@ -0,0 +1,65 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Channel is the Postgres channel notified by the outbox_events trigger.
+const Channel = "outbox_events"
+
+type notification struct {
+	StoreID  uuid.UUID `json:"store_id"`
+	Sequence int64     `json:"sequence"`
+}
+
+// Listen forwards the notifications of committed events to the broker until ctx is done, so the
+// watchers connected to any replica are woken. It reconnects when the connection is lost and then
+// wakes every watcher, since notifications sent while disconnected are missed.
+func Listen(ctx context.Context, dsn string, broker *Broker) {
+	for ctx.Err() == nil {
+		err := listen(ctx, dsn, broker)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Lost the connection listening to %s : %v \n", Channel, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func listen(ctx context.Context, dsn string, broker *Broker) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+	broker.NotifyAll()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var payload notification
+		if err := json.Unmarshal([]byte(n.Payload), &payload); err != nil {
+			log.Printf("Invalid %s notification %q : %v \n", Channel, n.Payload, err)
+			continue
+		}
+		broker.Notify(payload.StoreID)
+	}
+}
@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+)
+
+// Data types of the product attributes.
+const (
+	AttributeText    = "text"
+	AttributeNumber  = "number"
+	AttributeBoolean = "boolean"
+	AttributeDate    = "date"
+)
+
+// DateLayout is the layout of the dates written as text, e.g. the values of date attributes or the
+// expiry date of a lot.
+const DateLayout = "2006-01-02"
+
+// ProductAttributeDefinition is an attribute the products of a type can record, e.g. the brand of a
+// coffee or the expiry date of a milk. The values of the attribute are limited to AllowedValues when
+// it is not empty.
+type ProductAttributeDefinition struct {
+	base_model.BaseMasterDataModel
+	ProductTypeID int64    `gorm:"type:bigint;not null;index"`
+	Name          string   `gorm:"type:varchar(255);not null"`
+	DataType      string   `gorm:"type:varchar(20);not null"`
+	IsRequired    bool     `gorm:"type:bool;not null;default:false"`
+	AllowedValues []string `gorm:"type:jsonb;not null;serializer:json"`
+}
+
+// ProductAttribute is the value of an attribute of a product, it is kept as text whatever the data
+// type of the attribute.
+type ProductAttribute struct {
+	ID          int64                       `gorm:"primaryKey;autoIncrement"`
+	ProductID   uuid.UUID                   `gorm:"type:uuid;not null;uniqueIndex:idx_product_attributes_product_attribute"`
+	AttributeID int64                       `gorm:"type:bigint;not null;uniqueIndex:idx_product_attributes_product_attribute"`
+	Value       string                      `gorm:"type:varchar(255);not null"`
+	Attribute   *ProductAttributeDefinition `gorm:"foreignKey:AttributeID"`
+}
+
+func (d *ProductAttributeDefinition) FromProto(definition *pb.ProductAttributeDefinition) {
+	d.ID = definition.Id
+	d.ProductTypeID = definition.ProductTypeId
+	d.Name = definition.Name
+	d.DataType = definition.DataType
+	d.IsRequired = definition.IsRequired
+	d.AllowedValues = definition.AllowedValues
+}
+
+func (d *ProductAttributeDefinition) ToProto() *pb.ProductAttributeDefinition {
+	if d == nil {
+		return nil
+	}
+	return &pb.ProductAttributeDefinition{
+		Id:            d.ID,
+		ProductTypeId: d.ProductTypeID,
+		Name:          d.Name,
+		DataType:      d.DataType,
+		IsRequired:    d.IsRequired,
+		AllowedValues: d.AllowedValues,
+	}
+}
+
+// NormalizeValue returns value written the way values of the data type are stored, e.g. a boolean as
+// true or false, and false when value is not a value of the data type.
+func (d *ProductAttributeDefinition) NormalizeValue(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	switch d.DataType {
+	case AttributeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return value, false
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), true
+	case AttributeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return value, false
+		}
+		return strconv.FormatBool(b), true
+	case AttributeDate:
+		if _, err := time.Parse(DateLayout, value); err != nil {
+			return value, false
+		}
+	}
+	return value, value != ""
+}
+
+// NormalizeAllowedValues normalizes the allowed values to the data type, it returns a violation for
+// every allowed value which is not a value of the data type.
+func (d *ProductAttributeDefinition) NormalizeAllowedValues() error {
+	violations := []*storeerror.Violation{}
+	for i, v := range d.AllowedValues {
+		normalized, ok := d.NormalizeValue(v)
+		if !ok {
+			violations = append(violations, storeerror.Field("attribute.allowed_values["+strconv.Itoa(i)+"]",
+				errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE, v, d.DataType))
+			continue
+		}
+		d.AllowedValues[i] = normalized
+	}
+	if len(violations) > 0 {
+		return storeerror.BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT, violations...)
+	}
+	return nil
+}
+
+// AllowedValue returns the allowed value matching a normalized value, text values match ignoring the
+// case. Every value is allowed when the attribute has no allowed values.
+func (d *ProductAttributeDefinition) AllowedValue(value string) (string, bool) {
+	if len(d.AllowedValues) == 0 {
+		return value, true
+	}
+	for _, v := range d.AllowedValues {
+		if v == value || (d.DataType == AttributeText && strings.EqualFold(v, value)) {
+			return v, true
+		}
+	}
+	return value, false
+}
+
+func (a *ProductAttribute) FromProto(attribute *pb.ProductAttribute) {
+	a.AttributeID = attribute.AttributeId
+	a.Value = attribute.Value
+}
+
+func (a *ProductAttribute) ToProto() *pb.ProductAttribute {
+	attribute := &pb.ProductAttribute{
+		AttributeId: a.AttributeID,
+		Value:       a.Value,
+	}
+	if a.Attribute != nil {
+		attribute.Name = a.Attribute.Name
+		attribute.DataType = a.Attribute.DataType
+	}
+	return attribute
+}
+
+// ValidateAttributes checks the attribute values of the product against definitions, the attributes
+// defined for its type. The values are normalized to their data type and every violation is returned,
+// named below field, e.g. product_list[0].
+func (p *Product) ValidateAttributes(field string, definitions []*ProductAttributeDefinition) []*storeerror.Violation {
+	byID := make(map[int64]*ProductAttributeDefinition, len(definitions))
+	for _, d := range definitions {
+		byID[d.ID] = d
+	}
+
+	violations := []*storeerror.Violation{}
+	set := make(map[int64]bool)
+	for i, a := range p.Attributes {
+		name := field + ".attributes[" + strconv.Itoa(i) + "]"
+		definition := byID[a.AttributeID]
+		if definition == nil {
+			violations = append(violations, storeerror.Field(name+".attribute_id",
+				errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE, a.AttributeID))
+			continue
+		}
+		if set[a.AttributeID] {
+			violations = append(violations, storeerror.Field(name+".attribute_id",
+				errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_DUPLICATED, definition.Name))
+			continue
+		}
+		set[a.AttributeID] = true
+		a.Attribute = definition
+
+		value, ok := definition.NormalizeValue(a.Value)
+		if !ok {
+			violations = append(violations, storeerror.Field(name+".value",
+				errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE, a.Value, definition.DataType))
+			continue
+		}
+		if value, ok = definition.AllowedValue(value); !ok {
+			violations = append(violations, storeerror.Field(name+".value",
+				errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED, definition.Name, strings.Join(definition.AllowedValues, ", ")))
+			continue
+		}
+		a.Value = value
+	}
+
+	for _, d := range definitions {
+		if d.IsRequired && !set[d.ID] {
+			violations = append(violations, storeerror.Field(field+".attributes",
+				errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_REQUIRED, d.Name))
+		}
+	}
+	return violations
+}
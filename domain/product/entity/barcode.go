@@ -0,0 +1,23 @@
+package entity
+
+// ValidBarcodeCheckDigit reports whether the last digit of an EAN-8, UPC-A, EAN-13 or GTIN-14 barcode
+// is the check digit of the others. The digits are weighted 3 and 1 alternately from the right of the
+// check digit, the check digit brings their sum to a multiple of 10.
+func ValidBarcodeCheckDigit(barcode string) bool {
+	if len(barcode) < 2 {
+		return false
+	}
+	sum := 0
+	for i := len(barcode) - 2; i >= 0; i-- {
+		digit := int(barcode[i] - '0')
+		if digit > 9 {
+			return false
+		}
+		if (len(barcode)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	check := int(barcode[len(barcode)-1] - '0')
+	return check <= 9 && (sum+check)%10 == 0
+}
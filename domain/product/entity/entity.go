@@ -1,56 +1,86 @@
 package entity
 
 import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ProductCategory is a node of the category tree. Path materialises the ancestors of the category as
+// their ids from the root, e.g. /1/4/ for a category below 4 which is below the root category 1, so a
+// subtree is found with a prefix match on the path.
 type ProductCategory struct {
 	base_model.BaseMasterDataModel
-	Name         string         `gorm:"type:varchar(255);not null;unique"`
-	IsActive     bool           `gorm:"type:bool;not null"`
-	ProductTypes []*ProductType `gorm:"foreignKey:ProductCategoryID"`
+	Name         string             `gorm:"type:varchar(255);not null"`
+	IsActive     bool               `gorm:"type:bool;not null"`
+	ParentID     *int64             `gorm:"type:bigint"`
+	Path         string             `gorm:"type:varchar(1024);not null;default:'/'"`
+	ProductTypes []*ProductType     `gorm:"foreignKey:ProductCategoryID"`
+	Children     []*ProductCategory `gorm:"-"`
 }
 
 type ProductType struct {
 	base_model.BaseMasterDataModel
 	Name              string          `gorm:"type:varchar(255);not null"`
 	IsActive          bool            `gorm:"type:bool;not null"`
-	ProductCategoryID int64           `gorm:"type:uuid;not null"`
+	ProductCategoryID int64           `gorm:"type:bigint;not null"`
 	ProductCategory   ProductCategory `gorm:"foreignKey:ProductCategoryID"`
 }
 
+// UnitOfMeasure is a unit products are sold in. A unit with a base unit is converted to it with
+// its conversion factor, e.g. 1 kilogram is 1000 gram, the stock of products is kept in the base unit.
 type UnitOfMeasure struct {
 	base_model.BaseMasterDataModel
-	Name     string `gorm:"type:varchar(255);not null;unique"`
-	Symbol   string `gorm:"type:varchar(50);not null;unique"`
-	IsActive bool   `gorm:"type:bool;not null"`
+	Name             string  `gorm:"type:varchar(255);not null;unique"`
+	Symbol           string  `gorm:"type:varchar(50);not null;unique"`
+	IsActive         bool    `gorm:"type:bool;not null"`
+	BaseUnitID       *int64  `gorm:"type:bigint"`
+	ConversionFactor float64 `gorm:"type:decimal(18,6);not null;default:1"`
 }
 
 type Product struct {
 	base_model.BaseModel
-	StoreID             uuid.UUID       `gorm:"type:uuid;not null"`
-	Name                string          `gorm:"type:varchar(255);not null"`
-	SaleStatus          bool            `gorm:"type:bool;not null"`
-	Price               float64         `gorm:"decimal(17,2); not null; default:0"`
-	Stock               int64           `gorm:"type:int;"`
-	Uom                 string          `gorm:"type:varchar(50)"`
-	ProductTypeID       int64           `gorm:"type:uuid;not null"`
-	Images              []*ProductImage `gorm:"foreignKey:ProductId"`
-	ProductType         ProductType     `gorm:"foreignKey:ProductTypeID"`
-	ProductTypeName     string          `gorm:"-"`
-	ProductCategoryID   int64           `gorm:"-"`
-	ProductCategoryName string          `gorm:"-"`
+	StoreID             uuid.UUID           `gorm:"type:uuid;not null"`
+	Name                string              `gorm:"type:varchar(255);not null"`
+	Description         string              `gorm:"type:text;not null;default:''"`
+	SKU                 string              `gorm:"type:varchar(64);not null;default:''"`
+	Barcode             string              `gorm:"type:varchar(14);not null;default:''"`
+	SaleStatus          bool                `gorm:"type:bool;not null"`
+	Price               Money               `gorm:"embedded;embeddedPrefix:price_"`
+	Stock               int64               `gorm:"type:int;"`
+	BaseStock           int64               `gorm:"type:bigint;not null;default:0"`
+	UomID               int64               `gorm:"type:bigint"`
+	Uom                 string              `gorm:"type:varchar(50)"`
+	ProductTypeID       int64               `gorm:"type:bigint;not null"`
+	Version             int64               `gorm:"type:bigint;not null;default:1"`
+	PublishAt           *time.Time          `gorm:"type:timestamptz"`
+	UnpublishAt         *time.Time          `gorm:"type:timestamptz"`
+	Images              []*ProductImage     `gorm:"foreignKey:ProductId"`
+	Attributes          []*ProductAttribute `gorm:"foreignKey:ProductID"`
+	ProductType         ProductType         `gorm:"foreignKey:ProductTypeID"`
+	ProductTypeName     string              `gorm:"-"`
+	ProductCategoryID   int64               `gorm:"-"`
+	ProductCategoryName string              `gorm:"-"`
+	EffectivePrice      Money               `gorm:"-"`
+	ActiveDiscount      *ProductDiscount    `gorm:"-"`
 }
 
 type ProductImage struct {
 	base_model.BaseModel
 	ProductId      uuid.UUID `gorm:"type:uuid;not null"`
 	ImageId        uuid.UUID `gorm:"type:uuid;not null"`
+	SortOrder      int32     `gorm:"type:int;not null;default:0"`
+	IsPrimary      bool      `gorm:"type:bool;not null;default:false"`
 	ImageBase64Str string    `gorm:"-"`
 	ImageURL       string    `gorm:"-"`
 	IsDelete       bool      `gorm:"-"`
@@ -60,7 +90,7 @@ func (p *Product) FromProto(product *pb.Product, storeIdPrm *string) error {
 	if product.Id != "" {
 		id, err := uuid.Parse(product.Id)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "Invalid uuid for product id")
+			return storeerror.InvalidID("product.id")
 		}
 		p.ID = id
 	}
@@ -73,7 +103,7 @@ func (p *Product) FromProto(product *pb.Product, storeIdPrm *string) error {
 	if product.StoreId != "" {
 		storeId, err := uuid.Parse(storeId)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "Invalid uuid for store id")
+			return storeerror.InvalidID("product.store_id")
 		}
 		p.StoreID = storeId
 	}
@@ -86,15 +116,45 @@ func (p *Product) FromProto(product *pb.Product, storeIdPrm *string) error {
 		p.Images = append(p.Images, pi)
 	}
 
+	p.Attributes = nil
+	for _, a := range product.Attributes {
+		pa := &ProductAttribute{}
+		pa.FromProto(a)
+		p.Attributes = append(p.Attributes, pa)
+	}
+
 	p.Name = product.Name
+	p.Description = product.Description
+	p.SKU = strings.TrimSpace(product.Sku)
+	p.Barcode = product.Barcode
 	p.SaleStatus = product.SaleStatus
-	p.Price = product.Price
+	if product.PriceMoney != nil {
+		price, err := MoneyFromProto(product.PriceMoney)
+		if err != nil {
+			return err
+		}
+		p.Price = price
+	} else {
+		p.Price = MoneyFromFloat(product.Price, DefaultCurrency)
+	}
 	p.Stock = product.Stock
+	p.UomID = product.UomId
 	p.Uom = product.Uom
 	p.ProductTypeID = product.ProductTypeId
 	p.ProductTypeName = product.ProductTypeName
 	p.ProductCategoryID = product.ProductCategoryId
 	p.ProductCategoryName = product.ProductCategoryName
+	p.Version = product.Version
+	p.PublishAt = nil
+	if product.PublishAt != nil {
+		publishAt := product.PublishAt.AsTime()
+		p.PublishAt = &publishAt
+	}
+	p.UnpublishAt = nil
+	if product.UnpublishAt != nil {
+		unpublishAt := product.UnpublishAt.AsTime()
+		p.UnpublishAt = &unpublishAt
+	}
 
 	return nil
 }
@@ -103,7 +163,7 @@ func (p *ProductImage) FromProto(img *pb.ProductImage) error {
 	if img.Id != "" && img.Id != uuid.Nil.String() {
 		prodImgIdUUID, err := uuid.Parse(img.Id)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "Invalid uuid for id")
+			return storeerror.InvalidID("images.id")
 		}
 		p.ID = prodImgIdUUID
 	}
@@ -111,18 +171,168 @@ func (p *ProductImage) FromProto(img *pb.ProductImage) error {
 	if img.ImageId != "" && img.ImageId != uuid.Nil.String() {
 		imgIdUUID, err := uuid.Parse(img.ImageId)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "Invalid uuid for image id")
+			return storeerror.InvalidID("images.image_id")
 		}
 		p.ImageId = imgIdUUID
 	}
 	p.ImageURL = img.ImageUrl
+	p.SortOrder = img.SortOrder
+	p.IsPrimary = img.IsPrimary
+	return nil
+}
+
+// productMaskFields copies a product field which can be listed in an update mask, keyed by its proto name.
+var productMaskFields = map[string]func(dst, src *Product){
+	"name":            func(dst, src *Product) { dst.Name = src.Name },
+	"description":     func(dst, src *Product) { dst.Description = src.Description },
+	"sku":             func(dst, src *Product) { dst.SKU = src.SKU },
+	"barcode":         func(dst, src *Product) { dst.Barcode = src.Barcode },
+	"sale_status":     func(dst, src *Product) { dst.SaleStatus = src.SaleStatus },
+	"price":           func(dst, src *Product) { dst.Price = src.Price },
+	"stock":           func(dst, src *Product) { dst.Stock = src.Stock },
+	"uom_id":          func(dst, src *Product) { dst.UomID, dst.Uom = src.UomID, src.Uom },
+	"product_type_id": func(dst, src *Product) { dst.ProductTypeID = src.ProductTypeID },
+	"images":          func(dst, src *Product) { dst.Images = src.Images },
+	"attributes":      func(dst, src *Product) { dst.Attributes = src.Attributes },
+	"publish_at":      func(dst, src *Product) { dst.PublishAt = src.PublishAt },
+	"unpublish_at":    func(dst, src *Product) { dst.UnpublishAt = src.UnpublishAt },
+}
+
+// productMaskAliases maps the update mask paths naming the same stored field as another path.
+var productMaskAliases = map[string]string{
+	"price_money": "price",
+	"uom":         "uom_id",
+}
+
+// ApplyFieldMask keeps the stored value of every field which is not listed in paths,
+// so the product can be saved as a partial update. A product can not be moved to another store.
+func (p *Product) ApplyFieldMask(stored *Product, paths []string) error {
+	listed := make(map[string]bool)
+	for _, path := range paths {
+		if alias, ok := productMaskAliases[path]; ok {
+			path = alias
+		}
+		if productMaskFields[path] == nil {
+			return storeerror.Invalid("update_mask", errPb.StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED, path)
+		}
+		listed[path] = true
+	}
+	for name, copyField := range productMaskFields {
+		if !listed[name] {
+			copyField(p, stored)
+		}
+	}
+	p.StoreID = stored.StoreID
 	return nil
 }
 
+// NormalizeImages orders the product images by their sort order and makes sure exactly one image is primary.
+// When no sort order is given the images keep the order in which they were sent.
+func (p *Product) NormalizeImages() {
+	images := []*ProductImage{}
+	for _, i := range p.Images {
+		if i != nil {
+			images = append(images, i)
+		}
+	}
+	p.Images = images
+	if len(p.Images) == 0 {
+		return
+	}
+
+	hasSortOrder := false
+	for _, i := range p.Images {
+		if i.SortOrder != 0 {
+			hasSortOrder = true
+			break
+		}
+	}
+	if !hasSortOrder {
+		for idx, i := range p.Images {
+			i.SortOrder = int32(idx)
+		}
+	}
+
+	sort.SliceStable(p.Images, func(i, j int) bool {
+		return p.Images[i].SortOrder < p.Images[j].SortOrder
+	})
+
+	primaryIdx := 0
+	for idx, i := range p.Images {
+		if i.IsPrimary {
+			primaryIdx = idx
+			break
+		}
+	}
+	for idx, i := range p.Images {
+		i.IsPrimary = idx == primaryIdx
+	}
+}
+
 func (p *ProductCategory) FromProto(category *pb.ProductCategory) {
 	p.ID = category.Id
 	p.Name = category.Name
 	p.IsActive = category.IsActive
+	p.ParentID = nil
+	if category.ParentId != 0 {
+		parentID := category.ParentId
+		p.ParentID = &parentID
+	}
+}
+
+// SubtreePath is the path of the children of the category.
+func (p *ProductCategory) SubtreePath() string {
+	return p.Path + strconv.FormatInt(p.ID, 10) + "/"
+}
+
+// IsAncestorOf reports whether other is below the category.
+func (p *ProductCategory) IsAncestorOf(other *ProductCategory) bool {
+	return strings.HasPrefix(other.Path, p.SubtreePath())
+}
+
+// SetParent places the category below parent, or at the root when parent is nil.
+func (p *ProductCategory) SetParent(parent *ProductCategory) {
+	if parent == nil {
+		p.ParentID = nil
+		p.Path = "/"
+		return
+	}
+	parentID := parent.ID
+	p.ParentID = &parentID
+	p.Path = parent.SubtreePath()
+}
+
+// BuildCategoryTree links the categories to their children and returns the top of the tree, which is
+// root when it is given or else the root categories. A category whose parent is not in categories is
+// left out with its subtree, e.g. below a deactivated category.
+func BuildCategoryTree(categories []*ProductCategory, root *ProductCategory) []*ProductCategory {
+	byID := make(map[int64]*ProductCategory)
+	for _, c := range categories {
+		c.Children = nil
+		byID[c.ID] = c
+	}
+
+	top := []*ProductCategory{}
+	for _, c := range categories {
+		if root != nil && c.ID == root.ID {
+			continue
+		}
+		if c.ParentID == nil {
+			if root == nil {
+				top = append(top, c)
+			}
+			continue
+		}
+		if parent := byID[*c.ParentID]; parent != nil {
+			parent.Children = append(parent.Children, c)
+		}
+	}
+	if root != nil {
+		if c := byID[root.ID]; c != nil {
+			return []*ProductCategory{c}
+		}
+	}
+	return top
 }
 
 func (p *ProductType) FromProto(prodType *pb.ProductType) error {
@@ -139,10 +349,29 @@ func (u *UnitOfMeasure) FromProto(uom *pb.UnitOfMeasure) error {
 	u.Name = uom.Name
 	u.Symbol = uom.Symbol
 	u.IsActive = uom.IsActive
+	u.BaseUnitID = nil
+	if uom.BaseUnitId != 0 {
+		baseUnitID := uom.BaseUnitId
+		u.BaseUnitID = &baseUnitID
+	}
+	u.ConversionFactor = uom.ConversionFactor
 
 	return nil
 }
 
+// IsBaseUnit reports whether the unit is not converted to another unit.
+func (u *UnitOfMeasure) IsBaseUnit() bool {
+	return u.BaseUnitID == nil
+}
+
+// ToBase converts a quantity in the unit to its base unit, rounded to the nearest whole base unit.
+func (u *UnitOfMeasure) ToBase(quantity int64) int64 {
+	if u.IsBaseUnit() {
+		return quantity
+	}
+	return int64(math.Round(float64(quantity) * u.ConversionFactor))
+}
+
 func (p *Product) ToProto() *pb.Product {
 	if p == nil {
 		return nil
@@ -150,48 +379,128 @@ func (p *Product) ToProto() *pb.Product {
 	images := []*pb.ProductImage{}
 	for _, i := range p.Images {
 		images = append(images, &pb.ProductImage{
-			Id:       i.ID.String(),
-			ImageId:  i.ImageId.String(),
-			ImageUrl: i.ImageURL,
+			Id:        i.ID.String(),
+			ImageId:   i.ImageId.String(),
+			ImageUrl:  i.ImageURL,
+			SortOrder: i.SortOrder,
+			IsPrimary: i.IsPrimary,
 		})
 	}
-	return &pb.Product{
+
+	attributes := []*pb.ProductAttribute{}
+	for _, a := range p.Attributes {
+		attributes = append(attributes, a.ToProto())
+	}
+
+	effectivePrice := p.Price
+	if p.ActiveDiscount != nil {
+		effectivePrice = p.EffectivePrice
+	}
+
+	// sort images primary first, then by sort order ascending
+	sort.SliceStable(images, func(i, j int) bool {
+		if images[i].IsPrimary != images[j].IsPrimary {
+			return images[i].IsPrimary
+		}
+		return images[i].SortOrder < images[j].SortOrder
+	})
+	product := &pb.Product{
 		Id:                  p.ID.String(),
 		StoreId:             p.StoreID.String(),
 		Name:                p.Name,
+		Description:         p.Description,
+		Sku:                 p.SKU,
+		Barcode:             p.Barcode,
 		SaleStatus:          p.SaleStatus,
-		Price:               p.Price,
+		Price:               p.Price.Float(),
+		PriceMoney:          p.Price.ToProto(),
 		Stock:               p.Stock,
 		Uom:                 p.Uom,
+		UomId:               p.UomID,
+		BaseStock:           p.BaseStock,
 		ProductTypeId:       p.ProductTypeID,
 		ProductTypeName:     p.ProductTypeName,
 		ProductCategoryId:   p.ProductCategoryID,
 		ProductCategoryName: p.ProductCategoryName,
 		Images:              images,
+		Version:             p.Version,
+		OriginalPrice:       p.Price.ToProto(),
+		EffectivePrice:      effectivePrice.ToProto(),
+		ActiveDiscount:      p.ActiveDiscount.ToProto(),
+		Attributes:          attributes,
+	}
+	if p.PublishAt != nil {
+		product.PublishAt = timestamppb.New(*p.PublishAt)
+	}
+	if p.UnpublishAt != nil {
+		product.UnpublishAt = timestamppb.New(*p.UnpublishAt)
 	}
+	return product
+}
+
+// ApplySchedule applies the publish and unpublish schedules due at now to the sale status and clears
+// them, the later schedule wins when both are due. It reports whether a schedule was due.
+func (p *Product) ApplySchedule(now time.Time) bool {
+	publish := p.PublishAt != nil && !p.PublishAt.After(now)
+	unpublish := p.UnpublishAt != nil && !p.UnpublishAt.After(now)
+	switch {
+	case publish && unpublish:
+		p.SaleStatus = p.PublishAt.After(*p.UnpublishAt)
+	case publish:
+		p.SaleStatus = true
+	case unpublish:
+		p.SaleStatus = false
+	default:
+		return false
+	}
+	if publish {
+		p.PublishAt = nil
+	}
+	if unpublish {
+		p.UnpublishAt = nil
+	}
+	return true
 }
 
 func (u *UnitOfMeasure) ToProto() *pb.UnitOfMeasure {
 	if u == nil {
 		return nil
 	}
-	return &pb.UnitOfMeasure{
-		Id:       u.ID,
-		Name:     u.Name,
-		Symbol:   u.Symbol,
-		IsActive: u.IsActive,
+	uom := &pb.UnitOfMeasure{
+		Id:               u.ID,
+		Name:             u.Name,
+		Symbol:           u.Symbol,
+		IsActive:         u.IsActive,
+		ConversionFactor: u.ConversionFactor,
 	}
+	if u.BaseUnitID != nil {
+		uom.BaseUnitId = *u.BaseUnitID
+	}
+	return uom
 }
 
 func (c *ProductCategory) ToProto() *pb.ProductCategory {
 	if c == nil {
 		return nil
 	}
-	return &pb.ProductCategory{
+	category := &pb.ProductCategory{
 		Id:       c.ID,
 		Name:     c.Name,
 		IsActive: c.IsActive,
+		Path:     c.Path,
+	}
+	if c.ParentID != nil {
+		category.ParentId = *c.ParentID
+	}
+	return category
+}
+
+func (c *ProductCategory) ToTreeProto() *pb.CategoryTreeNode {
+	node := &pb.CategoryTreeNode{Category: c.ToProto()}
+	for _, child := range c.Children {
+		node.Children = append(node.Children, child.ToTreeProto())
 	}
+	return node
 }
 
 func (t *ProductType) ToProto() *pb.ProductType {
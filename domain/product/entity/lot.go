@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+)
+
+// ProductLot is stock of a product received at once, e.g. a delivery of milk expiring on the same day.
+// Quantity is in the unit of measure of the product, RemainingQuantity is what is left of it once the
+// stock consumed from the lot is taken out.
+type ProductLot struct {
+	base_model.BaseModel
+	ProductID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	LotNumber         string    `gorm:"type:varchar(64);not null"`
+	Quantity          int64     `gorm:"type:bigint;not null"`
+	RemainingQuantity int64     `gorm:"type:bigint;not null"`
+	ExpiryDate        time.Time `gorm:"type:date;not null"`
+	ReceivedDate      time.Time `gorm:"type:date;not null"`
+	ProductName       string    `gorm:"->;-:migration"`
+}
+
+func (l *ProductLot) FromProto(lot *pb.ProductLot) error {
+	expiryDate, err := time.Parse(DateLayout, lot.ExpiryDate)
+	if err != nil {
+		return storeerror.Invalid("lot.expiry_date", errPb.StoreErrorCode_INVALID_DATE, lot.ExpiryDate)
+	}
+	l.ExpiryDate = expiryDate
+	if lot.ReceivedDate != "" {
+		receivedDate, err := time.Parse(DateLayout, lot.ReceivedDate)
+		if err != nil {
+			return storeerror.Invalid("lot.received_date", errPb.StoreErrorCode_INVALID_DATE, lot.ReceivedDate)
+		}
+		l.ReceivedDate = receivedDate
+	}
+	l.LotNumber = lot.LotNumber
+	l.Quantity = lot.Quantity
+	return nil
+}
+
+func (l *ProductLot) ToProto() *pb.ProductLot {
+	if l == nil {
+		return nil
+	}
+	return &pb.ProductLot{
+		Id:                l.ID.String(),
+		ProductId:         l.ProductID.String(),
+		LotNumber:         l.LotNumber,
+		Quantity:          l.Quantity,
+		RemainingQuantity: l.RemainingQuantity,
+		ExpiryDate:        l.ExpiryDate.Format(DateLayout),
+		ReceivedDate:      l.ReceivedDate.Format(DateLayout),
+		ProductName:       l.ProductName,
+	}
+}
+
+// ConsumeLots takes quantity out of the remaining quantity of the lots first-expiry-first-out, the lots
+// received first go first among the lots expiring on the same day. It returns the lots it took stock
+// from, the quantity the lots do not hold is left untracked.
+func ConsumeLots(lots []*ProductLot, quantity int64) []*ProductLot {
+	sort.SliceStable(lots, func(i, j int) bool {
+		if !lots[i].ExpiryDate.Equal(lots[j].ExpiryDate) {
+			return lots[i].ExpiryDate.Before(lots[j].ExpiryDate)
+		}
+		return lots[i].ReceivedDate.Before(lots[j].ReceivedDate)
+	})
+
+	consumed := []*ProductLot{}
+	for _, l := range lots {
+		if quantity <= 0 {
+			break
+		}
+		if l.RemainingQuantity <= 0 {
+			continue
+		}
+		taken := l.RemainingQuantity
+		if taken > quantity {
+			taken = quantity
+		}
+		l.RemainingQuantity -= taken
+		quantity -= taken
+		consumed = append(consumed, l)
+	}
+	return consumed
+}
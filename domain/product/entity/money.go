@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"math"
+	"regexp"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultCurrency is the currency of a price sent without a currency code.
+const DefaultCurrency = "IDR"
+
+// nanosPerMinorUnit converts between the nanos of a proto Money and minor units.
+const nanosPerMinorUnit = 10_000_000
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Money is an exact amount of a currency. MinorUnits are hundredths of the currency unit for every
+// currency, so amounts of different currencies are stored with the same scale.
+type Money struct {
+	MinorUnits int64  `gorm:"type:bigint;not null;default:0"`
+	Currency   string `gorm:"type:char(3);not null;default:'IDR'"`
+}
+
+// NewMoney returns an amount given in minor units, the default currency is used when currency is empty.
+func NewMoney(minorUnits int64, currency string) Money {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	return Money{MinorUnits: minorUnits, Currency: currency}
+}
+
+// MoneyFromFloat converts an amount given in currency units, it is rounded to the nearest minor unit.
+func MoneyFromFloat(amount float64, currency string) Money {
+	return NewMoney(int64(math.Round(amount*100)), currency)
+}
+
+// MoneyFromProto converts a proto Money, amounts finer than a minor unit are rejected.
+func MoneyFromProto(m *pb.Money) (Money, error) {
+	if m == nil {
+		return NewMoney(0, ""), nil
+	}
+	if m.Nanos%nanosPerMinorUnit != 0 {
+		return Money{}, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_MONEY_IS_TOO_PRECISE)
+	}
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return Money{}, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_MONEY_SIGNS_DIFFER)
+	}
+	money := NewMoney(m.Units*100+int64(m.Nanos/nanosPerMinorUnit), m.CurrencyCode)
+	if err := money.Validate(); err != nil {
+		return Money{}, err
+	}
+	return money, nil
+}
+
+// Validate checks that the currency is a 3 letter ISO 4217 code.
+func (m Money) Validate() error {
+	if !currencyCodePattern.MatchString(m.Currency) {
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_INVALID_CURRENCY_CODE, m.Currency)
+	}
+	return nil
+}
+
+// Float returns the amount in currency units, it is only exact up to 2^53 minor units.
+func (m Money) Float() float64 {
+	return float64(m.MinorUnits) / 100
+}
+
+func (m Money) ToProto() *pb.Money {
+	return &pb.Money{
+		CurrencyCode: m.Currency,
+		Units:        m.MinorUnits / 100,
+		Nanos:        int32(m.MinorUnits%100) * nanosPerMinorUnit,
+	}
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Discount types, a percentage discount takes value percent off the price and a fixed discount
+// takes amount off the price.
+const (
+	DiscountPercentage = "percentage"
+	DiscountFixed      = "fixed"
+)
+
+// ProductDiscount is a discount of a product applied from StartsAt until EndsAt, a discount without
+// EndsAt never ends.
+type ProductDiscount struct {
+	base_model.BaseModel
+	ProductID    uuid.UUID  `gorm:"type:uuid;index;not null"`
+	DiscountType string     `gorm:"type:varchar(20);not null"`
+	Value        float64    `gorm:"type:decimal(17,2);not null"`
+	Amount       Money      `gorm:"embedded;embeddedPrefix:amount_"`
+	StartsAt     time.Time  `gorm:"type:timestamptz;not null"`
+	EndsAt       *time.Time `gorm:"type:timestamptz;null"`
+}
+
+// IsActiveAt reports whether the discount applies at t.
+func (d *ProductDiscount) IsActiveAt(t time.Time) bool {
+	return !t.Before(d.StartsAt) && (d.EndsAt == nil || t.Before(*d.EndsAt))
+}
+
+// Apply returns the discounted price rounded to the nearest minor unit, it never goes below zero. A
+// fixed discount in another currency than the price does not apply.
+func (d *ProductDiscount) Apply(price Money) Money {
+	discounted := price.MinorUnits
+	switch d.DiscountType {
+	case DiscountPercentage:
+		discounted = int64(math.Round(float64(price.MinorUnits) * (100 - d.Value) / 100))
+	case DiscountFixed:
+		if d.Amount.Currency != price.Currency {
+			break
+		}
+		discounted = price.MinorUnits - d.Amount.MinorUnits
+	}
+	if discounted < 0 {
+		discounted = 0
+	}
+	return NewMoney(discounted, price.Currency)
+}
+
+func (d *ProductDiscount) ToProto() *pb.ProductDiscount {
+	if d == nil {
+		return nil
+	}
+	discount := &pb.ProductDiscount{
+		Id:           d.ID.String(),
+		ProductId:    d.ProductID.String(),
+		DiscountType: d.DiscountType,
+		Value:        d.Value,
+		StartsAt:     timestamppb.New(d.StartsAt),
+	}
+	if d.DiscountType == DiscountFixed {
+		discount.Value = d.Amount.Float()
+		discount.Amount = d.Amount.ToProto()
+	}
+	if d.EndsAt != nil {
+		discount.EndsAt = timestamppb.New(*d.EndsAt)
+	}
+	return discount
+}
+
+// ProductPriceHistory records a change of the price of a product.
+type ProductPriceHistory struct {
+	ID            uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	ProductID     uuid.UUID `gorm:"type:uuid;index;not null"`
+	Price         Money     `gorm:"embedded;embeddedPrefix:price_"`
+	PreviousPrice Money     `gorm:"embedded;embeddedPrefix:previous_price_"`
+	ChangedBy     uuid.UUID `gorm:"type:uuid;not null"`
+	ChangedAt     time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (ProductPriceHistory) TableName() string {
+	return "product_price_history"
+}
+
+func (h *ProductPriceHistory) ToProto() *pb.ProductPriceHistory {
+	return &pb.ProductPriceHistory{
+		Id:            h.ID.String(),
+		ProductId:     h.ProductID.String(),
+		Price:         h.Price.ToProto(),
+		PreviousPrice: h.PreviousPrice.ToProto(),
+		ChangedBy:     h.ChangedBy.String(),
+		ChangedAt:     timestamppb.New(h.ChangedAt),
+	}
+}
+
+// ApplyDiscounts sets the effective price of the product at t to the lowest price given by the
+// discounts active at t, the price is kept when no discount is active.
+func (p *Product) ApplyDiscounts(discounts []*ProductDiscount, t time.Time) {
+	p.EffectivePrice = p.Price
+	p.ActiveDiscount = nil
+	for _, d := range discounts {
+		if d.ProductID != p.ID || !d.IsActiveAt(t) {
+			continue
+		}
+		if price := d.Apply(p.Price); p.ActiveDiscount == nil || price.MinorUnits < p.EffectivePrice.MinorUnits {
+			p.EffectivePrice = price
+			p.ActiveDiscount = d
+		}
+	}
+}
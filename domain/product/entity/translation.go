@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+)
+
+// Entity types of the master data which names are translated.
+const (
+	TranslationProductCategory = "product_category"
+	TranslationProductType     = "product_type"
+	TranslationUnitOfMeasure   = "unit_of_measure"
+)
+
+// Translation is the name of a category, product type or unit of measure in a locale. The name stored
+// with the entity is its name in the default locale, it is used when a locale has no translation.
+type Translation struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"`
+	EntityType string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_master_data_translations_entity_locale"`
+	EntityID   int64     `gorm:"type:bigint;not null;uniqueIndex:idx_master_data_translations_entity_locale"`
+	Locale     string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_master_data_translations_entity_locale"`
+	Name       string    `gorm:"type:varchar(255);not null"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (Translation) TableName() string {
+	return "master_data_translations"
+}
+
+func (t *Translation) FromProto(translation *pb.Translation) {
+	t.Locale = translation.Locale
+	t.Name = translation.Name
+}
+
+func (t *Translation) ToProto() *pb.Translation {
+	return &pb.Translation{
+		Locale: t.Locale,
+		Name:   t.Name,
+	}
+}
+
+// TranslationsProto holds translations for the audit trail, which diffs proto messages.
+func TranslationsProto(entityType string, entityID int64, translations []*Translation) *pb.UpsertTranslationsRequest {
+	msg := &pb.UpsertTranslationsRequest{EntityType: entityType, EntityId: entityID}
+	for _, t := range translations {
+		msg.Translations = append(msg.Translations, t.ToProto())
+	}
+	return msg
+}
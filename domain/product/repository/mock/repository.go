@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
 	uuid "github.com/google/uuid"
@@ -41,6 +42,63 @@ func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimScheduledProducts mocks base method.
+func (m *MockProductRepository) ClaimScheduledProducts(ctx context.Context, now time.Time, limit int) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimScheduledProducts", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimScheduledProducts indicates an expected call of ClaimScheduledProducts.
+func (mr *MockProductRepositoryMockRecorder) ClaimScheduledProducts(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimScheduledProducts", reflect.TypeOf((*MockProductRepository)(nil).ClaimScheduledProducts), ctx, now, limit)
+}
+
+// CreateProductDiscount mocks base method.
+func (m *MockProductRepository) CreateProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductDiscount", ctx, discount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductDiscount indicates an expected call of CreateProductDiscount.
+func (mr *MockProductRepositoryMockRecorder) CreateProductDiscount(ctx, discount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductDiscount", reflect.TypeOf((*MockProductRepository)(nil).CreateProductDiscount), ctx, discount)
+}
+
+// CreateProductLot mocks base method.
+func (m *MockProductRepository) CreateProductLot(ctx context.Context, lot *entity.ProductLot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductLot", ctx, lot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductLot indicates an expected call of CreateProductLot.
+func (mr *MockProductRepositoryMockRecorder) CreateProductLot(ctx, lot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductLot", reflect.TypeOf((*MockProductRepository)(nil).CreateProductLot), ctx, lot)
+}
+
+// CreateProductPriceHistories mocks base method.
+func (m *MockProductRepository) CreateProductPriceHistories(ctx context.Context, histories []*entity.ProductPriceHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductPriceHistories", ctx, histories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductPriceHistories indicates an expected call of CreateProductPriceHistories.
+func (mr *MockProductRepositoryMockRecorder) CreateProductPriceHistories(ctx, histories any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductPriceHistories", reflect.TypeOf((*MockProductRepository)(nil).CreateProductPriceHistories), ctx, histories)
+}
+
 // DeleteProductById mocks base method.
 func (m *MockProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -55,6 +113,20 @@ func (mr *MockProductRepositoryMockRecorder) DeleteProductById(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductById", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductById), ctx, id)
 }
 
+// DeleteProductDiscount mocks base method.
+func (m *MockProductRepository) DeleteProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductDiscount", ctx, discount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductDiscount indicates an expected call of DeleteProductDiscount.
+func (mr *MockProductRepositoryMockRecorder) DeleteProductDiscount(ctx, discount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductDiscount", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductDiscount), ctx, discount)
+}
+
 // DeleteProductImages mocks base method.
 func (m *MockProductRepository) DeleteProductImages(ctx context.Context, productImages []*entity.ProductImage) error {
 	m.ctrl.T.Helper()
@@ -69,6 +141,126 @@ func (mr *MockProductRepositoryMockRecorder) DeleteProductImages(ctx, productIma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductImages", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductImages), ctx, productImages)
 }
 
+// GetActiveProductDiscounts mocks base method.
+func (m *MockProductRepository) GetActiveProductDiscounts(ctx context.Context, productIDs []uuid.UUID, at time.Time) ([]*entity.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveProductDiscounts", ctx, productIDs, at)
+	ret0, _ := ret[0].([]*entity.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveProductDiscounts indicates an expected call of GetActiveProductDiscounts.
+func (mr *MockProductRepositoryMockRecorder) GetActiveProductDiscounts(ctx, productIDs, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveProductDiscounts", reflect.TypeOf((*MockProductRepository)(nil).GetActiveProductDiscounts), ctx, productIDs, at)
+}
+
+// GetAvailableProductLots mocks base method.
+func (m *MockProductRepository) GetAvailableProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableProductLots", ctx, productID)
+	ret0, _ := ret[0].([]*entity.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableProductLots indicates an expected call of GetAvailableProductLots.
+func (mr *MockProductRepositoryMockRecorder) GetAvailableProductLots(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableProductLots", reflect.TypeOf((*MockProductRepository)(nil).GetAvailableProductLots), ctx, productID)
+}
+
+// GetExpiringProductLots mocks base method.
+func (m *MockProductRepository) GetExpiringProductLots(ctx context.Context, storeID uuid.UUID, until time.Time) ([]*entity.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiringProductLots", ctx, storeID, until)
+	ret0, _ := ret[0].([]*entity.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiringProductLots indicates an expected call of GetExpiringProductLots.
+func (mr *MockProductRepositoryMockRecorder) GetExpiringProductLots(ctx, storeID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiringProductLots", reflect.TypeOf((*MockProductRepository)(nil).GetExpiringProductLots), ctx, storeID, until)
+}
+
+// GetProductAttributeDefinitionById mocks base method.
+func (m *MockProductRepository) GetProductAttributeDefinitionById(ctx context.Context, id int64) (*entity.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributeDefinitionById", ctx, id)
+	ret0, _ := ret[0].(*entity.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributeDefinitionById indicates an expected call of GetProductAttributeDefinitionById.
+func (mr *MockProductRepositoryMockRecorder) GetProductAttributeDefinitionById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributeDefinitionById", reflect.TypeOf((*MockProductRepository)(nil).GetProductAttributeDefinitionById), ctx, id)
+}
+
+// GetProductAttributeDefinitionByName mocks base method.
+func (m *MockProductRepository) GetProductAttributeDefinitionByName(ctx context.Context, productTypeID int64, name string) (*entity.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributeDefinitionByName", ctx, productTypeID, name)
+	ret0, _ := ret[0].(*entity.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributeDefinitionByName indicates an expected call of GetProductAttributeDefinitionByName.
+func (mr *MockProductRepositoryMockRecorder) GetProductAttributeDefinitionByName(ctx, productTypeID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributeDefinitionByName", reflect.TypeOf((*MockProductRepository)(nil).GetProductAttributeDefinitionByName), ctx, productTypeID, name)
+}
+
+// GetProductAttributeDefinitions mocks base method.
+func (m *MockProductRepository) GetProductAttributeDefinitions(ctx context.Context, productTypeIDs []int64) ([]*entity.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributeDefinitions", ctx, productTypeIDs)
+	ret0, _ := ret[0].([]*entity.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributeDefinitions indicates an expected call of GetProductAttributeDefinitions.
+func (mr *MockProductRepositoryMockRecorder) GetProductAttributeDefinitions(ctx, productTypeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributeDefinitions", reflect.TypeOf((*MockProductRepository)(nil).GetProductAttributeDefinitions), ctx, productTypeIDs)
+}
+
+// GetProductAttributeDefinitionsByIds mocks base method.
+func (m *MockProductRepository) GetProductAttributeDefinitionsByIds(ctx context.Context, ids []int64) ([]*entity.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributeDefinitionsByIds", ctx, ids)
+	ret0, _ := ret[0].([]*entity.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributeDefinitionsByIds indicates an expected call of GetProductAttributeDefinitionsByIds.
+func (mr *MockProductRepositoryMockRecorder) GetProductAttributeDefinitionsByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributeDefinitionsByIds", reflect.TypeOf((*MockProductRepository)(nil).GetProductAttributeDefinitionsByIds), ctx, ids)
+}
+
+// GetProductByBarcode mocks base method.
+func (m *MockProductRepository) GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByBarcode", ctx, storeID, barcode)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByBarcode indicates an expected call of GetProductByBarcode.
+func (mr *MockProductRepositoryMockRecorder) GetProductByBarcode(ctx, storeID, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByBarcode", reflect.TypeOf((*MockProductRepository)(nil).GetProductByBarcode), ctx, storeID, barcode)
+}
+
 // GetProductById mocks base method.
 func (m *MockProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
 	m.ctrl.T.Helper()
@@ -115,18 +307,63 @@ func (mr *MockProductRepositoryMockRecorder) GetProductCategoryById(ctx, id any)
 }
 
 // GetProductCategoryByName mocks base method.
-func (m *MockProductRepository) GetProductCategoryByName(ctx context.Context, name string) (*entity.ProductCategory, error) {
+func (m *MockProductRepository) GetProductCategoryByName(ctx context.Context, parentID *int64, name string) (*entity.ProductCategory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductCategoryByName", ctx, name)
+	ret := m.ctrl.Call(m, "GetProductCategoryByName", ctx, parentID, name)
 	ret0, _ := ret[0].(*entity.ProductCategory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductCategoryByName indicates an expected call of GetProductCategoryByName.
-func (mr *MockProductRepositoryMockRecorder) GetProductCategoryByName(ctx, name any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) GetProductCategoryByName(ctx, parentID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategoryByName", reflect.TypeOf((*MockProductRepository)(nil).GetProductCategoryByName), ctx, parentID, name)
+}
+
+// GetProductCategorySubtree mocks base method.
+func (m *MockProductRepository) GetProductCategorySubtree(ctx context.Context, root *entity.ProductCategory, isIncludeDeactivated bool) ([]*entity.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCategorySubtree", ctx, root, isIncludeDeactivated)
+	ret0, _ := ret[0].([]*entity.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCategorySubtree indicates an expected call of GetProductCategorySubtree.
+func (mr *MockProductRepositoryMockRecorder) GetProductCategorySubtree(ctx, root, isIncludeDeactivated any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategoryByName", reflect.TypeOf((*MockProductRepository)(nil).GetProductCategoryByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategorySubtree", reflect.TypeOf((*MockProductRepository)(nil).GetProductCategorySubtree), ctx, root, isIncludeDeactivated)
+}
+
+// GetProductDiscountById mocks base method.
+func (m *MockProductRepository) GetProductDiscountById(ctx context.Context, id uuid.UUID) (*entity.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDiscountById", ctx, id)
+	ret0, _ := ret[0].(*entity.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDiscountById indicates an expected call of GetProductDiscountById.
+func (mr *MockProductRepositoryMockRecorder) GetProductDiscountById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDiscountById", reflect.TypeOf((*MockProductRepository)(nil).GetProductDiscountById), ctx, id)
+}
+
+// GetProductDiscounts mocks base method.
+func (m *MockProductRepository) GetProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*entity.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDiscounts", ctx, productID)
+	ret0, _ := ret[0].([]*entity.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDiscounts indicates an expected call of GetProductDiscounts.
+func (mr *MockProductRepositoryMockRecorder) GetProductDiscounts(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDiscounts", reflect.TypeOf((*MockProductRepository)(nil).GetProductDiscounts), ctx, productID)
 }
 
 // GetProductImagesByProductIds mocks base method.
@@ -145,6 +382,36 @@ func (mr *MockProductRepositoryMockRecorder) GetProductImagesByProductIds(ctx, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductImagesByProductIds", reflect.TypeOf((*MockProductRepository)(nil).GetProductImagesByProductIds), ctx, productIds)
 }
 
+// GetProductLots mocks base method.
+func (m *MockProductRepository) GetProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductLots", ctx, productID)
+	ret0, _ := ret[0].([]*entity.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductLots indicates an expected call of GetProductLots.
+func (mr *MockProductRepositoryMockRecorder) GetProductLots(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductLots", reflect.TypeOf((*MockProductRepository)(nil).GetProductLots), ctx, productID)
+}
+
+// GetProductPriceHistory mocks base method.
+func (m *MockProductRepository) GetProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*entity.ProductPriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductPriceHistory", ctx, productID, page, limit)
+	ret0, _ := ret[0].([]*entity.ProductPriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductPriceHistory indicates an expected call of GetProductPriceHistory.
+func (mr *MockProductRepositoryMockRecorder) GetProductPriceHistory(ctx, productID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductPriceHistory", reflect.TypeOf((*MockProductRepository)(nil).GetProductPriceHistory), ctx, productID, page, limit)
+}
+
 // GetProductTypeByName mocks base method.
 func (m *MockProductRepository) GetProductTypeByName(ctx context.Context, productCategoryID int64, name string) (*entity.ProductType, error) {
 	m.ctrl.T.Helper()
@@ -190,19 +457,49 @@ func (mr *MockProductRepositoryMockRecorder) GetProductTypesByIds(ctx, typeIds a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTypesByIds", reflect.TypeOf((*MockProductRepository)(nil).GetProductTypesByIds), ctx, typeIds)
 }
 
+// GetProductsByIds mocks base method.
+func (m *MockProductRepository) GetProductsByIds(ctx context.Context, ids []uuid.UUID) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByIds", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByIds indicates an expected call of GetProductsByIds.
+func (mr *MockProductRepositoryMockRecorder) GetProductsByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByIds", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByIds), ctx, ids)
+}
+
 // GetProductsByStoreId mocks base method.
-func (m *MockProductRepository) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) ([]*entity.Product, error) {
+func (m *MockProductRepository) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, productCategory *entity.ProductCategory, attributes map[int64]string, isIncludeDeactivated bool) ([]*entity.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByStoreId", ctx, storeID, productTypeId, isIncludeDeactivated)
+	ret := m.ctrl.Call(m, "GetProductsByStoreId", ctx, storeID, productTypeId, productCategory, attributes, isIncludeDeactivated)
 	ret0, _ := ret[0].([]*entity.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductsByStoreId indicates an expected call of GetProductsByStoreId.
-func (mr *MockProductRepositoryMockRecorder) GetProductsByStoreId(ctx, storeID, productTypeId, isIncludeDeactivated any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) GetProductsByStoreId(ctx, storeID, productTypeId, productCategory, attributes, isIncludeDeactivated any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreId", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByStoreId), ctx, storeID, productTypeId, isIncludeDeactivated)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreId", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByStoreId), ctx, storeID, productTypeId, productCategory, attributes, isIncludeDeactivated)
+}
+
+// GetProductsByStoreIdAndBarcodes mocks base method.
+func (m *MockProductRepository) GetProductsByStoreIdAndBarcodes(ctx context.Context, storeID uuid.UUID, barcodes []string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByStoreIdAndBarcodes", ctx, storeID, barcodes)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByStoreIdAndBarcodes indicates an expected call of GetProductsByStoreIdAndBarcodes.
+func (mr *MockProductRepositoryMockRecorder) GetProductsByStoreIdAndBarcodes(ctx, storeID, barcodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreIdAndBarcodes", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByStoreIdAndBarcodes), ctx, storeID, barcodes)
 }
 
 // GetProductsByStoreIdAndNames mocks base method.
@@ -220,6 +517,66 @@ func (mr *MockProductRepositoryMockRecorder) GetProductsByStoreIdAndNames(ctx, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreIdAndNames", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByStoreIdAndNames), ctx, storeID, names)
 }
 
+// GetProductsByStoreIdAndSkus mocks base method.
+func (m *MockProductRepository) GetProductsByStoreIdAndSkus(ctx context.Context, storeID uuid.UUID, skus []string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByStoreIdAndSkus", ctx, storeID, skus)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByStoreIdAndSkus indicates an expected call of GetProductsByStoreIdAndSkus.
+func (mr *MockProductRepositoryMockRecorder) GetProductsByStoreIdAndSkus(ctx, storeID, skus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreIdAndSkus", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByStoreIdAndSkus), ctx, storeID, skus)
+}
+
+// GetTranslationByName mocks base method.
+func (m *MockProductRepository) GetTranslationByName(ctx context.Context, entityType, locale, name string) (*entity.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslationByName", ctx, entityType, locale, name)
+	ret0, _ := ret[0].(*entity.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslationByName indicates an expected call of GetTranslationByName.
+func (mr *MockProductRepositoryMockRecorder) GetTranslationByName(ctx, entityType, locale, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslationByName", reflect.TypeOf((*MockProductRepository)(nil).GetTranslationByName), ctx, entityType, locale, name)
+}
+
+// GetTranslations mocks base method.
+func (m *MockProductRepository) GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*entity.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslations", ctx, entityType, entityID)
+	ret0, _ := ret[0].([]*entity.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslations indicates an expected call of GetTranslations.
+func (mr *MockProductRepositoryMockRecorder) GetTranslations(ctx, entityType, entityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslations", reflect.TypeOf((*MockProductRepository)(nil).GetTranslations), ctx, entityType, entityID)
+}
+
+// GetTranslationsByLocale mocks base method.
+func (m *MockProductRepository) GetTranslationsByLocale(ctx context.Context, entityType, locale string, entityIDs []int64) ([]*entity.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslationsByLocale", ctx, entityType, locale, entityIDs)
+	ret0, _ := ret[0].([]*entity.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslationsByLocale indicates an expected call of GetTranslationsByLocale.
+func (mr *MockProductRepositoryMockRecorder) GetTranslationsByLocale(ctx, entityType, locale, entityIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslationsByLocale", reflect.TypeOf((*MockProductRepository)(nil).GetTranslationsByLocale), ctx, entityType, locale, entityIDs)
+}
+
 // GetUnitOfMeasureById mocks base method.
 func (m *MockProductRepository) GetUnitOfMeasureById(ctx context.Context, uomId int64) (*entity.UnitOfMeasure, error) {
 	m.ctrl.T.Helper()
@@ -295,44 +652,88 @@ func (mr *MockProductRepositoryMockRecorder) GetUnitOfMeasuresByIds(ctx, uomIds
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitOfMeasuresByIds", reflect.TypeOf((*MockProductRepository)(nil).GetUnitOfMeasuresByIds), ctx, uomIds)
 }
 
-// InitiateTransaction mocks base method.
-func (m *MockProductRepository) InitiateTransaction(ctx context.Context) bool {
+// LockProductCategoryTree mocks base method.
+func (m *MockProductRepository) LockProductCategoryTree(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockProductCategoryTree", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockProductCategoryTree indicates an expected call of LockProductCategoryTree.
+func (mr *MockProductRepositoryMockRecorder) LockProductCategoryTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockProductCategoryTree", reflect.TypeOf((*MockProductRepository)(nil).LockProductCategoryTree), ctx)
+}
+
+// MoveProductCategory mocks base method.
+func (m *MockProductRepository) MoveProductCategory(ctx context.Context, prodCategory *entity.ProductCategory, oldSubtreePath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitiateTransaction", ctx)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "MoveProductCategory", ctx, prodCategory, oldSubtreePath)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InitiateTransaction indicates an expected call of InitiateTransaction.
-func (mr *MockProductRepositoryMockRecorder) InitiateTransaction(ctx any) *gomock.Call {
+// MoveProductCategory indicates an expected call of MoveProductCategory.
+func (mr *MockProductRepositoryMockRecorder) MoveProductCategory(ctx, prodCategory, oldSubtreePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransaction", reflect.TypeOf((*MockProductRepository)(nil).InitiateTransaction), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProductCategory", reflect.TypeOf((*MockProductRepository)(nil).MoveProductCategory), ctx, prodCategory, oldSubtreePath)
 }
 
-// TransactionCommit mocks base method.
-func (m *MockProductRepository) TransactionCommit() error {
+// ReplaceProductAttributes mocks base method.
+func (m *MockProductRepository) ReplaceProductAttributes(ctx context.Context, products []*entity.Product) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransactionCommit")
+	ret := m.ctrl.Call(m, "ReplaceProductAttributes", ctx, products)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// TransactionCommit indicates an expected call of TransactionCommit.
-func (mr *MockProductRepositoryMockRecorder) TransactionCommit() *gomock.Call {
+// ReplaceProductAttributes indicates an expected call of ReplaceProductAttributes.
+func (mr *MockProductRepositoryMockRecorder) ReplaceProductAttributes(ctx, products any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionCommit", reflect.TypeOf((*MockProductRepository)(nil).TransactionCommit))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProductAttributes", reflect.TypeOf((*MockProductRepository)(nil).ReplaceProductAttributes), ctx, products)
 }
 
-// TransactionRollback mocks base method.
-func (m *MockProductRepository) TransactionRollback() {
+// UpdateProductLotsRemainingQuantity mocks base method.
+func (m *MockProductRepository) UpdateProductLotsRemainingQuantity(ctx context.Context, lots []*entity.ProductLot) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "TransactionRollback")
+	ret := m.ctrl.Call(m, "UpdateProductLotsRemainingQuantity", ctx, lots)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// TransactionRollback indicates an expected call of TransactionRollback.
-func (mr *MockProductRepositoryMockRecorder) TransactionRollback() *gomock.Call {
+// UpdateProductLotsRemainingQuantity indicates an expected call of UpdateProductLotsRemainingQuantity.
+func (mr *MockProductRepositoryMockRecorder) UpdateProductLotsRemainingQuantity(ctx, lots any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionRollback", reflect.TypeOf((*MockProductRepository)(nil).TransactionRollback))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductLotsRemainingQuantity", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductLotsRemainingQuantity), ctx, lots)
+}
+
+// UpdateProductsBaseStock mocks base method.
+func (m *MockProductRepository) UpdateProductsBaseStock(ctx context.Context, uomId int64, conversionFactor float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductsBaseStock", ctx, uomId, conversionFactor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductsBaseStock indicates an expected call of UpdateProductsBaseStock.
+func (mr *MockProductRepositoryMockRecorder) UpdateProductsBaseStock(ctx, uomId, conversionFactor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductsBaseStock", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductsBaseStock), ctx, uomId, conversionFactor)
+}
+
+// UpsertProductAttributeDefinition mocks base method.
+func (m *MockProductRepository) UpsertProductAttributeDefinition(ctx context.Context, definition *entity.ProductAttributeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProductAttributeDefinition", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertProductAttributeDefinition indicates an expected call of UpsertProductAttributeDefinition.
+func (mr *MockProductRepositoryMockRecorder) UpsertProductAttributeDefinition(ctx, definition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProductAttributeDefinition", reflect.TypeOf((*MockProductRepository)(nil).UpsertProductAttributeDefinition), ctx, definition)
 }
 
 // UpsertProductCategory mocks base method.
@@ -391,6 +792,20 @@ func (mr *MockProductRepositoryMockRecorder) UpsertProducts(ctx, product any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProducts", reflect.TypeOf((*MockProductRepository)(nil).UpsertProducts), ctx, product)
 }
 
+// UpsertTranslations mocks base method.
+func (m *MockProductRepository) UpsertTranslations(ctx context.Context, translations []*entity.Translation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTranslations", ctx, translations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTranslations indicates an expected call of UpsertTranslations.
+func (mr *MockProductRepositoryMockRecorder) UpsertTranslations(ctx, translations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTranslations", reflect.TypeOf((*MockProductRepository)(nil).UpsertTranslations), ctx, translations)
+}
+
 // UpsertUnitOfMeasure mocks base method.
 func (m *MockProductRepository) UpsertUnitOfMeasure(ctx context.Context, uom *entity.UnitOfMeasure) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (p *Postgres) GetProductAttributeDefinitions(ctx context.Context, productTypeIDs []int64) ([]*entity.ProductAttributeDefinition, error) {
+	definitions := []*entity.ProductAttributeDefinition{}
+	if len(productTypeIDs) == 0 {
+		return definitions, nil
+	}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_type_id IN ?", productTypeIDs).
+		Order("product_type_id, name").
+		Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+func (p *Postgres) GetProductAttributeDefinitionsByIds(ctx context.Context, ids []int64) ([]*entity.ProductAttributeDefinition, error) {
+	definitions := []*entity.ProductAttributeDefinition{}
+	if len(ids) == 0 {
+		return definitions, nil
+	}
+	if err := transaction.DB(ctx, p.db).Where("id IN ?", ids).Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+func (p *Postgres) GetProductAttributeDefinitionById(ctx context.Context, id int64) (*entity.ProductAttributeDefinition, error) {
+	var definition entity.ProductAttributeDefinition
+	if err := transaction.DB(ctx, p.db).First(&definition, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &definition, nil
+}
+
+func (p *Postgres) GetProductAttributeDefinitionByName(ctx context.Context, productTypeID int64, name string) (*entity.ProductAttributeDefinition, error) {
+	var definition entity.ProductAttributeDefinition
+	if err := transaction.DB(ctx, p.db).
+		Where("product_type_id = ? AND LOWER(name) = ?", productTypeID, strings.ToLower(name)).
+		First(&definition).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &definition, nil
+}
+
+func (p *Postgres) UpsertProductAttributeDefinition(ctx context.Context, definition *entity.ProductAttributeDefinition) error {
+	return transaction.DB(ctx, p.db).Save(definition).Error
+}
+
+// ReplaceProductAttributes removes the stored attribute values of the products and saves their
+// Attributes instead.
+func (p *Postgres) ReplaceProductAttributes(ctx context.Context, products []*entity.Product) error {
+	db := transaction.DB(ctx, p.db)
+	productIDs := []uuid.UUID{}
+	attributes := []*entity.ProductAttribute{}
+	for _, prod := range products {
+		productIDs = append(productIDs, prod.ID)
+		for _, a := range prod.Attributes {
+			a.ID = 0
+			a.ProductID = prod.ID
+			attributes = append(attributes, a)
+		}
+	}
+	if err := db.Where("product_id IN ?", productIDs).Delete(&entity.ProductAttribute{}).Error; err != nil {
+		return err
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+	return db.Omit("Attribute").Create(attributes).Error
+}
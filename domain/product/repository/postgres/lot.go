@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+)
+
+func (p *Postgres) CreateProductLot(ctx context.Context, lot *entity.ProductLot) error {
+	return transaction.DB(ctx, p.db).Create(lot).Error
+}
+
+func (p *Postgres) GetProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error) {
+	lots := []*entity.ProductLot{}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_id = ?", productID).
+		Order("expiry_date, received_date, created_at").
+		Find(&lots).Error; err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (p *Postgres) GetAvailableProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error) {
+	lots := []*entity.ProductLot{}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_id = ? AND remaining_quantity > 0", productID).
+		Order("expiry_date, received_date, created_at").
+		Find(&lots).Error; err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (p *Postgres) UpdateProductLotsRemainingQuantity(ctx context.Context, lots []*entity.ProductLot) error {
+	db := transaction.DB(ctx, p.db)
+	for _, l := range lots {
+		if err := db.Model(l).Update("remaining_quantity", l.RemainingQuantity).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Postgres) GetExpiringProductLots(ctx context.Context, storeID uuid.UUID, until time.Time) ([]*entity.ProductLot, error) {
+	lots := []*entity.ProductLot{}
+	if err := transaction.DB(ctx, p.db).
+		Select("product_lots.*, products.name AS product_name").
+		Joins("JOIN products ON products.id = product_lots.product_id AND products.deleted_at IS NULL").
+		Where("products.store_id = ? AND product_lots.remaining_quantity > 0 AND product_lots.expiry_date <= ?", storeID, until).
+		Order("product_lots.expiry_date, products.name").
+		Find(&lots).Error; err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
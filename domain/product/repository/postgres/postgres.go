@@ -3,37 +3,58 @@ package postgres
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/product/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 type Postgres struct {
-	db  *gorm.DB
-	trx *gorm.DB
+	db *gorm.DB
 }
 
 func NewPostgres(db *gorm.DB) *Postgres {
-	return &Postgres{db, nil}
+	return &Postgres{db}
 }
 
-func (p *Postgres) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) ([]*entity.Product, error) {
+func (p *Postgres) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, productCategory *entity.ProductCategory, attributes map[int64]string, isIncludeDeactivated bool) ([]*entity.Product, error) {
 	prods := []*entity.Product{}
-	tx := p.db.WithContext(ctx).
+	tx := transaction.DB(ctx, p.db).
 		Preload("Images").
+		Preload("Attributes.Attribute").
 		Preload("ProductType").
 		Preload("ProductType.ProductCategory").
 		Where("store_id = ?", storeID)
 	if !isIncludeDeactivated {
-		tx = tx.Where("sale_status = ?", true)
+		// the schedules due now are applied like entity.Product.ApplySchedule, the scheduler may not have applied them yet
+		now := time.Now()
+		tx = tx.Where(`CASE
+			WHEN unpublish_at <= ? AND (publish_at IS NULL OR publish_at > ? OR publish_at <= unpublish_at) THEN false
+			WHEN publish_at <= ? THEN true
+			ELSE sale_status END`, now, now, now)
 	}
 	if productTypeId != nil {
 		tx = tx.Where("product_type_id = ?", *productTypeId)
 	}
+	if productCategory != nil {
+		tx = tx.Where(`product_type_id IN (SELECT pt.id FROM product_types pt
+			JOIN product_categories pc ON pc.id = pt.product_category_id
+			WHERE pc.id = ? OR pc.path LIKE ?)`, productCategory.ID, productCategory.SubtreePath()+"%")
+	}
+	attributeIDs := make([]int64, 0, len(attributes))
+	for id := range attributes {
+		attributeIDs = append(attributeIDs, id)
+	}
+	sort.Slice(attributeIDs, func(i, j int) bool { return attributeIDs[i] < attributeIDs[j] })
+	for _, id := range attributeIDs {
+		tx = tx.Where(`id IN (SELECT product_id FROM product_attributes
+			WHERE attribute_id = ? AND LOWER(value) = ?)`, id, strings.ToLower(attributes[id]))
+	}
 	tx = tx.Order("name ASC")
 	err := tx.Find(&prods).Error
 	if err != nil {
@@ -54,8 +75,9 @@ func (p *Postgres) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID,
 
 func (p *Postgres) GetProductById(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
 	var prod entity.Product
-	tx := p.db.WithContext(ctx).
+	tx := transaction.DB(ctx, p.db).
 		Preload("Images").
+		Preload("Attributes.Attribute").
 		Preload("ProductType").
 		Preload("ProductType.ProductCategory").
 		First(&prod, id)
@@ -72,13 +94,34 @@ func (p *Postgres) GetProductById(ctx context.Context, id uuid.UUID) (*entity.Pr
 	return &prod, nil
 }
 
+// GetProductsByIds returns the products with the given ids, missing products are left out.
+func (p *Postgres) GetProductsByIds(ctx context.Context, ids []uuid.UUID) ([]*entity.Product, error) {
+	var prods []*entity.Product
+	tx := transaction.DB(ctx, p.db).
+		Preload("Images").
+		Preload("Attributes.Attribute").
+		Preload("ProductType").
+		Preload("ProductType.ProductCategory").
+		Where("id IN ?", ids).
+		Find(&prods)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	for _, prod := range prods {
+		prod.ProductTypeName = prod.ProductType.Name
+		prod.ProductCategoryID = prod.ProductType.ProductCategoryID
+		prod.ProductCategoryName = prod.ProductType.ProductCategory.Name
+	}
+	return prods, nil
+}
+
 func (p *Postgres) GetProductsByStoreIdAndNames(ctx context.Context, storeID uuid.UUID, names []string) ([]*entity.Product, error) {
 	lowerCaseNames := []string{}
 	for _, s := range names {
 		lowerCaseNames = append(lowerCaseNames, strings.ToLower(s))
 	}
 	prods := []*entity.Product{}
-	tx := p.db.WithContext(ctx).
+	tx := transaction.DB(ctx, p.db).
 		Preload("Images").
 		Where("store_id = ? AND LOWER(name) IN ?", storeID, lowerCaseNames).Find(&prods)
 	if tx.Error != nil {
@@ -90,85 +133,103 @@ func (p *Postgres) GetProductsByStoreIdAndNames(ctx context.Context, storeID uui
 	return prods, nil
 }
 
-func (p *Postgres) InitiateTransaction(ctx context.Context) bool {
-	newTrx := p.trx == nil
-	if newTrx {
-		p.trx = p.db.WithContext(ctx).Begin()
+func (p *Postgres) GetProductsByStoreIdAndSkus(ctx context.Context, storeID uuid.UUID, skus []string) ([]*entity.Product, error) {
+	lowerCaseSkus := []string{}
+	for _, s := range skus {
+		lowerCaseSkus = append(lowerCaseSkus, strings.ToLower(s))
 	}
-	return newTrx
-}
-
-func (p *Postgres) TransactionRollback() {
-	p.trx.Rollback()
-	p.trx = nil
+	prods := []*entity.Product{}
+	if len(lowerCaseSkus) == 0 {
+		return prods, nil
+	}
+	tx := transaction.DB(ctx, p.db).
+		Where("store_id = ? AND LOWER(sku) IN ?", storeID, lowerCaseSkus).Find(&prods)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return prods, nil
 }
 
-func (p *Postgres) TransactionCommit() error {
-	defer func() {
-		p.trx = nil
-	}()
-
-	if p.trx == nil {
-		return status.Errorf(codes.Internal, "No transaction committed")
+func (p *Postgres) GetProductsByStoreIdAndBarcodes(ctx context.Context, storeID uuid.UUID, barcodes []string) ([]*entity.Product, error) {
+	prods := []*entity.Product{}
+	if len(barcodes) == 0 {
+		return prods, nil
 	}
-
-	if err := p.trx.Commit().Error; err != nil {
-		return err
+	tx := transaction.DB(ctx, p.db).
+		Where("store_id = ? AND barcode IN ?", storeID, barcodes).Find(&prods)
+	if tx.Error != nil {
+		return nil, tx.Error
 	}
-	return nil
+	return prods, nil
 }
 
-func (p *Postgres) UpsertProducts(ctx context.Context, products []*entity.Product) error {
-	if err := p.trx.Omit("Images").
-		Save(products).Error; err != nil {
-		p.trx.Rollback()
-		return err
+func (p *Postgres) GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*entity.Product, error) {
+	var prod entity.Product
+	tx := transaction.DB(ctx, p.db).
+		Preload("Images").
+		Preload("Attributes.Attribute").
+		Preload("ProductType").
+		Preload("ProductType.ProductCategory").
+		Where("store_id = ? AND barcode = ?", storeID, barcode).
+		First(&prod)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, tx.Error
 	}
+	prod.ProductTypeName = prod.ProductType.Name
+	prod.ProductCategoryID = prod.ProductType.ProductCategoryID
+	prod.ProductCategoryName = prod.ProductType.ProductCategory.Name
 
-	return nil
+	return &prod, nil
 }
 
-func (p *Postgres) UpsertProductImages(ctx context.Context, productImages []*entity.ProductImage) error {
-	funcScopeTrx := p.InitiateTransaction(ctx)
+// UpsertProducts creates the products without id and updates the others when their version still
+// matches the stored one. The version of every saved product is incremented.
+func (p *Postgres) UpsertProducts(ctx context.Context, products []*entity.Product) error {
+	db := transaction.DB(ctx, p.db)
+	newProducts := []*entity.Product{}
+	for _, prod := range products {
+		if prod.ID == uuid.Nil {
+			prod.Version = 1
+			newProducts = append(newProducts, prod)
+			continue
+		}
 
-	if err := p.trx.Save(productImages).Error; err != nil {
-		p.trx.Rollback()
-		return err
+		version := prod.Version
+		prod.Version++
+		tx := db.Model(prod).Select("*").Omit("Images", "Attributes").Where("version = ?", version).Updates(prod)
+		if tx.Error != nil {
+			return tx.Error
+		}
+		if tx.RowsAffected == 0 {
+			prod.Version = version
+			return repository.ErrVersionConflict
+		}
 	}
 
-	if funcScopeTrx {
-		return p.TransactionCommit()
+	if len(newProducts) == 0 {
+		return nil
 	}
+	return db.Omit("Images", "Attributes").Create(newProducts).Error
+}
 
-	return nil
+func (p *Postgres) UpsertProductImages(ctx context.Context, productImages []*entity.ProductImage) error {
+	return transaction.DB(ctx, p.db).Save(productImages).Error
 }
 
 func (p *Postgres) DeleteProductImages(ctx context.Context, productImages []*entity.ProductImage) error {
-	funcScopeTrx := p.InitiateTransaction(ctx)
-
-	if err := p.trx.Delete(productImages).Error; err != nil {
-		p.trx.Rollback()
-		return err
-	}
-
-	if funcScopeTrx {
-		return p.TransactionCommit()
-	}
-
-	return nil
+	return transaction.DB(ctx, p.db).Delete(productImages).Error
 }
 
 func (p *Postgres) DeleteProductById(ctx context.Context, id uuid.UUID) error {
-	if err := p.trx.Where("id = ?", id.String()).Delete(&entity.Product{}).Error; err != nil {
-		return err
-	}
-
-	return nil
+	return transaction.DB(ctx, p.db).Where("id = ?", id.String()).Delete(&entity.Product{}).Error
 }
 
 func (p *Postgres) GetProductImagesByProductIds(ctx context.Context, productIds []uuid.UUID) ([]*entity.ProductImage, map[uuid.UUID][]*entity.ProductImage, error) {
 	prodImages := []*entity.ProductImage{}
-	if tx := p.db.WithContext(ctx).Where("product_id IN ?", productIds).Find(&prodImages); tx.Error != nil {
+	if tx := transaction.DB(ctx, p.db).Where("product_id IN ?", productIds).Find(&prodImages); tx.Error != nil {
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			return nil, nil, nil
 		}
@@ -185,7 +246,7 @@ func (p *Postgres) GetProductImagesByProductIds(ctx context.Context, productIds
 
 func (p *Postgres) GetUnitOfMeasureByName(ctx context.Context, name string) (*entity.UnitOfMeasure, error) {
 	uom := entity.UnitOfMeasure{}
-	err := p.db.WithContext(ctx).Where("LOWER(name) = ?", strings.ToLower(name)).First(&uom).Error
+	err := transaction.DB(ctx, p.db).Where("LOWER(name) = ?", strings.ToLower(name)).First(&uom).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -197,7 +258,7 @@ func (p *Postgres) GetUnitOfMeasureByName(ctx context.Context, name string) (*en
 
 func (p *Postgres) GetUnitOfMeasureBySymbol(ctx context.Context, symbol string) (*entity.UnitOfMeasure, error) {
 	uom := entity.UnitOfMeasure{}
-	err := p.db.WithContext(ctx).Where("symbol = ?", symbol).First(&uom).Error
+	err := transaction.DB(ctx, p.db).Where("symbol = ?", symbol).First(&uom).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -209,7 +270,7 @@ func (p *Postgres) GetUnitOfMeasureBySymbol(ctx context.Context, symbol string)
 
 func (p *Postgres) GetUnitOfMeasureById(ctx context.Context, uomId int64) (*entity.UnitOfMeasure, error) {
 	uom := entity.UnitOfMeasure{}
-	err := p.db.WithContext(ctx).First(&uom, uomId).Error
+	err := transaction.DB(ctx, p.db).First(&uom, uomId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -223,7 +284,7 @@ func (p *Postgres) GetUnitOfMeasureById(ctx context.Context, uomId int64) (*enti
 func (p *Postgres) GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) ([]*entity.UnitOfMeasure, error) {
 	uom := []*entity.UnitOfMeasure{}
 	var err error
-	tx := p.db.WithContext(ctx)
+	tx := transaction.DB(ctx, p.db)
 	if !isIncludeDeactivated {
 		tx = tx.Where("is_active = ?", true)
 	}
@@ -241,7 +302,7 @@ func (p *Postgres) GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated b
 
 func (p *Postgres) GetUnitOfMeasuresByIds(ctx context.Context, uomIds []int64) ([]*entity.UnitOfMeasure, error) {
 	uoms := []*entity.UnitOfMeasure{}
-	err := p.db.WithContext(ctx).Where("id IN ?", uomIds).Find(&uoms).Error
+	err := transaction.DB(ctx, p.db).Where("id IN ?", uomIds).Find(&uoms).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -252,23 +313,18 @@ func (p *Postgres) GetUnitOfMeasuresByIds(ctx context.Context, uomIds []int64) (
 }
 
 func (p *Postgres) UpsertUnitOfMeasure(ctx context.Context, uom *entity.UnitOfMeasure) error {
-	tx := p.db.WithContext(ctx).Begin()
-
-	if err := tx.Save(uom).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return err
-	}
+	return transaction.DB(ctx, p.db).Save(uom).Error
+}
 
-	return nil
+func (p *Postgres) UpdateProductsBaseStock(ctx context.Context, uomId int64, conversionFactor float64) error {
+	return transaction.DB(ctx, p.db).Model(&entity.Product{}).
+		Where("uom_id = ?", uomId).
+		Update("base_stock", gorm.Expr("ROUND(stock * ?)", conversionFactor)).Error
 }
 
 func (p *Postgres) GetProductCategories(ctx context.Context, includeDeactivated bool) ([]*entity.ProductCategory, error) {
 	categories := []*entity.ProductCategory{}
-	tx := p.db.WithContext(ctx).Where("is_active = ?", includeDeactivated).Order("name ASC")
+	tx := transaction.DB(ctx, p.db).Where("is_active = ?", includeDeactivated).Order("name ASC")
 	if err := tx.Find(&categories).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -278,11 +334,15 @@ func (p *Postgres) GetProductCategories(ctx context.Context, includeDeactivated
 	return categories, nil
 }
 
-func (p *Postgres) GetProductCategoryByName(ctx context.Context, name string) (*entity.ProductCategory, error) {
+func (p *Postgres) GetProductCategoryByName(ctx context.Context, parentID *int64, name string) (*entity.ProductCategory, error) {
 	category := &entity.ProductCategory{}
-	if err := p.db.WithContext(ctx).
-		Where("LOWER(name) = ?", strings.ToLower(name)).
-		First(category).Error; err != nil {
+	tx := transaction.DB(ctx, p.db).Where("LOWER(name) = ?", strings.ToLower(name))
+	if parentID != nil {
+		tx = tx.Where("parent_id = ?", *parentID)
+	} else {
+		tx = tx.Where("parent_id IS NULL")
+	}
+	if err := tx.First(category).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -293,7 +353,7 @@ func (p *Postgres) GetProductCategoryByName(ctx context.Context, name string) (*
 
 func (p *Postgres) GetProductCategoryById(ctx context.Context, id int64) (*entity.ProductCategory, error) {
 	category := entity.ProductCategory{}
-	if err := p.db.WithContext(ctx).Where("id = ?", id).First(&category).Error; err != nil {
+	if err := transaction.DB(ctx, p.db).Where("id = ?", id).First(&category).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -303,12 +363,42 @@ func (p *Postgres) GetProductCategoryById(ctx context.Context, id int64) (*entit
 }
 
 func (p *Postgres) UpsertProductCategory(ctx context.Context, prodCategory *entity.ProductCategory) error {
-	return p.db.WithContext(ctx).Save(prodCategory).Error
+	return transaction.DB(ctx, p.db).Save(prodCategory).Error
+}
+
+func (p *Postgres) GetProductCategorySubtree(ctx context.Context, root *entity.ProductCategory, isIncludeDeactivated bool) ([]*entity.ProductCategory, error) {
+	categories := []*entity.ProductCategory{}
+	tx := transaction.DB(ctx, p.db)
+	if root != nil {
+		tx = tx.Where("id = ? OR path LIKE ?", root.ID, root.SubtreePath()+"%")
+	}
+	if !isIncludeDeactivated {
+		tx = tx.Where("is_active = ?", true)
+	}
+	if err := tx.Order("name ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (p *Postgres) LockProductCategoryTree(ctx context.Context) error {
+	return transaction.DB(ctx, p.db).Exec("SELECT pg_advisory_xact_lock(hashtext('product_category_tree'))").Error
+}
+
+func (p *Postgres) MoveProductCategory(ctx context.Context, prodCategory *entity.ProductCategory, oldSubtreePath string) error {
+	tx := transaction.DB(ctx, p.db)
+	if err := tx.Save(prodCategory).Error; err != nil {
+		return err
+	}
+	// deleted categories are moved as well so a restored category keeps a valid path
+	return tx.Unscoped().Model(&entity.ProductCategory{}).
+		Where("path LIKE ?", oldSubtreePath+"%").
+		Update("path", gorm.Expr("? || SUBSTR(path, ?)", prodCategory.SubtreePath(), len(oldSubtreePath)+1)).Error
 }
 
 func (p *Postgres) GetProductTypeByName(ctx context.Context, productCategoryID int64, name string) (*entity.ProductType, error) {
 	prodType := entity.ProductType{}
-	err := p.db.WithContext(ctx).Where("product_category_id = ? AND LOWER(name) = ?", productCategoryID, strings.ToLower(name)).First(&prodType).Error
+	err := transaction.DB(ctx, p.db).Where("product_category_id = ? AND LOWER(name) = ?", productCategoryID, strings.ToLower(name)).First(&prodType).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -321,7 +411,7 @@ func (p *Postgres) GetProductTypeByName(ctx context.Context, productCategoryID i
 func (p *Postgres) GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) ([]*entity.ProductType, error) {
 	types := []*entity.ProductType{}
 	var err error
-	tx := p.db.WithContext(ctx).Where("product_category_id = ?", productCategoryID)
+	tx := transaction.DB(ctx, p.db).Where("product_category_id = ?", productCategoryID)
 	if !isIncludeDeactivated {
 		tx = tx.Where("is_active = ?", true)
 	}
@@ -339,7 +429,7 @@ func (p *Postgres) GetProductTypes(ctx context.Context, productCategoryID int64,
 
 func (p *Postgres) GetProductTypesByIds(ctx context.Context, typeIds []int64) ([]*entity.ProductType, error) {
 	prodTypes := []*entity.ProductType{}
-	err := p.db.WithContext(ctx).
+	err := transaction.DB(ctx, p.db).
 		Where("id IN ?", typeIds).
 		Find(&prodTypes).Error
 	if err != nil {
@@ -352,16 +442,5 @@ func (p *Postgres) GetProductTypesByIds(ctx context.Context, typeIds []int64) ([
 }
 
 func (p *Postgres) UpsertProductType(ctx context.Context, prodType *entity.ProductType) error {
-	tx := p.db.WithContext(ctx).Begin()
-
-	if err := tx.Save(prodType).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return err
-	}
-
-	return nil
+	return transaction.DB(ctx, p.db).Save(prodType).Error
 }
@@ -2,15 +2,23 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
 	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/product/repository"
 	repositoryPostgres "github.com/Mitra-Apps/be-store-service/domain/product/repository/postgres"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -111,6 +119,391 @@ func Test_postgres_UpsertProducts(t *testing.T) {
 	}
 }
 
+// openSqlite returns a database with the products, product_images, product_types, product_categories, product attribute and product_lots tables. The entities use postgres
+// defaults, so the tables are created with the columns the repository writes.
+func openSqlite(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(t.TempDir()+"/store.db?_txlock=immediate&_busy_timeout=10000&_journal_mode=WAL"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, db.Exec(`CREATE TABLE products (
+		id text PRIMARY KEY DEFAULT (lower(printf('%s-%s-%s-%s-%s', hex(randomblob(4)), hex(randomblob(2)),
+		hex(randomblob(2)), hex(randomblob(2)), hex(randomblob(6))))), created_at datetime, created_by text, updated_at datetime, updated_by text, deleted_at datetime, deleted_by text, store_id text, name text,
+		description text NOT NULL DEFAULT '', sku text NOT NULL DEFAULT '', barcode text NOT NULL DEFAULT '', sale_status numeric, price_minor_units integer, price_currency text, stock integer, base_stock integer NOT NULL DEFAULT 0, uom_id integer, uom text, product_type_id integer, version integer NOT NULL DEFAULT 1,
+		publish_at datetime, unpublish_at datetime
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_images (
+		id text PRIMARY KEY, created_at datetime, created_by text, updated_at datetime, updated_by text,
+		deleted_at datetime, deleted_by text, product_id text, image_id text, sort_order integer, is_primary numeric
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_types (
+		id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, created_by text, updated_at datetime, updated_by text,
+		deleted_at datetime, deleted_by text, name text, is_active numeric, product_category_id integer
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_categories (
+		id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, created_by text, updated_at datetime, updated_by text,
+		deleted_at datetime, deleted_by text, name text, is_active numeric, parent_id integer, path text NOT NULL DEFAULT '/'
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_attribute_definitions (
+		id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, created_by text, updated_at datetime, updated_by text,
+		deleted_at datetime, deleted_by text, product_type_id integer, name text, data_type text,
+		is_required numeric, allowed_values text NOT NULL DEFAULT '[]'
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_attributes (
+		id integer PRIMARY KEY AUTOINCREMENT, product_id text, attribute_id integer, value text
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE TABLE product_lots (
+		id text PRIMARY KEY DEFAULT (lower(printf('%s-%s-%s-%s-%s', hex(randomblob(4)), hex(randomblob(2)),
+		hex(randomblob(2)), hex(randomblob(2)), hex(randomblob(6))))), created_at datetime, created_by text, updated_at datetime, updated_by text, deleted_at datetime, deleted_by text,
+		product_id text, lot_number text, quantity integer, remaining_quantity integer, expiry_date datetime, received_date datetime
+	)`).Error)
+	return db
+}
+
+// Test_postgres_UpsertProducts_Concurrent runs parallel units of work against one repository, run it with -race.
+// Every unit of work has to see only its own transaction, half of them are rolled back.
+func Test_postgres_UpsertProducts_Concurrent(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	trx := transaction.NewManager(db)
+	storeIdUuid := uuid.MustParse(storeID)
+	errRollback := errors.New("rollback")
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			product := &entity.Product{
+				StoreID:       storeIdUuid,
+				Name:          fmt.Sprintf("product %d", i),
+				Uom:           "kg",
+				ProductTypeID: 1,
+			}
+			image := &entity.ProductImage{ImageId: uuid.New()}
+			image.ID = uuid.New()
+
+			err := trx.Do(context.Background(), func(ctx context.Context) error {
+				if err := repo.UpsertProducts(ctx, []*entity.Product{product}); err != nil {
+					return err
+				}
+				image.ProductId = product.ID
+				if err := repo.UpsertProductImages(ctx, []*entity.ProductImage{image}); err != nil {
+					return err
+				}
+				if i%2 == 1 {
+					return errRollback
+				}
+				return nil
+			})
+			if i%2 == 1 {
+				assert.Equal(t, errRollback, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var productCount, imageCount int64
+	assert.NoError(t, db.Model(&entity.Product{}).Count(&productCount).Error)
+	assert.NoError(t, db.Model(&entity.ProductImage{}).Count(&imageCount).Error)
+	assert.Equal(t, int64(workers/2), productCount)
+	assert.Equal(t, int64(workers/2), imageCount)
+}
+
+func Test_postgres_UpsertProducts_VersionConflict(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+
+	product := &entity.Product{StoreID: uuid.MustParse(storeID), Name: "product", Uom: "kg", ProductTypeID: 1}
+	assert.NoError(t, repo.UpsertProducts(ctx, []*entity.Product{product}))
+	assert.NotEqual(t, uuid.Nil, product.ID)
+	assert.Equal(t, int64(1), product.Version)
+
+	// both updates are based on version 1, the second one has to be rejected
+	first, second := *product, *product
+	first.Name, second.Name = "first", "second"
+	assert.NoError(t, repo.UpsertProducts(ctx, []*entity.Product{&first}))
+	assert.Equal(t, int64(2), first.Version)
+	assert.ErrorIs(t, repo.UpsertProducts(ctx, []*entity.Product{&second}), repository.ErrVersionConflict)
+	assert.Equal(t, int64(1), second.Version)
+
+	var saved entity.Product
+	assert.NoError(t, db.First(&saved, "id = ?", product.ID).Error)
+	assert.Equal(t, "first", saved.Name)
+	assert.Equal(t, int64(2), saved.Version)
+}
+
+func Test_postgres_MoveProductCategory(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+
+	// Food > Beverages > Coffee > Instant and Snacks
+	categories := map[string]*entity.ProductCategory{}
+	for _, c := range [][2]string{{"Food", ""}, {"Beverages", "Food"}, {"Coffee", "Beverages"}, {"Instant", "Coffee"}, {"Snacks", ""}} {
+		category := &entity.ProductCategory{Name: c[0], IsActive: true}
+		category.SetParent(categories[c[1]])
+		assert.NoError(t, repo.UpsertProductCategory(ctx, category))
+		categories[c[0]] = category
+	}
+	assert.Equal(t, fmt.Sprintf("/%d/%d/%d/", categories["Food"].ID, categories["Beverages"].ID, categories["Coffee"].ID), categories["Instant"].Path)
+
+	prodType := &entity.ProductType{Name: "Sachet", IsActive: true, ProductCategoryID: categories["Instant"].ID}
+	assert.NoError(t, db.Create(prodType).Error)
+	product := &entity.Product{StoreID: storeIdUuid, Name: "kopi", SaleStatus: true, Uom: "pcs", ProductTypeID: prodType.ID}
+	assert.NoError(t, repo.UpsertProducts(ctx, []*entity.Product{product}))
+
+	products, err := repo.GetProductsByStoreId(ctx, storeIdUuid, nil, categories["Food"], nil, false)
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+
+	coffee := categories["Coffee"]
+	oldSubtreePath := coffee.SubtreePath()
+	coffee.SetParent(categories["Snacks"])
+	assert.NoError(t, repo.MoveProductCategory(ctx, coffee, oldSubtreePath))
+
+	var instant entity.ProductCategory
+	assert.NoError(t, db.First(&instant, categories["Instant"].ID).Error)
+	assert.Equal(t, fmt.Sprintf("/%d/%d/", categories["Snacks"].ID, coffee.ID), instant.Path)
+
+	subtree, err := repo.GetProductCategorySubtree(ctx, categories["Snacks"], false)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, c := range subtree {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"Coffee", "Instant", "Snacks"}, names)
+
+	products, err = repo.GetProductsByStoreId(ctx, storeIdUuid, nil, categories["Food"], nil, false)
+	assert.NoError(t, err)
+	assert.Empty(t, products)
+	products, err = repo.GetProductsByStoreId(ctx, storeIdUuid, nil, categories["Snacks"], nil, false)
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+}
+
+func Test_postgres_ProductAttributes(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+
+	prodType := &entity.ProductType{Name: "Susu", IsActive: true}
+	assert.NoError(t, db.Create(prodType).Error)
+	brand := &entity.ProductAttributeDefinition{ProductTypeID: prodType.ID, Name: "Brand", DataType: entity.AttributeText}
+	halal := &entity.ProductAttributeDefinition{ProductTypeID: prodType.ID, Name: "Halal", DataType: entity.AttributeBoolean, AllowedValues: []string{}}
+	assert.NoError(t, repo.UpsertProductAttributeDefinition(ctx, brand))
+	assert.NoError(t, repo.UpsertProductAttributeDefinition(ctx, halal))
+
+	found, err := repo.GetProductAttributeDefinitionByName(ctx, prodType.ID, "brand")
+	assert.NoError(t, err)
+	assert.Equal(t, brand.ID, found.ID)
+	definitions, err := repo.GetProductAttributeDefinitions(ctx, []int64{prodType.ID})
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 2)
+
+	products := []*entity.Product{
+		{StoreID: storeIdUuid, Name: "susu ultra", SaleStatus: true, Uom: "pcs", ProductTypeID: prodType.ID, Attributes: []*entity.ProductAttribute{
+			{AttributeID: brand.ID, Value: "Ultra"}, {AttributeID: halal.ID, Value: "true"},
+		}},
+		{StoreID: storeIdUuid, Name: "susu bear", SaleStatus: true, Uom: "pcs", ProductTypeID: prodType.ID, Attributes: []*entity.ProductAttribute{
+			{AttributeID: brand.ID, Value: "Bear Brand"},
+		}},
+	}
+	assert.NoError(t, repo.UpsertProducts(ctx, products))
+	assert.NoError(t, repo.ReplaceProductAttributes(ctx, products))
+
+	// the values are replaced as a whole
+	products[1].Attributes = []*entity.ProductAttribute{{AttributeID: brand.ID, Value: "Bear Brand"}, {AttributeID: halal.ID, Value: "false"}}
+	assert.NoError(t, repo.ReplaceProductAttributes(ctx, products[1:]))
+
+	product, err := repo.GetProductById(ctx, products[1].ID)
+	assert.NoError(t, err)
+	values := map[string]string{}
+	for _, a := range product.Attributes {
+		values[a.Attribute.Name] = a.Value
+	}
+	assert.Equal(t, map[string]string{"Brand": "Bear Brand", "Halal": "false"}, values)
+
+	filtered, err := repo.GetProductsByStoreId(ctx, storeIdUuid, nil, nil, map[int64]string{brand.ID: "ULTRA", halal.ID: "true"}, false)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "susu ultra", filtered[0].Name)
+	filtered, err = repo.GetProductsByStoreId(ctx, storeIdUuid, nil, nil, map[int64]string{halal.ID: "true", brand.ID: "Bear Brand"}, false)
+	assert.NoError(t, err)
+	assert.Empty(t, filtered)
+}
+
+func Test_postgres_ProductCodes(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+
+	prodType := &entity.ProductType{Name: "Kopi", IsActive: true}
+	assert.NoError(t, db.Create(prodType).Error)
+	products := []*entity.Product{
+		{StoreID: storeIdUuid, Name: "kopi bubuk", SKU: "KOPI-250", Barcode: "8991002101630", Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: uuid.New(), Name: "kopi bubuk", SKU: "KOPI-250", Barcode: "8991002101630", Uom: "pcs", ProductTypeID: prodType.ID},
+	}
+	assert.NoError(t, repo.UpsertProducts(ctx, products))
+
+	found, err := repo.GetProductsByStoreIdAndSkus(ctx, storeIdUuid, []string{"kopi-250", "TEH-1"})
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	found, err = repo.GetProductsByStoreIdAndBarcodes(ctx, storeIdUuid, []string{"8991002101630"})
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	product, err := repo.GetProductByBarcode(ctx, storeIdUuid, "8991002101630")
+	assert.NoError(t, err)
+	assert.Equal(t, products[0].ID, product.ID)
+	assert.Equal(t, "Kopi", product.ProductTypeName)
+	product, err = repo.GetProductByBarcode(ctx, storeIdUuid, "96385074")
+	assert.NoError(t, err)
+	assert.Nil(t, product)
+}
+
+func Test_postgres_ProductLots(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+
+	products := []*entity.Product{
+		{StoreID: storeIdUuid, Name: "susu", Uom: "pcs", ProductTypeID: 1},
+		{StoreID: storeIdUuid, Name: "roti", Uom: "pcs", ProductTypeID: 1},
+		{StoreID: uuid.New(), Name: "keju", Uom: "pcs", ProductTypeID: 1},
+	}
+	assert.NoError(t, repo.UpsertProducts(ctx, products))
+	lots := []*entity.ProductLot{
+		{ProductID: products[0].ID, LotNumber: "S-2", Quantity: 5, RemainingQuantity: 5, ExpiryDate: day(25), ReceivedDate: day(10)},
+		{ProductID: products[0].ID, LotNumber: "S-1", Quantity: 5, RemainingQuantity: 5, ExpiryDate: day(20), ReceivedDate: day(10)},
+		{ProductID: products[0].ID, LotNumber: "S-0", Quantity: 5, RemainingQuantity: 0, ExpiryDate: day(15), ReceivedDate: day(1)},
+		{ProductID: products[1].ID, LotNumber: "R-1", Quantity: 3, RemainingQuantity: 3, ExpiryDate: day(20), ReceivedDate: day(18)},
+		{ProductID: products[2].ID, LotNumber: "K-1", Quantity: 3, RemainingQuantity: 3, ExpiryDate: day(20), ReceivedDate: day(18)},
+	}
+	for _, l := range lots {
+		assert.NoError(t, repo.CreateProductLot(ctx, l))
+	}
+
+	got, err := repo.GetProductLots(ctx, products[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"S-0", "S-1", "S-2"}, []string{got[0].LotNumber, got[1].LotNumber, got[2].LotNumber})
+
+	got, err = repo.GetAvailableProductLots(ctx, products[0].ID)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	got[0].RemainingQuantity = 0
+	assert.NoError(t, repo.UpdateProductLotsRemainingQuantity(ctx, got[:1]))
+	got, err = repo.GetAvailableProductLots(ctx, products[0].ID)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "S-2", got[0].LotNumber)
+
+	// the consumed lots and the lots of other stores are left out
+	got, err = repo.GetExpiringProductLots(ctx, storeIdUuid, day(25))
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "R-1", got[0].LotNumber)
+	assert.Equal(t, "roti", got[0].ProductName)
+	assert.Equal(t, "S-2", got[1].LotNumber)
+	got, err = repo.GetExpiringProductLots(ctx, storeIdUuid, day(24))
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func Test_postgres_ProductSchedules(t *testing.T) {
+	db := openSqlite(t)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour)
+	earlier := now.Add(-2 * time.Hour)
+	future := now.Add(time.Hour)
+
+	prodType := &entity.ProductType{Name: "Kopi", IsActive: true}
+	assert.NoError(t, db.Create(prodType).Error)
+	products := []*entity.Product{
+		{StoreID: storeIdUuid, Name: "a on sale", SaleStatus: true, Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: storeIdUuid, Name: "b published", PublishAt: &past, Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: storeIdUuid, Name: "c pre-order", PublishAt: &future, Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: storeIdUuid, Name: "d unpublished", SaleStatus: true, UnpublishAt: &past, Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: storeIdUuid, Name: "e republished", PublishAt: &past, UnpublishAt: &earlier, Uom: "pcs", ProductTypeID: prodType.ID},
+		{StoreID: storeIdUuid, Name: "f seasonal", SaleStatus: true, UnpublishAt: &future, Uom: "pcs", ProductTypeID: prodType.ID},
+	}
+	assert.NoError(t, repo.UpsertProducts(ctx, products))
+
+	got, err := repo.GetProductsByStoreId(ctx, storeIdUuid, nil, nil, nil, false)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, p := range got {
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{"a on sale", "b published", "e republished", "f seasonal"}, names)
+	image := &entity.ProductImage{BaseModel: base_model.BaseModel{ID: uuid.New()}, ProductId: products[1].ID, ImageId: uuid.New()}
+	assert.NoError(t, repo.UpsertProductImages(ctx, []*entity.ProductImage{image}))
+
+	claimed, err := repo.ClaimScheduledProducts(ctx, now, 10)
+	assert.NoError(t, err)
+	names = []string{}
+	for _, p := range claimed {
+		names = append(names, p.Name)
+		// the claimed products are complete, their events would otherwise clear the associations
+		assert.Equal(t, "Kopi", p.ProductTypeName)
+		if p.ID == products[1].ID {
+			assert.Len(t, p.Images, 1)
+		}
+	}
+	assert.ElementsMatch(t, []string{"b published", "d unpublished", "e republished"}, names)
+}
+
+func Test_postgres_UpsertTranslations(t *testing.T) {
+	db := openSqlite(t)
+	assert.NoError(t, db.Exec(`CREATE TABLE master_data_translations (
+		id integer PRIMARY KEY AUTOINCREMENT, entity_type text, entity_id integer, locale text, name text,
+		created_at datetime DEFAULT CURRENT_TIMESTAMP, updated_at datetime DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (entity_type, entity_id, locale)
+	)`).Error)
+	repo := repositoryPostgres.NewPostgres(db)
+	ctx := context.Background()
+
+	assert.NoError(t, repo.UpsertTranslations(ctx, []*entity.Translation{
+		{EntityType: entity.TranslationUnitOfMeasure, EntityID: 1, Locale: "en", Name: "dozn"},
+		{EntityType: entity.TranslationUnitOfMeasure, EntityID: 2, Locale: "en", Name: "bottle"},
+		{EntityType: entity.TranslationProductType, EntityID: 1, Locale: "en", Name: "coffee"},
+	}))
+	// the name of an existing translation is replaced
+	assert.NoError(t, repo.UpsertTranslations(ctx, []*entity.Translation{
+		{EntityType: entity.TranslationUnitOfMeasure, EntityID: 1, Locale: "en", Name: "dozen"},
+		{EntityType: entity.TranslationUnitOfMeasure, EntityID: 1, Locale: "id", Name: "lusin"},
+	}))
+
+	translations, err := repo.GetTranslations(ctx, entity.TranslationUnitOfMeasure, 1)
+	assert.NoError(t, err)
+	assert.Len(t, translations, 2)
+	assert.Equal(t, "dozen", translations[0].Name)
+	assert.Equal(t, "lusin", translations[1].Name)
+
+	translations, err = repo.GetTranslationsByLocale(ctx, entity.TranslationUnitOfMeasure, "en", []int64{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Len(t, translations, 2)
+
+	translation, err := repo.GetTranslationByName(ctx, entity.TranslationUnitOfMeasure, "en", "Bottle")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), translation.EntityID)
+	translation, err = repo.GetTranslationByName(ctx, entity.TranslationProductType, "en", "bottle")
+	assert.NoError(t, err)
+	assert.Nil(t, translation)
+}
+
 /*
 func Test_postgres_UpsertUnitOfMeasure(t *testing.T) {
 	type args struct {
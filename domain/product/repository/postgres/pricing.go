@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (p *Postgres) CreateProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error {
+	return transaction.DB(ctx, p.db).Create(discount).Error
+}
+
+func (p *Postgres) GetProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*entity.ProductDiscount, error) {
+	discounts := []*entity.ProductDiscount{}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_id = ?", productID).
+		Order("starts_at DESC").
+		Find(&discounts).Error; err != nil {
+		return nil, err
+	}
+	return discounts, nil
+}
+
+func (p *Postgres) GetProductDiscountById(ctx context.Context, id uuid.UUID) (*entity.ProductDiscount, error) {
+	var discount entity.ProductDiscount
+	if err := transaction.DB(ctx, p.db).First(&discount, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &discount, nil
+}
+
+func (p *Postgres) DeleteProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error {
+	return transaction.DB(ctx, p.db).Delete(discount).Error
+}
+
+func (p *Postgres) GetActiveProductDiscounts(ctx context.Context, productIDs []uuid.UUID, at time.Time) ([]*entity.ProductDiscount, error) {
+	discounts := []*entity.ProductDiscount{}
+	if len(productIDs) == 0 {
+		return discounts, nil
+	}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_id IN ?", productIDs).
+		Where("starts_at <= ? AND (ends_at IS NULL OR ends_at > ?)", at, at).
+		Find(&discounts).Error; err != nil {
+		return nil, err
+	}
+	return discounts, nil
+}
+
+func (p *Postgres) CreateProductPriceHistories(ctx context.Context, histories []*entity.ProductPriceHistory) error {
+	return transaction.DB(ctx, p.db).Create(histories).Error
+}
+
+func (p *Postgres) GetProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*entity.ProductPriceHistory, error) {
+	histories := []*entity.ProductPriceHistory{}
+	if err := transaction.DB(ctx, p.db).
+		Where("product_id = ?", productID).
+		Order("changed_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&histories).Error; err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
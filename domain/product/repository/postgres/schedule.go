@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"gorm.io/gorm/clause"
+)
+
+func (p *Postgres) ClaimScheduledProducts(ctx context.Context, now time.Time, limit int) ([]*entity.Product, error) {
+	products := []*entity.Product{}
+	err := transaction.DB(ctx, p.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Preload("Images").
+		Preload("Attributes.Attribute").
+		Preload("ProductType").
+		Preload("ProductType.ProductCategory").
+		Where("publish_at <= ? OR unpublish_at <= ?", now, now).
+		Limit(limit).
+		Find(&products).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	for _, prod := range products {
+		prod.ProductTypeName = prod.ProductType.Name
+		prod.ProductCategoryID = prod.ProductType.ProductCategoryID
+		prod.ProductCategoryName = prod.ProductType.ProductCategory.Name
+	}
+	return products, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *Postgres) GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*entity.Translation, error) {
+	translations := []*entity.Translation{}
+	if err := transaction.DB(ctx, p.db).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("locale").
+		Find(&translations).Error; err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (p *Postgres) GetTranslationsByLocale(ctx context.Context, entityType, locale string, entityIDs []int64) ([]*entity.Translation, error) {
+	translations := []*entity.Translation{}
+	if len(entityIDs) == 0 {
+		return translations, nil
+	}
+	if err := transaction.DB(ctx, p.db).
+		Where("entity_type = ? AND locale = ? AND entity_id IN ?", entityType, locale, entityIDs).
+		Find(&translations).Error; err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (p *Postgres) GetTranslationByName(ctx context.Context, entityType, locale, name string) (*entity.Translation, error) {
+	var translation entity.Translation
+	if err := transaction.DB(ctx, p.db).
+		Where("entity_type = ? AND locale = ? AND LOWER(name) = ?", entityType, locale, strings.ToLower(name)).
+		First(&translation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &translation, nil
+}
+
+func (p *Postgres) UpsertTranslations(ctx context.Context, translations []*entity.Translation) error {
+	return transaction.DB(ctx, p.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+		}).
+		Create(translations).Error
+}
@@ -2,35 +2,88 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/Mitra-Apps/be-store-service/domain/product/entity"
 	"github.com/google/uuid"
 )
 
+// ErrVersionConflict is returned when a product was changed after the version it is updated from was read.
+var ErrVersionConflict = errors.New("product version conflict")
+
 type ProductRepository interface {
 	UpsertProducts(ctx context.Context, product []*entity.Product) error
-	GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) ([]*entity.Product, error)
+	// GetProductsByStoreId returns the products of a store, filtered by type, by the subtree of a category and by attribute values keyed by attribute id when they are given.
+	// Unless isIncludeDeactivated, only the products on sale are returned, taking the schedules due now into account.
+	GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, productCategory *entity.ProductCategory, attributes map[int64]string, isIncludeDeactivated bool) ([]*entity.Product, error)
 	GetProductsByStoreIdAndNames(ctx context.Context, storeID uuid.UUID, names []string) ([]*entity.Product, error)
+	// GetProductsByStoreIdAndSkus returns the products of the store with one of the skus, ignoring the case.
+	GetProductsByStoreIdAndSkus(ctx context.Context, storeID uuid.UUID, skus []string) ([]*entity.Product, error)
+	GetProductsByStoreIdAndBarcodes(ctx context.Context, storeID uuid.UUID, barcodes []string) ([]*entity.Product, error)
+	GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*entity.Product, error)
 	GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) ([]*entity.UnitOfMeasure, error)
 	GetUnitOfMeasureByName(ctx context.Context, name string) (*entity.UnitOfMeasure, error)
 	GetUnitOfMeasureBySymbol(ctx context.Context, symbol string) (*entity.UnitOfMeasure, error)
 	GetUnitOfMeasuresByIds(ctx context.Context, uomIds []int64) ([]*entity.UnitOfMeasure, error)
 	GetUnitOfMeasureById(ctx context.Context, uomId int64) (*entity.UnitOfMeasure, error)
-	GetProductCategoryByName(ctx context.Context, name string) (*entity.ProductCategory, error)
+	// GetProductCategoryByName returns the category with the name among the children of parentID, or among the root categories when parentID is nil.
+	GetProductCategoryByName(ctx context.Context, parentID *int64, name string) (*entity.ProductCategory, error)
 	GetProductCategoryById(ctx context.Context, id int64) (*entity.ProductCategory, error)
 	GetProductCategories(ctx context.Context, isIncludeDeactivated bool) ([]*entity.ProductCategory, error)
+	// GetProductCategorySubtree returns root with the categories below it, or every category when root is nil.
+	GetProductCategorySubtree(ctx context.Context, root *entity.ProductCategory, isIncludeDeactivated bool) ([]*entity.ProductCategory, error)
 	GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) ([]*entity.ProductType, error)
 	GetProductTypeByName(ctx context.Context, productCategoryID int64, name string) (*entity.ProductType, error)
 	GetProductTypesByIds(ctx context.Context, typeIds []int64) ([]*entity.ProductType, error)
 	GetProductById(ctx context.Context, id uuid.UUID) (*entity.Product, error)
+	GetProductsByIds(ctx context.Context, ids []uuid.UUID) ([]*entity.Product, error)
 	UpsertUnitOfMeasure(ctx context.Context, uom *entity.UnitOfMeasure) error
+	// UpdateProductsBaseStock recalculates the base stock of the products sold in the unit of measure.
+	UpdateProductsBaseStock(ctx context.Context, uomId int64, conversionFactor float64) error
 	UpsertProductCategory(ctx context.Context, prodCategory *entity.ProductCategory) error
+	// LockProductCategoryTree holds the category tree until the transaction ends, so the paths read after it stay valid.
+	LockProductCategoryTree(ctx context.Context) error
+	// MoveProductCategory saves the new parent and path of the category and moves its subtree, which was below oldSubtreePath.
+	MoveProductCategory(ctx context.Context, prodCategory *entity.ProductCategory, oldSubtreePath string) error
 	UpsertProductType(ctx context.Context, prodType *entity.ProductType) error
+	// GetProductAttributeDefinitions returns the attributes defined for the product types.
+	GetProductAttributeDefinitions(ctx context.Context, productTypeIDs []int64) ([]*entity.ProductAttributeDefinition, error)
+	GetProductAttributeDefinitionsByIds(ctx context.Context, ids []int64) ([]*entity.ProductAttributeDefinition, error)
+	GetProductAttributeDefinitionById(ctx context.Context, id int64) (*entity.ProductAttributeDefinition, error)
+	// GetProductAttributeDefinitionByName returns the attribute of the product type with the name, ignoring the case.
+	GetProductAttributeDefinitionByName(ctx context.Context, productTypeID int64, name string) (*entity.ProductAttributeDefinition, error)
+	UpsertProductAttributeDefinition(ctx context.Context, definition *entity.ProductAttributeDefinition) error
+	// ReplaceProductAttributes replaces the stored attribute values of the products with their Attributes.
+	ReplaceProductAttributes(ctx context.Context, products []*entity.Product) error
 	UpsertProductImages(ctx context.Context, productImages []*entity.ProductImage) error
-	InitiateTransaction(ctx context.Context) bool
-	TransactionCommit() error
-	TransactionRollback()
 	GetProductImagesByProductIds(ctx context.Context, productIds []uuid.UUID) ([]*entity.ProductImage, map[uuid.UUID][]*entity.ProductImage, error)
 	DeleteProductImages(ctx context.Context, productImages []*entity.ProductImage) error
 	DeleteProductById(ctx context.Context, id uuid.UUID) error
+	CreateProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error
+	GetProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*entity.ProductDiscount, error)
+	GetProductDiscountById(ctx context.Context, id uuid.UUID) (*entity.ProductDiscount, error)
+	DeleteProductDiscount(ctx context.Context, discount *entity.ProductDiscount) error
+	// GetActiveProductDiscounts returns the discounts of the products which apply at the given time.
+	GetActiveProductDiscounts(ctx context.Context, productIDs []uuid.UUID, at time.Time) ([]*entity.ProductDiscount, error)
+	CreateProductPriceHistories(ctx context.Context, histories []*entity.ProductPriceHistory) error
+	GetProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*entity.ProductPriceHistory, error)
+	CreateProductLot(ctx context.Context, lot *entity.ProductLot) error
+	// GetProductLots returns the lots of the product, the first to expire first.
+	GetProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error)
+	// GetAvailableProductLots returns the lots of the product with a remaining quantity, the first to expire first.
+	GetAvailableProductLots(ctx context.Context, productID uuid.UUID) ([]*entity.ProductLot, error)
+	UpdateProductLotsRemainingQuantity(ctx context.Context, lots []*entity.ProductLot) error
+	// GetExpiringProductLots returns the lots of the products of the store with a remaining quantity which expire until the given date, with the name of their product.
+	GetExpiringProductLots(ctx context.Context, storeID uuid.UUID, until time.Time) ([]*entity.ProductLot, error)
+	// ClaimScheduledProducts locks up to limit products with a schedule due at now, skipping the products locked by another transaction.
+	// The products are loaded with their associations like GetProductById, as the events of a claimed product carry the whole product.
+	ClaimScheduledProducts(ctx context.Context, now time.Time, limit int) ([]*entity.Product, error)
+	// GetTranslations returns the names of an entity in every locale it is translated to.
+	GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*entity.Translation, error)
+	GetTranslationsByLocale(ctx context.Context, entityType, locale string, entityIDs []int64) ([]*entity.Translation, error)
+	// GetTranslationByName returns the translation with the name in the locale, ignoring the case.
+	GetTranslationByName(ctx context.Context, entityType, locale, name string) (*entity.Translation, error)
+	// UpsertTranslations creates the translations or replaces the name of the existing ones.
+	UpsertTranslations(ctx context.Context, translations []*entity.Translation) error
 }
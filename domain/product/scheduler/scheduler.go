@@ -0,0 +1,118 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/lease/repository"
+	"github.com/google/uuid"
+)
+
+// LeaseName is the name of the lease held by the scheduler applying the product schedules.
+const LeaseName = "product_scheduler"
+
+// Config controls how the scheduler applies the product schedules.
+type Config struct {
+	// Interval is the wait between polls once no schedule is due, or while another replica holds the lease.
+	Interval time.Duration
+	// BatchSize is the number of products claimed by a poll.
+	BatchSize int
+	// LeaseTTL is how long the lease is held without being renewed, it should be several intervals so
+	// the lease is renewed before it expires.
+	LeaseTTL time.Duration
+}
+
+// DefaultConfig returns the configuration used when nothing is set in the environment.
+func DefaultConfig() Config {
+	return Config{
+		Interval:  10 * time.Second,
+		BatchSize: 100,
+		LeaseTTL:  time.Minute,
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by the PRODUCT_SCHEDULER_* environment variables.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if d, err := time.ParseDuration(os.Getenv("PRODUCT_SCHEDULER_INTERVAL")); err == nil {
+		cfg.Interval = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("PRODUCT_SCHEDULER_BATCH_SIZE")); err == nil {
+		cfg.BatchSize = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("PRODUCT_SCHEDULER_LEASE_TTL")); err == nil {
+		cfg.LeaseTTL = d
+	}
+	return cfg
+}
+
+// Applier applies the product schedules due at now to up to limit products and returns how many
+// products were claimed.
+type Applier interface {
+	ApplyProductSchedules(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// Scheduler applies the publish and unpublish schedules of the products. Every replica may run one,
+// only the one holding the lease applies the schedules, another one takes over once the lease of a
+// stopped replica has expired.
+type Scheduler struct {
+	leases  repository.LeaseRepository
+	applier Applier
+	cfg     Config
+	holder  string
+	now     func() time.Time
+}
+
+func New(leases repository.LeaseRepository, applier Applier, cfg Config) *Scheduler {
+	return &Scheduler{
+		leases:  leases,
+		applier: applier,
+		cfg:     cfg,
+		holder:  uuid.NewString(),
+		now:     time.Now,
+	}
+}
+
+// Run applies the schedules until ctx is done, the lease is then released.
+func (s *Scheduler) Run(ctx context.Context) {
+	defer func() {
+		// the lease is released once ctx is done, so the release needs its own context
+		if err := s.leases.ReleaseLease(context.Background(), LeaseName, s.holder); err != nil {
+			log.Printf("Failed to release the product scheduler lease : %v \n", err)
+		}
+	}()
+
+	for {
+		claimed, err := s.ApplyBatch(ctx)
+		if err != nil {
+			log.Printf("Failed to apply product schedules : %v \n", err)
+		}
+
+		// a full batch means more schedules are due
+		if err == nil && claimed == s.cfg.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.cfg.Interval):
+		}
+	}
+}
+
+// ApplyBatch renews the lease and, while it is held, applies a batch of due schedules. It returns how
+// many products were claimed.
+func (s *Scheduler) ApplyBatch(ctx context.Context) (int, error) {
+	now := s.now()
+	held, err := s.leases.AcquireLease(ctx, LeaseName, s.holder, now, s.cfg.LeaseTTL)
+	if err != nil || !held {
+		return 0, err
+	}
+	return s.applier.ApplyProductSchedules(ctx, now, s.cfg.BatchSize)
+}
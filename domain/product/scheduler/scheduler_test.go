@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	leaseRepoMock "github.com/Mitra-Apps/be-store-service/domain/lease/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type applierFunc func(ctx context.Context, now time.Time, limit int) (int, error)
+
+func (f applierFunc) ApplyProductSchedules(ctx context.Context, now time.Time, limit int) (int, error) {
+	return f(ctx, now, limit)
+}
+
+func TestScheduler_ApplyBatch(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		held        bool
+		leaseErr    error
+		wantApplied bool
+		wantClaimed int
+		wantErr     bool
+	}{
+		{
+			name:        "lease held",
+			held:        true,
+			wantApplied: true,
+			wantClaimed: 3,
+		},
+		{
+			name: "lease held by another replica",
+		},
+		{
+			name:     "lease error",
+			leaseErr: errors.New("db down"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			leases := leaseRepoMock.NewMockLeaseRepository(ctrl)
+			cfg := DefaultConfig()
+			cfg.BatchSize = 10
+
+			applied := false
+			s := New(leases, applierFunc(func(ctx context.Context, at time.Time, limit int) (int, error) {
+				applied = true
+				assert.Equal(t, now, at)
+				assert.Equal(t, 10, limit)
+				return 3, nil
+			}), cfg)
+			s.now = func() time.Time { return now }
+			leases.EXPECT().AcquireLease(gomock.Any(), LeaseName, s.holder, now, cfg.LeaseTTL).Return(tt.held, tt.leaseErr)
+
+			claimed, err := s.ApplyBatch(context.Background())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantClaimed, claimed)
+			assert.Equal(t, tt.wantApplied, applied)
+		})
+	}
+}
+
+func TestScheduler_Run_ReleasesLease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	leases := leaseRepoMock.NewMockLeaseRepository(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := New(leases, applierFunc(func(context.Context, time.Time, int) (int, error) {
+		cancel()
+		return 0, nil
+	}), DefaultConfig())
+	leases.EXPECT().AcquireLease(gomock.Any(), LeaseName, s.holder, gomock.Any(), gomock.Any()).Return(true, nil)
+	leases.EXPECT().ReleaseLease(gomock.Any(), LeaseName, s.holder).Return(nil)
+
+	s.Run(ctx)
+}
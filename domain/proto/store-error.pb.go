@@ -23,70 +23,340 @@ const (
 type StoreErrorCode int32
 
 const (
-	StoreErrorCode_NO_PRODUCT_INSERTED                            StoreErrorCode = 1
-	StoreErrorCode_STORE_ID_IS_REQUIRED                           StoreErrorCode = 2
-	StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE StoreErrorCode = 3
-	StoreErrorCode_PRODUCT_IS_REQUIRED                            StoreErrorCode = 4
-	StoreErrorCode_PRODUCT_ID_SHOULD_BE_EMPTY                     StoreErrorCode = 5
-	StoreErrorCode_UOM_IS_REQUIRED                                StoreErrorCode = 6
-	StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED                       StoreErrorCode = 7
-	StoreErrorCode_STOCK_SHOULD_BE_POSITIVE                       StoreErrorCode = 8
-	StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED                StoreErrorCode = 9
-	StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE        StoreErrorCode = 10
-	StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND                   StoreErrorCode = 11
-	StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT       StoreErrorCode = 12
-	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE               StoreErrorCode = 13
-	StoreErrorCode_ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE         StoreErrorCode = 14
-	StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE              StoreErrorCode = 15
-	StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT              StoreErrorCode = 16
-	StoreErrorCode_NAME_IS_REQUIRED                               StoreErrorCode = 17
-	StoreErrorCode_PRICE_IS_REQUIRED                              StoreErrorCode = 18
-	StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN       StoreErrorCode = 19
+	StoreErrorCode_NO_PRODUCT_INSERTED                               StoreErrorCode = 1
+	StoreErrorCode_STORE_ID_IS_REQUIRED                              StoreErrorCode = 2
+	StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE    StoreErrorCode = 3
+	StoreErrorCode_PRODUCT_IS_REQUIRED                               StoreErrorCode = 4
+	StoreErrorCode_PRODUCT_ID_SHOULD_BE_EMPTY                        StoreErrorCode = 5
+	StoreErrorCode_UOM_IS_REQUIRED                                   StoreErrorCode = 6
+	StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED                          StoreErrorCode = 7
+	StoreErrorCode_STOCK_SHOULD_BE_POSITIVE                          StoreErrorCode = 8
+	StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED                   StoreErrorCode = 9
+	StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE           StoreErrorCode = 10
+	StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND                      StoreErrorCode = 11
+	StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT          StoreErrorCode = 12
+	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE                  StoreErrorCode = 13
+	StoreErrorCode_ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE            StoreErrorCode = 14
+	StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE                 StoreErrorCode = 15
+	StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT                 StoreErrorCode = 16
+	StoreErrorCode_NAME_IS_REQUIRED                                  StoreErrorCode = 17
+	StoreErrorCode_PRICE_IS_REQUIRED                                 StoreErrorCode = 18
+	StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN          StoreErrorCode = 19
+	StoreErrorCode_ERROR_WHEN_SAVING_IMAGE_REFERENCE                 StoreErrorCode = 20
+	StoreErrorCode_VERSION_IS_REQUIRED                               StoreErrorCode = 21
+	StoreErrorCode_VERSION_CONFLICT                                  StoreErrorCode = 22
+	StoreErrorCode_ERROR_WHEN_RECORDING_AUDIT_EVENT                  StoreErrorCode = 23
+	StoreErrorCode_ERROR_WHEN_WRITING_DOMAIN_EVENT                   StoreErrorCode = 24
+	StoreErrorCode_INVALID_WEBHOOK_URL                               StoreErrorCode = 25
+	StoreErrorCode_INVALID_WEBHOOK_EVENT_TYPE                        StoreErrorCode = 26
+	StoreErrorCode_WEBHOOK_SUBSCRIPTION_NOT_FOUND                    StoreErrorCode = 27
+	StoreErrorCode_WEBHOOK_DELIVERY_NOT_FOUND                        StoreErrorCode = 28
+	StoreErrorCode_WEBHOOK_SUBSCRIPTION_IS_DISABLED                  StoreErrorCode = 29
+	StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION            StoreErrorCode = 30
+	StoreErrorCode_ERROR_WHEN_WATCHING_STORE                         StoreErrorCode = 31
+	StoreErrorCode_WATCH_RESUME_SEQUENCE_EXPIRED                     StoreErrorCode = 32
+	StoreErrorCode_INVALID_PRODUCT_DISCOUNT                          StoreErrorCode = 33
+	StoreErrorCode_PRODUCT_DISCOUNT_NOT_FOUND                        StoreErrorCode = 34
+	StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE                   StoreErrorCode = 35
+	StoreErrorCode_UOM_ID_IS_NOT_FOUND                               StoreErrorCode = 36
+	StoreErrorCode_INVALID_UOM_CONVERSION                            StoreErrorCode = 37
+	StoreErrorCode_INTERNAL_ERROR                                    StoreErrorCode = 38
+	StoreErrorCode_INVALID_ARGUMENT                                  StoreErrorCode = 39
+	StoreErrorCode_FIELD_IS_REQUIRED                                 StoreErrorCode = 40
+	StoreErrorCode_INVALID_ID                                        StoreErrorCode = 41
+	StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED                          StoreErrorCode = 42
+	StoreErrorCode_TOKEN_IS_REQUIRED                                 StoreErrorCode = 43
+	StoreErrorCode_INVALID_TOKEN                                     StoreErrorCode = 44
+	StoreErrorCode_STORE_NOT_FOUND                                   StoreErrorCode = 45
+	StoreErrorCode_USER_ALREADY_HAS_STORE                            StoreErrorCode = 46
+	StoreErrorCode_ERROR_WHEN_GETTING_STORE                          StoreErrorCode = 47
+	StoreErrorCode_ERROR_WHEN_SAVING_STORE                           StoreErrorCode = 48
+	StoreErrorCode_STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED             StoreErrorCode = 49
+	StoreErrorCode_PRODUCT_NOT_FOUND                                 StoreErrorCode = 50
+	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT                        StoreErrorCode = 51
+	StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT                       StoreErrorCode = 52
+	StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND                        StoreErrorCode = 53
+	StoreErrorCode_PARENT_PRODUCT_CATEGORY_NOT_FOUND                 StoreErrorCode = 54
+	StoreErrorCode_PRODUCT_CATEGORY_NAME_IS_ALREADY_USED             StoreErrorCode = 55
+	StoreErrorCode_PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF    StoreErrorCode = 56
+	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY               StoreErrorCode = 57
+	StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY                StoreErrorCode = 58
+	StoreErrorCode_PRODUCT_TYPE_NAME_IS_ALREADY_USED                 StoreErrorCode = 59
+	StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_TYPE                    StoreErrorCode = 60
+	StoreErrorCode_UOM_NAME_IS_ALREADY_USED                          StoreErrorCode = 61
+	StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED                        StoreErrorCode = 62
+	StoreErrorCode_ERROR_WHEN_GETTING_UOM                            StoreErrorCode = 63
+	StoreErrorCode_ERROR_WHEN_SAVING_UOM                             StoreErrorCode = 64
+	StoreErrorCode_BASE_UOM_IS_NOT_FOUND                             StoreErrorCode = 65
+	StoreErrorCode_UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE          StoreErrorCode = 66
+	StoreErrorCode_UOM_CAN_NOT_BE_ITS_OWN_BASE                       StoreErrorCode = 67
+	StoreErrorCode_BASE_UOM_CAN_NOT_HAVE_BASE_UOM                    StoreErrorCode = 68
+	StoreErrorCode_UOM_IS_USED_AS_BASE_UOM                           StoreErrorCode = 69
+	StoreErrorCode_INVALID_PERCENTAGE_DISCOUNT                       StoreErrorCode = 70
+	StoreErrorCode_INVALID_FIXED_DISCOUNT                            StoreErrorCode = 71
+	StoreErrorCode_INVALID_DISCOUNT_PERIOD                           StoreErrorCode = 72
+	StoreErrorCode_MONEY_IS_TOO_PRECISE                              StoreErrorCode = 73
+	StoreErrorCode_MONEY_SIGNS_DIFFER                                StoreErrorCode = 74
+	StoreErrorCode_INVALID_CURRENCY_CODE                             StoreErrorCode = 75
+	StoreErrorCode_DONT_HAVE_PERMISSION_TO_READ_AUDIT_EVENTS         StoreErrorCode = 76
+	StoreErrorCode_ERROR_WHEN_GETTING_AUDIT_EVENTS                   StoreErrorCode = 77
+	StoreErrorCode_TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED          StoreErrorCode = 78
+	StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND                      StoreErrorCode = 79
+	StoreErrorCode_LOCALE_IS_NOT_SUPPORTED                           StoreErrorCode = 80
+	StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION                     StoreErrorCode = 81
+	StoreErrorCode_INVALID_ETAG                                      StoreErrorCode = 82
+	StoreErrorCode_ETAG_DOES_NOT_MATCH_VERSION                       StoreErrorCode = 83
+	StoreErrorCode_IDEMPOTENCY_KEY_IS_TOO_LONG                       StoreErrorCode = 84
+	StoreErrorCode_IDEMPOTENCY_KEY_IS_IN_PROGRESS                    StoreErrorCode = 85
+	StoreErrorCode_IDEMPOTENCY_KEY_IS_ALREADY_USED                   StoreErrorCode = 86
+	StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY                 StoreErrorCode = 87
+	StoreErrorCode_IMAGE_TYPE_IS_NOT_SUPPORTED                       StoreErrorCode = 88
+	StoreErrorCode_IMAGE_IS_TOO_LARGE                                StoreErrorCode = 89
+	StoreErrorCode_ERROR_WHEN_UPLOADING_IMAGE                        StoreErrorCode = 90
+	StoreErrorCode_PRODUCT_ATTRIBUTE_NOT_FOUND                       StoreErrorCode = 91
+	StoreErrorCode_PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED            StoreErrorCode = 92
+	StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE          StoreErrorCode = 93
+	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE              StoreErrorCode = 94
+	StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE               StoreErrorCode = 95
+	StoreErrorCode_PRODUCT_ATTRIBUTES_ARE_NOT_VALID                  StoreErrorCode = 96
+	StoreErrorCode_PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE StoreErrorCode = 97
+	StoreErrorCode_PRODUCT_ATTRIBUTE_IS_REQUIRED                     StoreErrorCode = 98
+	StoreErrorCode_PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED            StoreErrorCode = 99
+	StoreErrorCode_PRODUCT_ATTRIBUTE_IS_DUPLICATED                   StoreErrorCode = 100
+	StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT                       StoreErrorCode = 101
+	StoreErrorCode_PRODUCT_SKUS_ARE_ALREADY_REGISTERED               StoreErrorCode = 102
+	StoreErrorCode_PRODUCT_BARCODES_ARE_ALREADY_REGISTERED           StoreErrorCode = 103
+	StoreErrorCode_PRODUCT_LOT_NUMBER_IS_ALREADY_USED                StoreErrorCode = 104
+	StoreErrorCode_INVALID_DATE                                      StoreErrorCode = 105
+	StoreErrorCode_LOT_EXPIRES_BEFORE_IT_IS_RECEIVED                 StoreErrorCode = 106
+	StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT                    StoreErrorCode = 107
+	StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_LOT                     StoreErrorCode = 108
+	StoreErrorCode_DISCOUNT_CURRENCY_MISMATCH                        StoreErrorCode = 109
 )
 
 // Enum value maps for StoreErrorCode.
 var (
 	StoreErrorCode_name = map[int32]string{
-		1:  "NO_PRODUCT_INSERTED",
-		2:  "STORE_ID_IS_REQUIRED",
-		3:  "DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE",
-		4:  "PRODUCT_IS_REQUIRED",
-		5:  "PRODUCT_ID_SHOULD_BE_EMPTY",
-		6:  "UOM_IS_REQUIRED",
-		7:  "PRODUCT_TYPE_IS_REQUIRED",
-		8:  "STOCK_SHOULD_BE_POSITIVE",
-		9:  "PRODUCTS_ARE_ALREADY_REGISTERED",
-		10: "ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE",
-		11: "PRODUCT_TYPE_ID_IS_NOT_FOUND",
-		12: "ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT",
-		13: "ERROR_WHEN_GETTING_PRODUCT_IMAGE",
-		14: "ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE",
-		15: "ERROR_WHEN_DELETING_PRODUCT_IMAGE",
-		16: "IMAGE_SHOULD_BE_IN_BASE_64_FORMAT",
-		17: "NAME_IS_REQUIRED",
-		18: "PRICE_IS_REQUIRED",
-		19: "ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN",
+		1:   "NO_PRODUCT_INSERTED",
+		2:   "STORE_ID_IS_REQUIRED",
+		3:   "DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE",
+		4:   "PRODUCT_IS_REQUIRED",
+		5:   "PRODUCT_ID_SHOULD_BE_EMPTY",
+		6:   "UOM_IS_REQUIRED",
+		7:   "PRODUCT_TYPE_IS_REQUIRED",
+		8:   "STOCK_SHOULD_BE_POSITIVE",
+		9:   "PRODUCTS_ARE_ALREADY_REGISTERED",
+		10:  "ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE",
+		11:  "PRODUCT_TYPE_ID_IS_NOT_FOUND",
+		12:  "ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT",
+		13:  "ERROR_WHEN_GETTING_PRODUCT_IMAGE",
+		14:  "ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE",
+		15:  "ERROR_WHEN_DELETING_PRODUCT_IMAGE",
+		16:  "IMAGE_SHOULD_BE_IN_BASE_64_FORMAT",
+		17:  "NAME_IS_REQUIRED",
+		18:  "PRICE_IS_REQUIRED",
+		19:  "ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN",
+		20:  "ERROR_WHEN_SAVING_IMAGE_REFERENCE",
+		21:  "VERSION_IS_REQUIRED",
+		22:  "VERSION_CONFLICT",
+		23:  "ERROR_WHEN_RECORDING_AUDIT_EVENT",
+		24:  "ERROR_WHEN_WRITING_DOMAIN_EVENT",
+		25:  "INVALID_WEBHOOK_URL",
+		26:  "INVALID_WEBHOOK_EVENT_TYPE",
+		27:  "WEBHOOK_SUBSCRIPTION_NOT_FOUND",
+		28:  "WEBHOOK_DELIVERY_NOT_FOUND",
+		29:  "WEBHOOK_SUBSCRIPTION_IS_DISABLED",
+		30:  "ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION",
+		31:  "ERROR_WHEN_WATCHING_STORE",
+		32:  "WATCH_RESUME_SEQUENCE_EXPIRED",
+		33:  "INVALID_PRODUCT_DISCOUNT",
+		34:  "PRODUCT_DISCOUNT_NOT_FOUND",
+		35:  "ERROR_WHEN_SAVING_PRODUCT_PRICE",
+		36:  "UOM_ID_IS_NOT_FOUND",
+		37:  "INVALID_UOM_CONVERSION",
+		38:  "INTERNAL_ERROR",
+		39:  "INVALID_ARGUMENT",
+		40:  "FIELD_IS_REQUIRED",
+		41:  "INVALID_ID",
+		42:  "FIELD_CAN_NOT_BE_UPDATED",
+		43:  "TOKEN_IS_REQUIRED",
+		44:  "INVALID_TOKEN",
+		45:  "STORE_NOT_FOUND",
+		46:  "USER_ALREADY_HAS_STORE",
+		47:  "ERROR_WHEN_GETTING_STORE",
+		48:  "ERROR_WHEN_SAVING_STORE",
+		49:  "STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED",
+		50:  "PRODUCT_NOT_FOUND",
+		51:  "ERROR_WHEN_GETTING_PRODUCT",
+		52:  "ERROR_WHEN_DELETING_PRODUCT",
+		53:  "PRODUCT_CATEGORY_NOT_FOUND",
+		54:  "PARENT_PRODUCT_CATEGORY_NOT_FOUND",
+		55:  "PRODUCT_CATEGORY_NAME_IS_ALREADY_USED",
+		56:  "PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF",
+		57:  "ERROR_WHEN_GETTING_PRODUCT_CATEGORY",
+		58:  "ERROR_WHEN_SAVING_PRODUCT_CATEGORY",
+		59:  "PRODUCT_TYPE_NAME_IS_ALREADY_USED",
+		60:  "ERROR_WHEN_SAVING_PRODUCT_TYPE",
+		61:  "UOM_NAME_IS_ALREADY_USED",
+		62:  "UOM_SYMBOL_IS_ALREADY_USED",
+		63:  "ERROR_WHEN_GETTING_UOM",
+		64:  "ERROR_WHEN_SAVING_UOM",
+		65:  "BASE_UOM_IS_NOT_FOUND",
+		66:  "UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE",
+		67:  "UOM_CAN_NOT_BE_ITS_OWN_BASE",
+		68:  "BASE_UOM_CAN_NOT_HAVE_BASE_UOM",
+		69:  "UOM_IS_USED_AS_BASE_UOM",
+		70:  "INVALID_PERCENTAGE_DISCOUNT",
+		71:  "INVALID_FIXED_DISCOUNT",
+		72:  "INVALID_DISCOUNT_PERIOD",
+		73:  "MONEY_IS_TOO_PRECISE",
+		74:  "MONEY_SIGNS_DIFFER",
+		75:  "INVALID_CURRENCY_CODE",
+		76:  "DONT_HAVE_PERMISSION_TO_READ_AUDIT_EVENTS",
+		77:  "ERROR_WHEN_GETTING_AUDIT_EVENTS",
+		78:  "TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED",
+		79:  "TRANSLATION_ENTITY_NOT_FOUND",
+		80:  "LOCALE_IS_NOT_SUPPORTED",
+		81:  "ERROR_WHEN_SAVING_TRANSLATION",
+		82:  "INVALID_ETAG",
+		83:  "ETAG_DOES_NOT_MATCH_VERSION",
+		84:  "IDEMPOTENCY_KEY_IS_TOO_LONG",
+		85:  "IDEMPOTENCY_KEY_IS_IN_PROGRESS",
+		86:  "IDEMPOTENCY_KEY_IS_ALREADY_USED",
+		87:  "ERROR_WHEN_SAVING_IDEMPOTENCY_KEY",
+		88:  "IMAGE_TYPE_IS_NOT_SUPPORTED",
+		89:  "IMAGE_IS_TOO_LARGE",
+		90:  "ERROR_WHEN_UPLOADING_IMAGE",
+		91:  "PRODUCT_ATTRIBUTE_NOT_FOUND",
+		92:  "PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED",
+		93:  "ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE",
+		94:  "ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE",
+		95:  "ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE",
+		96:  "PRODUCT_ATTRIBUTES_ARE_NOT_VALID",
+		97:  "PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE",
+		98:  "PRODUCT_ATTRIBUTE_IS_REQUIRED",
+		99:  "PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED",
+		100: "PRODUCT_ATTRIBUTE_IS_DUPLICATED",
+		101: "INVALID_BARCODE_CHECK_DIGIT",
+		102: "PRODUCT_SKUS_ARE_ALREADY_REGISTERED",
+		103: "PRODUCT_BARCODES_ARE_ALREADY_REGISTERED",
+		104: "PRODUCT_LOT_NUMBER_IS_ALREADY_USED",
+		105: "INVALID_DATE",
+		106: "LOT_EXPIRES_BEFORE_IT_IS_RECEIVED",
+		107: "ERROR_WHEN_GETTING_PRODUCT_LOT",
+		108: "ERROR_WHEN_SAVING_PRODUCT_LOT",
+		109: "DISCOUNT_CURRENCY_MISMATCH",
 	}
 	StoreErrorCode_value = map[string]int32{
-		"NO_PRODUCT_INSERTED":                            1,
-		"STORE_ID_IS_REQUIRED":                           2,
-		"DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE": 3,
-		"PRODUCT_IS_REQUIRED":                            4,
-		"PRODUCT_ID_SHOULD_BE_EMPTY":                     5,
-		"UOM_IS_REQUIRED":                                6,
-		"PRODUCT_TYPE_IS_REQUIRED":                       7,
-		"STOCK_SHOULD_BE_POSITIVE":                       8,
-		"PRODUCTS_ARE_ALREADY_REGISTERED":                9,
-		"ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE":        10,
-		"PRODUCT_TYPE_ID_IS_NOT_FOUND":                   11,
-		"ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT":       12,
-		"ERROR_WHEN_GETTING_PRODUCT_IMAGE":               13,
-		"ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE":         14,
-		"ERROR_WHEN_DELETING_PRODUCT_IMAGE":              15,
-		"IMAGE_SHOULD_BE_IN_BASE_64_FORMAT":              16,
-		"NAME_IS_REQUIRED":                               17,
-		"PRICE_IS_REQUIRED":                              18,
-		"ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN":       19,
+		"NO_PRODUCT_INSERTED":                               1,
+		"STORE_ID_IS_REQUIRED":                              2,
+		"DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE":    3,
+		"PRODUCT_IS_REQUIRED":                               4,
+		"PRODUCT_ID_SHOULD_BE_EMPTY":                        5,
+		"UOM_IS_REQUIRED":                                   6,
+		"PRODUCT_TYPE_IS_REQUIRED":                          7,
+		"STOCK_SHOULD_BE_POSITIVE":                          8,
+		"PRODUCTS_ARE_ALREADY_REGISTERED":                   9,
+		"ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE":           10,
+		"PRODUCT_TYPE_ID_IS_NOT_FOUND":                      11,
+		"ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT":          12,
+		"ERROR_WHEN_GETTING_PRODUCT_IMAGE":                  13,
+		"ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE":            14,
+		"ERROR_WHEN_DELETING_PRODUCT_IMAGE":                 15,
+		"IMAGE_SHOULD_BE_IN_BASE_64_FORMAT":                 16,
+		"NAME_IS_REQUIRED":                                  17,
+		"PRICE_IS_REQUIRED":                                 18,
+		"ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN":          19,
+		"ERROR_WHEN_SAVING_IMAGE_REFERENCE":                 20,
+		"VERSION_IS_REQUIRED":                               21,
+		"VERSION_CONFLICT":                                  22,
+		"ERROR_WHEN_RECORDING_AUDIT_EVENT":                  23,
+		"ERROR_WHEN_WRITING_DOMAIN_EVENT":                   24,
+		"INVALID_WEBHOOK_URL":                               25,
+		"INVALID_WEBHOOK_EVENT_TYPE":                        26,
+		"WEBHOOK_SUBSCRIPTION_NOT_FOUND":                    27,
+		"WEBHOOK_DELIVERY_NOT_FOUND":                        28,
+		"WEBHOOK_SUBSCRIPTION_IS_DISABLED":                  29,
+		"ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION":            30,
+		"ERROR_WHEN_WATCHING_STORE":                         31,
+		"WATCH_RESUME_SEQUENCE_EXPIRED":                     32,
+		"INVALID_PRODUCT_DISCOUNT":                          33,
+		"PRODUCT_DISCOUNT_NOT_FOUND":                        34,
+		"ERROR_WHEN_SAVING_PRODUCT_PRICE":                   35,
+		"UOM_ID_IS_NOT_FOUND":                               36,
+		"INVALID_UOM_CONVERSION":                            37,
+		"INTERNAL_ERROR":                                    38,
+		"INVALID_ARGUMENT":                                  39,
+		"FIELD_IS_REQUIRED":                                 40,
+		"INVALID_ID":                                        41,
+		"FIELD_CAN_NOT_BE_UPDATED":                          42,
+		"TOKEN_IS_REQUIRED":                                 43,
+		"INVALID_TOKEN":                                     44,
+		"STORE_NOT_FOUND":                                   45,
+		"USER_ALREADY_HAS_STORE":                            46,
+		"ERROR_WHEN_GETTING_STORE":                          47,
+		"ERROR_WHEN_SAVING_STORE":                           48,
+		"STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED":             49,
+		"PRODUCT_NOT_FOUND":                                 50,
+		"ERROR_WHEN_GETTING_PRODUCT":                        51,
+		"ERROR_WHEN_DELETING_PRODUCT":                       52,
+		"PRODUCT_CATEGORY_NOT_FOUND":                        53,
+		"PARENT_PRODUCT_CATEGORY_NOT_FOUND":                 54,
+		"PRODUCT_CATEGORY_NAME_IS_ALREADY_USED":             55,
+		"PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF":    56,
+		"ERROR_WHEN_GETTING_PRODUCT_CATEGORY":               57,
+		"ERROR_WHEN_SAVING_PRODUCT_CATEGORY":                58,
+		"PRODUCT_TYPE_NAME_IS_ALREADY_USED":                 59,
+		"ERROR_WHEN_SAVING_PRODUCT_TYPE":                    60,
+		"UOM_NAME_IS_ALREADY_USED":                          61,
+		"UOM_SYMBOL_IS_ALREADY_USED":                        62,
+		"ERROR_WHEN_GETTING_UOM":                            63,
+		"ERROR_WHEN_SAVING_UOM":                             64,
+		"BASE_UOM_IS_NOT_FOUND":                             65,
+		"UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE":          66,
+		"UOM_CAN_NOT_BE_ITS_OWN_BASE":                       67,
+		"BASE_UOM_CAN_NOT_HAVE_BASE_UOM":                    68,
+		"UOM_IS_USED_AS_BASE_UOM":                           69,
+		"INVALID_PERCENTAGE_DISCOUNT":                       70,
+		"INVALID_FIXED_DISCOUNT":                            71,
+		"INVALID_DISCOUNT_PERIOD":                           72,
+		"MONEY_IS_TOO_PRECISE":                              73,
+		"MONEY_SIGNS_DIFFER":                                74,
+		"INVALID_CURRENCY_CODE":                             75,
+		"DONT_HAVE_PERMISSION_TO_READ_AUDIT_EVENTS":         76,
+		"ERROR_WHEN_GETTING_AUDIT_EVENTS":                   77,
+		"TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED":          78,
+		"TRANSLATION_ENTITY_NOT_FOUND":                      79,
+		"LOCALE_IS_NOT_SUPPORTED":                           80,
+		"ERROR_WHEN_SAVING_TRANSLATION":                     81,
+		"INVALID_ETAG":                                      82,
+		"ETAG_DOES_NOT_MATCH_VERSION":                       83,
+		"IDEMPOTENCY_KEY_IS_TOO_LONG":                       84,
+		"IDEMPOTENCY_KEY_IS_IN_PROGRESS":                    85,
+		"IDEMPOTENCY_KEY_IS_ALREADY_USED":                   86,
+		"ERROR_WHEN_SAVING_IDEMPOTENCY_KEY":                 87,
+		"IMAGE_TYPE_IS_NOT_SUPPORTED":                       88,
+		"IMAGE_IS_TOO_LARGE":                                89,
+		"ERROR_WHEN_UPLOADING_IMAGE":                        90,
+		"PRODUCT_ATTRIBUTE_NOT_FOUND":                       91,
+		"PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED":            92,
+		"ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE":          93,
+		"ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE":              94,
+		"ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE":               95,
+		"PRODUCT_ATTRIBUTES_ARE_NOT_VALID":                  96,
+		"PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE": 97,
+		"PRODUCT_ATTRIBUTE_IS_REQUIRED":                     98,
+		"PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED":            99,
+		"PRODUCT_ATTRIBUTE_IS_DUPLICATED":                   100,
+		"INVALID_BARCODE_CHECK_DIGIT":                       101,
+		"PRODUCT_SKUS_ARE_ALREADY_REGISTERED":               102,
+		"PRODUCT_BARCODES_ARE_ALREADY_REGISTERED":           103,
+		"PRODUCT_LOT_NUMBER_IS_ALREADY_USED":                104,
+		"INVALID_DATE":                                      105,
+		"LOT_EXPIRES_BEFORE_IT_IS_RECEIVED":                 106,
+		"ERROR_WHEN_GETTING_PRODUCT_LOT":                    107,
+		"ERROR_WHEN_SAVING_PRODUCT_LOT":                     108,
+		"DISCOUNT_CURRENCY_MISMATCH":                        109,
 	}
 )
 
@@ -132,7 +402,7 @@ var File_proto_store_error_proto protoreflect.FileDescriptor
 var file_proto_store_error_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x65, 0x72,
 	0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0x9e, 0x05, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x2a, 0xd8, 0x1c, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
 	0x54, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
 	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x49, 0x44, 0x5f, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55,
@@ -174,16 +444,203 @@ var file_proto_store_error_proto_rawDesc = []byte{
 	0x44, 0x10, 0x12, 0x12, 0x2c, 0x0a, 0x28, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45,
 	0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x53,
 	0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x4a, 0x57, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10,
-	0x13, 0x42, 0x91, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42,
-	0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d,
-	0x69, 0x74, 0x72, 0x61, 0x2d, 0x41, 0x70, 0x70, 0x73, 0x2f, 0x62, 0x65, 0x2d, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0xca, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xe2, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
-	0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x13, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f,
+	0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x46,
+	0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x14, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x15, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x16, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x17, 0x12, 0x23, 0x0a,
+	0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x57, 0x52, 0x49, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x10, 0x18, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x19, 0x12, 0x1e, 0x0a, 0x1a, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x1a, 0x12, 0x22, 0x0a, 0x1e, 0x57,
+	0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1b, 0x12,
+	0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1c, 0x12,
+	0x24, 0x0a, 0x20, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43,
+	0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0x1d, 0x12, 0x2a, 0x0a, 0x26, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57,
+	0x48, 0x45, 0x4e, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x1e, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x1f,
+	0x12, 0x21, 0x0a, 0x1d, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45,
+	0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x20, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
+	0x21, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x53,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x22, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f,
+	0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x10, 0x23, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4f, 0x4d, 0x5f, 0x49, 0x44,
+	0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x24, 0x12,
+	0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x4f, 0x4d, 0x5f, 0x43,
+	0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x25, 0x12, 0x12, 0x0a, 0x0e, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x26, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x27, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x49,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x28, 0x12, 0x0e, 0x0a, 0x0a,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x29, 0x12, 0x1c, 0x0a, 0x18,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x2a, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x2b, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4b,
+	0x45, 0x4e, 0x10, 0x2c, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x2d, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x10, 0x2e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57,
+	0x48, 0x45, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x52,
+	0x45, 0x10, 0x2f, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45,
+	0x4e, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x30,
+	0x12, 0x29, 0x0a, 0x25, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x32,
+	0x34, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x42, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x31, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x32, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e,
+	0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
+	0x10, 0x33, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x10, 0x34, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x43,
+	0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x35, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x36, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x37, 0x12, 0x32, 0x0a, 0x2e, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x42, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57,
+	0x5f, 0x49, 0x54, 0x53, 0x45, 0x4c, 0x46, 0x10, 0x38, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59,
+	0x10, 0x39, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e,
+	0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x10, 0x3a, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x3b, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f,
+	0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x3c, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x4f, 0x4d, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45,
+	0x44, 0x10, 0x3d, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x4f, 0x4d, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f,
+	0x4c, 0x5f, 0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45,
+	0x44, 0x10, 0x3e, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45,
+	0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4f, 0x4d, 0x10, 0x3f, 0x12,
+	0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x53, 0x41,
+	0x56, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4f, 0x4d, 0x10, 0x40, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41,
+	0x53, 0x45, 0x5f, 0x55, 0x4f, 0x4d, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x41, 0x12, 0x2c, 0x0a, 0x28, 0x55, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4e,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53,
+	0x48, 0x4f, 0x55, 0x4c, 0x44, 0x5f, 0x42, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x42, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x4f, 0x4d, 0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x49, 0x54, 0x53, 0x5f, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x10, 0x43, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4f, 0x4d,
+	0x5f, 0x43, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x48, 0x41, 0x56, 0x45, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x5f, 0x55, 0x4f, 0x4d, 0x10, 0x44, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x4f, 0x4d, 0x5f,
+	0x49, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x41, 0x53, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x55, 0x4f, 0x4d, 0x10, 0x45, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x46, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x47, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x48, 0x12,
+	0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x5f, 0x49, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x45, 0x10, 0x49, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x4e,
+	0x45, 0x59, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x53, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x10,
+	0x4a, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x55, 0x52,
+	0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x4b, 0x12, 0x2d, 0x0a, 0x29,
+	0x44, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x4c, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x4d,
+	0x12, 0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x4e, 0x12, 0x20,
+	0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x4f,
+	0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x50, 0x12, 0x21, 0x0a,
+	0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x53, 0x41, 0x56, 0x49,
+	0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x51,
+	0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x54, 0x41, 0x47,
+	0x10, 0x52, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x54, 0x41, 0x47, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x53, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f,
+	0x4e, 0x47, 0x10, 0x54, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x55, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x44, 0x45, 0x4d,
+	0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x53, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0x56, 0x12, 0x25, 0x0a,
+	0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x53, 0x41, 0x56, 0x49,
+	0x4e, 0x47, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b,
+	0x45, 0x59, 0x10, 0x57, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x58, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x49,
+	0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0x59, 0x12, 0x1e, 0x0a,
+	0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x55, 0x50, 0x4c, 0x4f,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x5a, 0x12, 0x1f, 0x0a,
+	0x1b, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55,
+	0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x5b, 0x12, 0x2a,
+	0x0a, 0x26, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42,
+	0x55, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0x5c, 0x12, 0x2c, 0x0a, 0x28, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x44, 0x4f,
+	0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x5d, 0x12, 0x28, 0x0a, 0x24, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x10, 0x5e, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e,
+	0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x10, 0x5f, 0x12, 0x24, 0x0a, 0x20, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x53, 0x5f, 0x41, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x60, 0x12, 0x35, 0x0a, 0x31, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54,
+	0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45,
+	0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x61, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x44,
+	0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x49, 0x53,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x62, 0x12, 0x2a, 0x0a, 0x26, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x63, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f, 0x44, 0x55,
+	0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x49, 0x53, 0x5f,
+	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x64, 0x12, 0x1f, 0x0a, 0x1b,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x41, 0x52, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54, 0x10, 0x65, 0x12, 0x27, 0x0a,
+	0x23, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x53, 0x4b, 0x55, 0x53, 0x5f, 0x41, 0x52,
+	0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x66, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x42, 0x41, 0x52, 0x43, 0x4f, 0x44, 0x45, 0x53, 0x5f, 0x41, 0x52, 0x45, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45,
+	0x44, 0x10, 0x67, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4c,
+	0x4f, 0x54, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0x68, 0x12, 0x10, 0x0a, 0x0c, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x69, 0x12, 0x25, 0x0a,
+	0x21, 0x4c, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x53, 0x5f, 0x42, 0x45, 0x46,
+	0x4f, 0x52, 0x45, 0x5f, 0x49, 0x54, 0x5f, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x44, 0x10, 0x6a, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x48,
+	0x45, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55,
+	0x43, 0x54, 0x5f, 0x4c, 0x4f, 0x54, 0x10, 0x6b, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x53, 0x41, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4c, 0x4f, 0x54, 0x10, 0x6c, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x6d, 0x42, 0x91, 0x01, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x69, 0x74, 0x72, 0x61, 0x2d, 0x41,
+	0x70, 0x70, 0x73, 0x2f, 0x62, 0x65, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0xa2, 0x02, 0x03,
+	0x50, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xca, 0x02, 0x05, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0xe2, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -412,6 +412,10 @@ func (m *StoreImage) validate(all bool) error {
 
 	// no validation rules for ImageBase64
 
+	// no validation rules for SortOrder
+
+	// no validation rules for IsPrimary
+
 	if len(errors) > 0 {
 		return StoreImageMultiError(errors)
 	}
@@ -925,6 +929,8 @@ func (m *Store) validate(all bool) error {
 
 	}
 
+	// no validation rules for Version
+
 	if len(errors) > 0 {
 		return StoreMultiError(errors)
 	}
@@ -1039,6 +1045,10 @@ func (m *ProductCategory) validate(all bool) error {
 
 	// no validation rules for IsActive
 
+	// no validation rules for ParentId
+
+	// no validation rules for Path
+
 	if len(errors) > 0 {
 		return ProductCategoryMultiError(errors)
 	}
@@ -1117,6 +1127,168 @@ var _ interface {
 	ErrorName() string
 } = ProductCategoryValidationError{}
 
+// Validate checks the field values on CategoryTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CategoryTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryTreeNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryTreeNodeMultiError, or nil if none found.
+func (m *CategoryTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryTreeNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryTreeNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryTreeNodeValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryTreeNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by CategoryTreeNode.ValidateAll() if the designated constraints aren't met.
+type CategoryTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryTreeNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryTreeNodeMultiError) AllErrors() []error { return m }
+
+// CategoryTreeNodeValidationError is the validation error returned by
+// CategoryTreeNode.Validate if the designated constraints aren't met.
+type CategoryTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryTreeNodeValidationError) ErrorName() string { return "CategoryTreeNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryTreeNodeValidationError{}
+
 // Validate checks the field values on ProductType with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1141,11 +1313,29 @@ func (m *ProductType) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := ProductTypeValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for IsActive
 
-	// no validation rules for ProductCategoryId
+	if m.GetProductCategoryId() <= 0 {
+		err := ProductTypeValidationError{
+			field:  "ProductCategoryId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ProductTypeMultiError(errors)
@@ -1248,12 +1438,43 @@ func (m *UnitOfMeasure) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UnitOfMeasureValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Symbol
+	if l := utf8.RuneCountInString(m.GetSymbol()); l < 1 || l > 255 {
+		err := UnitOfMeasureValidationError{
+			field:  "Symbol",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for IsActive
 
+	// no validation rules for BaseUnitId
+
+	if m.GetConversionFactor() < 0 {
+		err := UnitOfMeasureValidationError{
+			field:  "ConversionFactor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UnitOfMeasureMultiError(errors)
 	}
@@ -1357,17 +1578,44 @@ func (m *Product) validate(all bool) error {
 
 	// no validation rules for StoreId
 
-	// no validation rules for Name
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := ProductValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for SaleStatus
 
-	// no validation rules for Price
+	if m.GetPrice() < 0 {
+		err := ProductValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Stock
 
 	// no validation rules for Uom
 
-	// no validation rules for ProductTypeId
+	if m.GetProductTypeId() <= 0 {
+		err := ProductValidationError{
+			field:  "ProductTypeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for ProductTypeName
 
@@ -1409,55 +1657,7869 @@ func (m *Product) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return ProductMultiError(errors)
-	}
-
-	return nil
-}
-
-// ProductMultiError is an error wrapping multiple validation errors returned
-// by Product.ValidateAll() if the designated constraints aren't met.
-type ProductMultiError []error
+	// no validation rules for Version
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ProductMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetOriginalPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "OriginalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "OriginalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOriginalPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "OriginalPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m ProductMultiError) AllErrors() []error { return m }
+	if all {
+		switch v := interface{}(m.GetEffectivePrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "EffectivePrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "EffectivePrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectivePrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "EffectivePrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// ProductValidationError is the validation error returned by Product.Validate
-// if the designated constraints aren't met.
-type ProductValidationError struct {
-	field  string
-	reason string
+	if all {
+		switch v := interface{}(m.GetActiveDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "ActiveDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "ActiveDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActiveDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "ActiveDiscount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "PriceMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "PriceMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "PriceMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUomId() < 0 {
+		err := ProductValidationError{
+			field:  "UomId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BaseStock
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProductValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProductValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProductValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+		err := ProductValidationError{
+			field:  "Description",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSku()) > 64 {
+		err := ProductValidationError{
+			field:  "Sku",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBarcode() != "" {
+
+		if !_Product_Barcode_Pattern.MatchString(m.GetBarcode()) {
+			err := ProductValidationError{
+				field:  "Barcode",
+				reason: "value does not match regex pattern \"^([0-9]{8}|[0-9]{12,14})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUnpublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "UnpublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "UnpublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnpublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "UnpublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProductMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductMultiError is an error wrapping multiple validation errors returned
+// by Product.ValidateAll() if the designated constraints aren't met.
+type ProductMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductMultiError) AllErrors() []error { return m }
+
+// ProductValidationError is the validation error returned by Product.Validate
+// if the designated constraints aren't met.
+type ProductValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductValidationError) ErrorName() string { return "ProductValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProduct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductValidationError{}
+
+var _Product_Barcode_Pattern = regexp.MustCompile("^([0-9]{8}|[0-9]{12,14})$")
+
+// Validate checks the field values on ProductAttributeDefinition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProductAttributeDefinition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductAttributeDefinition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductAttributeDefinitionMultiError, or nil if none found.
+func (m *ProductAttributeDefinition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductAttributeDefinition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductTypeId
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := ProductAttributeDefinitionValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ProductAttributeDefinition_DataType_InLookup[m.GetDataType()]; !ok {
+		err := ProductAttributeDefinitionValidationError{
+			field:  "DataType",
+			reason: "value must be in list [text number boolean date]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsRequired
+
+	for idx, item := range m.GetAllowedValues() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := ProductAttributeDefinitionValidationError{
+				field:  fmt.Sprintf("AllowedValues[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProductAttributeDefinitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductAttributeDefinitionMultiError is an error wrapping multiple
+// validation errors returned by ProductAttributeDefinition.ValidateAll() if
+// the designated constraints aren't met.
+type ProductAttributeDefinitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductAttributeDefinitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductAttributeDefinitionMultiError) AllErrors() []error { return m }
+
+// ProductAttributeDefinitionValidationError is the validation error returned
+// by ProductAttributeDefinition.Validate if the designated constraints aren't met.
+type ProductAttributeDefinitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductAttributeDefinitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductAttributeDefinitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductAttributeDefinitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductAttributeDefinitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductAttributeDefinitionValidationError) ErrorName() string {
+	return "ProductAttributeDefinitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProductAttributeDefinitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductAttributeDefinition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductAttributeDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductAttributeDefinitionValidationError{}
+
+var _ProductAttributeDefinition_DataType_InLookup = map[string]struct{}{
+	"text":    {},
+	"number":  {},
+	"boolean": {},
+	"date":    {},
+}
+
+// Validate checks the field values on ProductAttribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProductAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductAttributeMultiError, or nil if none found.
+func (m *ProductAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttributeId() <= 0 {
+		err := ProductAttributeValidationError{
+			field:  "AttributeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for DataType
+
+	if l := utf8.RuneCountInString(m.GetValue()); l < 1 || l > 255 {
+		err := ProductAttributeValidationError{
+			field:  "Value",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProductAttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductAttributeMultiError is an error wrapping multiple validation errors
+// returned by ProductAttribute.ValidateAll() if the designated constraints aren't met.
+type ProductAttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductAttributeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductAttributeMultiError) AllErrors() []error { return m }
+
+// ProductAttributeValidationError is the validation error returned by
+// ProductAttribute.Validate if the designated constraints aren't met.
+type ProductAttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductAttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductAttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductAttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductAttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductAttributeValidationError) ErrorName() string { return "ProductAttributeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductAttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductAttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductAttributeValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrencyCode
+
+	// no validation rules for Units
+
+	// no validation rules for Nanos
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+// Validate checks the field values on ProductDiscount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProductDiscount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductDiscount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductDiscountMultiError, or nil if none found.
+func (m *ProductDiscount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductDiscount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	// no validation rules for DiscountType
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductDiscountValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductDiscountValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductDiscountValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductDiscountValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProductDiscountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductDiscountMultiError is an error wrapping multiple validation errors
+// returned by ProductDiscount.ValidateAll() if the designated constraints aren't met.
+type ProductDiscountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductDiscountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductDiscountMultiError) AllErrors() []error { return m }
+
+// ProductDiscountValidationError is the validation error returned by
+// ProductDiscount.Validate if the designated constraints aren't met.
+type ProductDiscountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductDiscountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductDiscountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductDiscountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductDiscountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductDiscountValidationError) ErrorName() string { return "ProductDiscountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductDiscountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductDiscount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductDiscountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductDiscountValidationError{}
+
+// Validate checks the field values on ProductLot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProductLot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductLot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProductLotMultiError, or
+// nil if none found.
+func (m *ProductLot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductLot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	if l := utf8.RuneCountInString(m.GetLotNumber()); l < 1 || l > 64 {
+		err := ProductLotValidationError{
+			field:  "LotNumber",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := ProductLotValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RemainingQuantity
+
+	if !_ProductLot_ExpiryDate_Pattern.MatchString(m.GetExpiryDate()) {
+		err := ProductLotValidationError{
+			field:  "ExpiryDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReceivedDate() != "" {
+
+		if !_ProductLot_ReceivedDate_Pattern.MatchString(m.GetReceivedDate()) {
+			err := ProductLotValidationError{
+				field:  "ReceivedDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ProductName
+
+	if len(errors) > 0 {
+		return ProductLotMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductLotMultiError is an error wrapping multiple validation errors
+// returned by ProductLot.ValidateAll() if the designated constraints aren't met.
+type ProductLotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductLotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductLotMultiError) AllErrors() []error { return m }
+
+// ProductLotValidationError is the validation error returned by
+// ProductLot.Validate if the designated constraints aren't met.
+type ProductLotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductLotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductLotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductLotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductLotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductLotValidationError) ErrorName() string { return "ProductLotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductLotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductLot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductLotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductLotValidationError{}
+
+var _ProductLot_ExpiryDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _ProductLot_ReceivedDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on ProductPriceHistory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProductPriceHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductPriceHistory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProductPriceHistoryMultiError, or nil if none found.
+func (m *ProductPriceHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductPriceHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductPriceHistoryValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreviousPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "PreviousPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "PreviousPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviousPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductPriceHistoryValidationError{
+				field:  "PreviousPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ChangedBy
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductPriceHistoryValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductPriceHistoryValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProductPriceHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductPriceHistoryMultiError is an error wrapping multiple validation
+// errors returned by ProductPriceHistory.ValidateAll() if the designated
+// constraints aren't met.
+type ProductPriceHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductPriceHistoryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductPriceHistoryMultiError) AllErrors() []error { return m }
+
+// ProductPriceHistoryValidationError is the validation error returned by
+// ProductPriceHistory.Validate if the designated constraints aren't met.
+type ProductPriceHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductPriceHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductPriceHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductPriceHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductPriceHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductPriceHistoryValidationError) ErrorName() string {
+	return "ProductPriceHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProductPriceHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductPriceHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductPriceHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductPriceHistoryValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActorId
+
+	// no validation rules for Action
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	if all {
+		switch v := interface{}(m.GetChanges()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Changes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookSubscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookSubscriptionMultiError, or nil if none found.
+func (m *WebhookSubscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookSubscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for StoreId
+
+	// no validation rules for Url
+
+	// no validation rules for Secret
+
+	// no validation rules for IsActive
+
+	// no validation rules for ConsecutiveFailures
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DisabledReason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookSubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookSubscriptionMultiError is an error wrapping multiple validation
+// errors returned by WebhookSubscription.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookSubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookSubscriptionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookSubscriptionMultiError) AllErrors() []error { return m }
+
+// WebhookSubscriptionValidationError is the validation error returned by
+// WebhookSubscription.Validate if the designated constraints aren't met.
+type WebhookSubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookSubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookSubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookSubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookSubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookSubscriptionValidationError) ErrorName() string {
+	return "WebhookSubscriptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookSubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookSubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookSubscriptionValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SubscriptionId
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for ResponseStatus
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on StoreEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StoreEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StoreEventMultiError, or
+// nil if none found.
+func (m *StoreEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for AggregateType
+
+	// no validation rules for AggregateId
+
+	// no validation rules for StoreId
+
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StoreEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StoreEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StoreEventValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StoreEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StoreEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StoreEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StoreEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreEventMultiError is an error wrapping multiple validation errors
+// returned by StoreEvent.ValidateAll() if the designated constraints aren't met.
+type StoreEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreEventMultiError) AllErrors() []error { return m }
+
+// StoreEventValidationError is the validation error returned by
+// StoreEvent.Validate if the designated constraints aren't met.
+type StoreEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreEventValidationError) ErrorName() string { return "StoreEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StoreEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreEventValidationError{}
+
+// Validate checks the field values on ProductImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProductImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductImage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProductImageMultiError, or
+// nil if none found.
+func (m *ProductImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ImageId
+
+	// no validation rules for ImageBase64Str
+
+	// no validation rules for ImageUrl
+
+	if len(errors) > 0 {
+		return ProductImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductImageMultiError is an error wrapping multiple validation errors
+// returned by ProductImage.ValidateAll() if the designated constraints aren't met.
+type ProductImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductImageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductImageMultiError) AllErrors() []error { return m }
+
+// ProductImageValidationError is the validation error returned by
+// ProductImage.Validate if the designated constraints aren't met.
+type ProductImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductImageValidationError) ErrorName() string { return "ProductImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductImageValidationError{}
+
+// Validate checks the field values on CreateStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateStoreRequestMultiError, or nil if none found.
+func (m *CreateStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateStoreRequestValidationError{
+					field:  "Store",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateStoreRequestValidationError{
+					field:  "Store",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateStoreRequestValidationError{
+				field:  "Store",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateStoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateStoreRequestMultiError) AllErrors() []error { return m }
+
+// CreateStoreRequestValidationError is the validation error returned by
+// CreateStoreRequest.Validate if the designated constraints aren't met.
+type CreateStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStoreRequestValidationError) ErrorName() string {
+	return "CreateStoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStoreRequestValidationError{}
+
+// Validate checks the field values on CreateStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateStoreResponseMultiError, or nil if none found.
+func (m *CreateStoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateStoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateStoreResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateStoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateStoreResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateStoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateStoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateStoreResponseMultiError) AllErrors() []error { return m }
+
+// CreateStoreResponseValidationError is the validation error returned by
+// CreateStoreResponse.Validate if the designated constraints aren't met.
+type CreateStoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStoreResponseValidationError) ErrorName() string {
+	return "CreateStoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStoreResponseValidationError{}
+
+// Validate checks the field values on GetStoreRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreRequestMultiError, or nil if none found.
+func (m *GetStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	if len(errors) > 0 {
+		return GetStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by GetStoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreRequestMultiError) AllErrors() []error { return m }
+
+// GetStoreRequestValidationError is the validation error returned by
+// GetStoreRequest.Validate if the designated constraints aren't met.
+type GetStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreRequestValidationError) ErrorName() string { return "GetStoreRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreRequestValidationError{}
+
+// Validate checks the field values on GetStoreResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreResponseMultiError, or nil if none found.
+func (m *GetStoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStoreResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetStoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreResponseMultiError is an error wrapping multiple validation errors
+// returned by GetStoreResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetStoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreResponseMultiError) AllErrors() []error { return m }
+
+// GetStoreResponseValidationError is the validation error returned by
+// GetStoreResponse.Validate if the designated constraints aren't met.
+type GetStoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreResponseValidationError) ErrorName() string { return "GetStoreResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetStoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreResponseValidationError{}
+
+// Validate checks the field values on UpdateStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStoreRequestMultiError, or nil if none found.
+func (m *UpdateStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetStoreId()); l < 1 || l > 255 {
+		err := UpdateStoreRequestValidationError{
+			field:  "StoreId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateStoreRequestValidationError{
+					field:  "Store",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateStoreRequestValidationError{
+					field:  "Store",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreRequestValidationError{
+				field:  "Store",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateStoreRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateStoreRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateStoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStoreRequestMultiError) AllErrors() []error { return m }
+
+// UpdateStoreRequestValidationError is the validation error returned by
+// UpdateStoreRequest.Validate if the designated constraints aren't met.
+type UpdateStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStoreRequestValidationError) ErrorName() string {
+	return "UpdateStoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStoreRequestValidationError{}
+
+// Validate checks the field values on UpdateStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateStoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateStoreResponseMultiError, or nil if none found.
+func (m *UpdateStoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateStoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateStoreResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateStoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateStoreResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateStoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateStoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateStoreResponseMultiError) AllErrors() []error { return m }
+
+// UpdateStoreResponseValidationError is the validation error returned by
+// UpdateStoreResponse.Validate if the designated constraints aren't met.
+type UpdateStoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStoreResponseValidationError) ErrorName() string {
+	return "UpdateStoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStoreResponseValidationError{}
+
+// Validate checks the field values on DeleteStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteStoreRequestMultiError, or nil if none found.
+func (m *DeleteStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteStoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteStoreRequestMultiError) AllErrors() []error { return m }
+
+// DeleteStoreRequestValidationError is the validation error returned by
+// DeleteStoreRequest.Validate if the designated constraints aren't met.
+type DeleteStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStoreRequestValidationError) ErrorName() string {
+	return "DeleteStoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStoreRequestValidationError{}
+
+// Validate checks the field values on ListStoresRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListStoresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoresRequestMultiError, or nil if none found.
+func (m *ListStoresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListStoresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoresRequestMultiError is an error wrapping multiple validation errors
+// returned by ListStoresRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListStoresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoresRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoresRequestMultiError) AllErrors() []error { return m }
+
+// ListStoresRequestValidationError is the validation error returned by
+// ListStoresRequest.Validate if the designated constraints aren't met.
+type ListStoresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoresRequestValidationError) ErrorName() string {
+	return "ListStoresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoresRequestValidationError{}
+
+// Validate checks the field values on ListStoresResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoresResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoresResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoresResponseMultiError, or nil if none found.
+func (m *ListStoresResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoresResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoresResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUserStore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListStoresResponseValidationError{
+					field:  "UserStore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListStoresResponseValidationError{
+					field:  "UserStore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserStore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoresResponseValidationError{
+				field:  "UserStore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListStoresResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoresResponseMultiError is an error wrapping multiple validation errors
+// returned by ListStoresResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListStoresResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoresResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoresResponseMultiError) AllErrors() []error { return m }
+
+// ListStoresResponseValidationError is the validation error returned by
+// ListStoresResponse.Validate if the designated constraints aren't met.
+type ListStoresResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoresResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoresResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoresResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoresResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoresResponseValidationError) ErrorName() string {
+	return "ListStoresResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoresResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoresResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoresResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoresResponseValidationError{}
+
+// Validate checks the field values on GetStoreByUserIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreByUserIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreByUserIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreByUserIDRequestMultiError, or nil if none found.
+func (m *GetStoreByUserIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreByUserIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetStoreByUserIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreByUserIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStoreByUserIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStoreByUserIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreByUserIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreByUserIDRequestMultiError) AllErrors() []error { return m }
+
+// GetStoreByUserIDRequestValidationError is the validation error returned by
+// GetStoreByUserIDRequest.Validate if the designated constraints aren't met.
+type GetStoreByUserIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreByUserIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreByUserIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreByUserIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreByUserIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreByUserIDRequestValidationError) ErrorName() string {
+	return "GetStoreByUserIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreByUserIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreByUserIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreByUserIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreByUserIDRequestValidationError{}
+
+// Validate checks the field values on GetStoreByUserIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreByUserIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreByUserIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreByUserIDResponseMultiError, or nil if none found.
+func (m *GetStoreByUserIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreByUserIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStoreByUserIDResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStoreByUserIDResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStoreByUserIDResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetStoreByUserIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreByUserIDResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStoreByUserIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStoreByUserIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreByUserIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreByUserIDResponseMultiError) AllErrors() []error { return m }
+
+// GetStoreByUserIDResponseValidationError is the validation error returned by
+// GetStoreByUserIDResponse.Validate if the designated constraints aren't met.
+type GetStoreByUserIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreByUserIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreByUserIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreByUserIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreByUserIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreByUserIDResponseValidationError) ErrorName() string {
+	return "GetStoreByUserIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreByUserIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreByUserIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreByUserIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreByUserIDResponseValidationError{}
+
+// Validate checks the field values on OpenCloseStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenCloseStoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenCloseStoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenCloseStoreRequestMultiError, or nil if none found.
+func (m *OpenCloseStoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenCloseStoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	// no validation rules for IsActive
+
+	if len(errors) > 0 {
+		return OpenCloseStoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenCloseStoreRequestMultiError is an error wrapping multiple validation
+// errors returned by OpenCloseStoreRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OpenCloseStoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenCloseStoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenCloseStoreRequestMultiError) AllErrors() []error { return m }
+
+// OpenCloseStoreRequestValidationError is the validation error returned by
+// OpenCloseStoreRequest.Validate if the designated constraints aren't met.
+type OpenCloseStoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenCloseStoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenCloseStoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenCloseStoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenCloseStoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenCloseStoreRequestValidationError) ErrorName() string {
+	return "OpenCloseStoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenCloseStoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenCloseStoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenCloseStoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenCloseStoreRequestValidationError{}
+
+// Validate checks the field values on OpenCloseStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenCloseStoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenCloseStoreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenCloseStoreResponseMultiError, or nil if none found.
+func (m *OpenCloseStoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenCloseStoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return OpenCloseStoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenCloseStoreResponseMultiError is an error wrapping multiple validation
+// errors returned by OpenCloseStoreResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OpenCloseStoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenCloseStoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenCloseStoreResponseMultiError) AllErrors() []error { return m }
+
+// OpenCloseStoreResponseValidationError is the validation error returned by
+// OpenCloseStoreResponse.Validate if the designated constraints aren't met.
+type OpenCloseStoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenCloseStoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenCloseStoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenCloseStoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenCloseStoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenCloseStoreResponseValidationError) ErrorName() string {
+	return "OpenCloseStoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenCloseStoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenCloseStoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenCloseStoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenCloseStoreResponseValidationError{}
+
+// Validate checks the field values on InsertProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InsertProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsertProductsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InsertProductsRequestMultiError, or nil if none found.
+func (m *InsertProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsertProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	for idx, item := range m.GetProductList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsertProductsRequestValidationError{
+						field:  fmt.Sprintf("ProductList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsertProductsRequestValidationError{
+						field:  fmt.Sprintf("ProductList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsertProductsRequestValidationError{
+					field:  fmt.Sprintf("ProductList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InsertProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsertProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by InsertProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InsertProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsertProductsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsertProductsRequestMultiError) AllErrors() []error { return m }
+
+// InsertProductsRequestValidationError is the validation error returned by
+// InsertProductsRequest.Validate if the designated constraints aren't met.
+type InsertProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsertProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsertProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsertProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsertProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsertProductsRequestValidationError) ErrorName() string {
+	return "InsertProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InsertProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsertProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsertProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsertProductsRequestValidationError{}
+
+// Validate checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductRequestMultiError, or nil if none found.
+func (m *UpdateProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProductRequestValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProductRequestValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProductRequestValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProductRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProductRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProductRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProductRequestValidationError is the validation error returned by
+// UpdateProductRequest.Validate if the designated constraints aren't met.
+type UpdateProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductRequestValidationError) ErrorName() string {
+	return "UpdateProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductRequestValidationError{}
+
+// Validate checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductRequestMultiError, or nil if none found.
+func (m *DeleteProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	if len(errors) > 0 {
+		return DeleteProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProductRequestValidationError is the validation error returned by
+// DeleteProductRequest.Validate if the designated constraints aren't met.
+type DeleteProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductRequestValidationError) ErrorName() string {
+	return "DeleteProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductRequestValidationError{}
+
+// Validate checks the field values on UpsertUnitOfMeasureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertUnitOfMeasureRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertUnitOfMeasureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertUnitOfMeasureRequestMultiError, or nil if none found.
+func (m *UpsertUnitOfMeasureRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertUnitOfMeasureRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertUnitOfMeasureRequestValidationError{
+					field:  "Uom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertUnitOfMeasureRequestValidationError{
+					field:  "Uom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertUnitOfMeasureRequestValidationError{
+				field:  "Uom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertUnitOfMeasureRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertUnitOfMeasureRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertUnitOfMeasureRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertUnitOfMeasureRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertUnitOfMeasureRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertUnitOfMeasureRequestMultiError) AllErrors() []error { return m }
+
+// UpsertUnitOfMeasureRequestValidationError is the validation error returned
+// by UpsertUnitOfMeasureRequest.Validate if the designated constraints aren't met.
+type UpsertUnitOfMeasureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertUnitOfMeasureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertUnitOfMeasureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertUnitOfMeasureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertUnitOfMeasureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertUnitOfMeasureRequestValidationError) ErrorName() string {
+	return "UpsertUnitOfMeasureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertUnitOfMeasureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertUnitOfMeasureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertUnitOfMeasureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertUnitOfMeasureRequestValidationError{}
+
+// Validate checks the field values on UpsertUnitOfMeasureResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertUnitOfMeasureResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertUnitOfMeasureResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertUnitOfMeasureResponseMultiError, or nil if none found.
+func (m *UpsertUnitOfMeasureResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertUnitOfMeasureResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpsertUnitOfMeasureResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertUnitOfMeasureResponseMultiError is an error wrapping multiple
+// validation errors returned by UpsertUnitOfMeasureResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertUnitOfMeasureResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertUnitOfMeasureResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertUnitOfMeasureResponseMultiError) AllErrors() []error { return m }
+
+// UpsertUnitOfMeasureResponseValidationError is the validation error returned
+// by UpsertUnitOfMeasureResponse.Validate if the designated constraints
+// aren't met.
+type UpsertUnitOfMeasureResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertUnitOfMeasureResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertUnitOfMeasureResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertUnitOfMeasureResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertUnitOfMeasureResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertUnitOfMeasureResponseValidationError) ErrorName() string {
+	return "UpsertUnitOfMeasureResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertUnitOfMeasureResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertUnitOfMeasureResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertUnitOfMeasureResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertUnitOfMeasureResponseValidationError{}
+
+// Validate checks the field values on UpdateUnitOfMeasureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitOfMeasureRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitOfMeasureRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitOfMeasureRequestMultiError, or nil if none found.
+func (m *UpdateUnitOfMeasureRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitOfMeasureRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUomId() <= 1 {
+		err := UpdateUnitOfMeasureRequestValidationError{
+			field:  "UomId",
+			reason: "value must be greater than 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUnitOfMeasureRequestValidationError{
+					field:  "Uom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUnitOfMeasureRequestValidationError{
+					field:  "Uom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUnitOfMeasureRequestValidationError{
+				field:  "Uom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUnitOfMeasureRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitOfMeasureRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUnitOfMeasureRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUnitOfMeasureRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitOfMeasureRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitOfMeasureRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUnitOfMeasureRequestValidationError is the validation error returned
+// by UpdateUnitOfMeasureRequest.Validate if the designated constraints aren't met.
+type UpdateUnitOfMeasureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitOfMeasureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitOfMeasureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitOfMeasureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitOfMeasureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitOfMeasureRequestValidationError) ErrorName() string {
+	return "UpdateUnitOfMeasureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitOfMeasureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitOfMeasureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitOfMeasureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitOfMeasureRequestValidationError{}
+
+// Validate checks the field values on UpdateUnitOfMeasureResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitOfMeasureResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitOfMeasureResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitOfMeasureResponseMultiError, or nil if none found.
+func (m *UpdateUnitOfMeasureResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitOfMeasureResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateUnitOfMeasureResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitOfMeasureResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUnitOfMeasureResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUnitOfMeasureResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitOfMeasureResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitOfMeasureResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUnitOfMeasureResponseValidationError is the validation error returned
+// by UpdateUnitOfMeasureResponse.Validate if the designated constraints
+// aren't met.
+type UpdateUnitOfMeasureResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitOfMeasureResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitOfMeasureResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitOfMeasureResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitOfMeasureResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitOfMeasureResponseValidationError) ErrorName() string {
+	return "UpdateUnitOfMeasureResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitOfMeasureResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitOfMeasureResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitOfMeasureResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitOfMeasureResponseValidationError{}
+
+// Validate checks the field values on UpsertProductCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertProductCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertProductCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertProductCategoryRequestMultiError, or nil if none found.
+func (m *UpsertProductCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertProductCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetProductCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertProductCategoryRequestValidationError{
+					field:  "ProductCategory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertProductCategoryRequestValidationError{
+					field:  "ProductCategory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProductCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertProductCategoryRequestValidationError{
+				field:  "ProductCategory",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertProductCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertProductCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertProductCategoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertProductCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertProductCategoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertProductCategoryRequestMultiError) AllErrors() []error { return m }
+
+// UpsertProductCategoryRequestValidationError is the validation error returned
+// by UpsertProductCategoryRequest.Validate if the designated constraints
+// aren't met.
+type UpsertProductCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertProductCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertProductCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertProductCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertProductCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertProductCategoryRequestValidationError) ErrorName() string {
+	return "UpsertProductCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertProductCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertProductCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertProductCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertProductCategoryRequestValidationError{}
+
+// Validate checks the field values on UpsertProductCategoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertProductCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertProductCategoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpsertProductCategoryResponseMultiError, or nil if none found.
+func (m *UpsertProductCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertProductCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpsertProductCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertProductCategoryResponseMultiError is an error wrapping multiple
+// validation errors returned by UpsertProductCategoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpsertProductCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertProductCategoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertProductCategoryResponseMultiError) AllErrors() []error { return m }
+
+// UpsertProductCategoryResponseValidationError is the validation error
+// returned by UpsertProductCategoryResponse.Validate if the designated
+// constraints aren't met.
+type UpsertProductCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertProductCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertProductCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertProductCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertProductCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertProductCategoryResponseValidationError) ErrorName() string {
+	return "UpsertProductCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertProductCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertProductCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertProductCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertProductCategoryResponseValidationError{}
+
+// Validate checks the field values on UpsertProductTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertProductTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertProductTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertProductTypeRequestMultiError, or nil if none found.
+func (m *UpsertProductTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertProductTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProductType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertProductTypeRequestValidationError{
+					field:  "ProductType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertProductTypeRequestValidationError{
+					field:  "ProductType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProductType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertProductTypeRequestValidationError{
+				field:  "ProductType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertProductTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertProductTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertProductTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertProductTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertProductTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertProductTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpsertProductTypeRequestValidationError is the validation error returned by
+// UpsertProductTypeRequest.Validate if the designated constraints aren't met.
+type UpsertProductTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertProductTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertProductTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertProductTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertProductTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertProductTypeRequestValidationError) ErrorName() string {
+	return "UpsertProductTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertProductTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertProductTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertProductTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertProductTypeRequestValidationError{}
+
+// Validate checks the field values on UpsertProductTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertProductTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertProductTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertProductTypeResponseMultiError, or nil if none found.
+func (m *UpsertProductTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertProductTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpsertProductTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertProductTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertProductTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpsertProductTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertProductTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertProductTypeResponseMultiError) AllErrors() []error { return m }
+
+// UpsertProductTypeResponseValidationError is the validation error returned by
+// UpsertProductTypeResponse.Validate if the designated constraints aren't met.
+type UpsertProductTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertProductTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertProductTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertProductTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertProductTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertProductTypeResponseValidationError) ErrorName() string {
+	return "UpsertProductTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertProductTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertProductTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertProductTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertProductTypeResponseValidationError{}
+
+// Validate checks the field values on UpsertProductAttributeDefinitionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpsertProductAttributeDefinitionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpsertProductAttributeDefinitionRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpsertProductAttributeDefinitionRequestMultiError, or nil if none found.
+func (m *UpsertProductAttributeDefinitionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertProductAttributeDefinitionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductTypeId() <= 0 {
+		err := UpsertProductAttributeDefinitionRequestValidationError{
+			field:  "ProductTypeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAttribute() == nil {
+		err := UpsertProductAttributeDefinitionRequestValidationError{
+			field:  "Attribute",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttribute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertProductAttributeDefinitionRequestValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertProductAttributeDefinitionRequestValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertProductAttributeDefinitionRequestValidationError{
+				field:  "Attribute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertProductAttributeDefinitionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertProductAttributeDefinitionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpsertProductAttributeDefinitionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertProductAttributeDefinitionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertProductAttributeDefinitionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertProductAttributeDefinitionRequestMultiError) AllErrors() []error { return m }
+
+// UpsertProductAttributeDefinitionRequestValidationError is the validation
+// error returned by UpsertProductAttributeDefinitionRequest.Validate if the
+// designated constraints aren't met.
+type UpsertProductAttributeDefinitionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertProductAttributeDefinitionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertProductAttributeDefinitionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertProductAttributeDefinitionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertProductAttributeDefinitionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertProductAttributeDefinitionRequestValidationError) ErrorName() string {
+	return "UpsertProductAttributeDefinitionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertProductAttributeDefinitionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertProductAttributeDefinitionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertProductAttributeDefinitionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertProductAttributeDefinitionRequestValidationError{}
+
+// Validate checks the field values on ProductAttributeDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ProductAttributeDefinitionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProductAttributeDefinitionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ProductAttributeDefinitionResponseMultiError, or nil if none found.
+func (m *ProductAttributeDefinitionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProductAttributeDefinitionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductAttributeDefinitionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductAttributeDefinitionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductAttributeDefinitionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProductAttributeDefinitionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductAttributeDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ProductAttributeDefinitionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProductAttributeDefinitionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductAttributeDefinitionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductAttributeDefinitionResponseMultiError) AllErrors() []error { return m }
+
+// ProductAttributeDefinitionResponseValidationError is the validation error
+// returned by ProductAttributeDefinitionResponse.Validate if the designated
+// constraints aren't met.
+type ProductAttributeDefinitionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductAttributeDefinitionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductAttributeDefinitionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductAttributeDefinitionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductAttributeDefinitionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductAttributeDefinitionResponseValidationError) ErrorName() string {
+	return "ProductAttributeDefinitionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProductAttributeDefinitionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductAttributeDefinitionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductAttributeDefinitionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductAttributeDefinitionResponseValidationError{}
+
+// Validate checks the field values on ListProductAttributeDefinitionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProductAttributeDefinitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListProductAttributeDefinitionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListProductAttributeDefinitionsRequestMultiError, or nil if none found.
+func (m *ListProductAttributeDefinitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductAttributeDefinitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductTypeId() <= 0 {
+		err := ListProductAttributeDefinitionsRequestValidationError{
+			field:  "ProductTypeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListProductAttributeDefinitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductAttributeDefinitionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProductAttributeDefinitionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductAttributeDefinitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductAttributeDefinitionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductAttributeDefinitionsRequestMultiError) AllErrors() []error { return m }
+
+// ListProductAttributeDefinitionsRequestValidationError is the validation
+// error returned by ListProductAttributeDefinitionsRequest.Validate if the
+// designated constraints aren't met.
+type ListProductAttributeDefinitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductAttributeDefinitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductAttributeDefinitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductAttributeDefinitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProductAttributeDefinitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProductAttributeDefinitionsRequestValidationError) ErrorName() string {
+	return "ListProductAttributeDefinitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductAttributeDefinitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductAttributeDefinitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductAttributeDefinitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductAttributeDefinitionsRequestValidationError{}
+
+// Validate checks the field values on ListProductAttributeDefinitionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProductAttributeDefinitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ListProductAttributeDefinitionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListProductAttributeDefinitionsResponseMultiError, or nil if none found.
+func (m *ListProductAttributeDefinitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProductAttributeDefinitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductAttributeDefinitionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductAttributeDefinitionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProductAttributeDefinitionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProductAttributeDefinitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProductAttributeDefinitionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProductAttributeDefinitionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductAttributeDefinitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProductAttributeDefinitionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProductAttributeDefinitionsResponseMultiError) AllErrors() []error { return m }
+
+// ListProductAttributeDefinitionsResponseValidationError is the validation
+// error returned by ListProductAttributeDefinitionsResponse.Validate if the
+// designated constraints aren't met.
+type ListProductAttributeDefinitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProductAttributeDefinitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProductAttributeDefinitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProductAttributeDefinitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProductAttributeDefinitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProductAttributeDefinitionsResponseValidationError) ErrorName() string {
+	return "ListProductAttributeDefinitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProductAttributeDefinitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProductAttributeDefinitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProductAttributeDefinitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProductAttributeDefinitionsResponseValidationError{}
+
+// Validate checks the field values on GetProductListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductListRequestMultiError, or nil if none found.
+func (m *GetProductListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	// no validation rules for ProductTypeId
+
+	// no validation rules for IsIncludeDeactivated
+
+	// no validation rules for ProductCategoryId
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return GetProductListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProductListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductListRequestMultiError) AllErrors() []error { return m }
+
+// GetProductListRequestValidationError is the validation error returned by
+// GetProductListRequest.Validate if the designated constraints aren't met.
+type GetProductListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductListRequestValidationError) ErrorName() string {
+	return "GetProductListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductListRequestValidationError{}
+
+// Validate checks the field values on GetProductListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductListResponseMultiError, or nil if none found.
+func (m *GetProductListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProductListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProductListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProductListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProductListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProductListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductListResponseMultiError) AllErrors() []error { return m }
+
+// GetProductListResponseValidationError is the validation error returned by
+// GetProductListResponse.Validate if the designated constraints aren't met.
+type GetProductListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductListResponseValidationError) ErrorName() string {
+	return "GetProductListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductListResponseValidationError{}
+
+// Validate checks the field values on GetProductByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductByIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductByIdRequestMultiError, or nil if none found.
+func (m *GetProductByIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductByIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	if len(errors) > 0 {
+		return GetProductByIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductByIdRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProductByIdRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductByIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductByIdRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductByIdRequestMultiError) AllErrors() []error { return m }
+
+// GetProductByIdRequestValidationError is the validation error returned by
+// GetProductByIdRequest.Validate if the designated constraints aren't met.
+type GetProductByIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductByIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductByIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductByIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductByIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductByIdRequestValidationError) ErrorName() string {
+	return "GetProductByIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductByIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductByIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductByIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductByIdRequestValidationError{}
+
+// Validate checks the field values on GetProductByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductByIdResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductByIdResponseMultiError, or nil if none found.
+func (m *GetProductByIdResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductByIdResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProductByIdResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProductByIdResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProductByIdResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProductByIdResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductByIdResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProductByIdResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductByIdResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductByIdResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductByIdResponseMultiError) AllErrors() []error { return m }
+
+// GetProductByIdResponseValidationError is the validation error returned by
+// GetProductByIdResponse.Validate if the designated constraints aren't met.
+type GetProductByIdResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductByIdResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductByIdResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductByIdResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductByIdResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductByIdResponseValidationError) ErrorName() string {
+	return "GetProductByIdResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductByIdResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductByIdResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductByIdResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductByIdResponseValidationError{}
+
+// Validate checks the field values on GetUnitOfMeasuresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnitOfMeasuresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnitOfMeasuresRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnitOfMeasuresRequestMultiError, or nil if none found.
+func (m *GetUnitOfMeasuresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnitOfMeasuresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsIncludeDeactivated
+
+	if len(errors) > 0 {
+		return GetUnitOfMeasuresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnitOfMeasuresRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUnitOfMeasuresRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnitOfMeasuresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnitOfMeasuresRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnitOfMeasuresRequestMultiError) AllErrors() []error { return m }
+
+// GetUnitOfMeasuresRequestValidationError is the validation error returned by
+// GetUnitOfMeasuresRequest.Validate if the designated constraints aren't met.
+type GetUnitOfMeasuresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnitOfMeasuresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnitOfMeasuresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnitOfMeasuresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnitOfMeasuresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnitOfMeasuresRequestValidationError) ErrorName() string {
+	return "GetUnitOfMeasuresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnitOfMeasuresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnitOfMeasuresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnitOfMeasuresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnitOfMeasuresRequestValidationError{}
+
+// Validate checks the field values on GetUnitOfMeasuresResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnitOfMeasuresResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnitOfMeasuresResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnitOfMeasuresResponseMultiError, or nil if none found.
+func (m *GetUnitOfMeasuresResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUnitOfMeasuresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUnitOfMeasuresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUnitOfMeasuresResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUnitOfMeasuresResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnitOfMeasuresResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUnitOfMeasuresResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetUnitOfMeasuresResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnitOfMeasuresResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnitOfMeasuresResponseMultiError) AllErrors() []error { return m }
+
+// GetUnitOfMeasuresResponseValidationError is the validation error returned by
+// GetUnitOfMeasuresResponse.Validate if the designated constraints aren't met.
+type GetUnitOfMeasuresResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnitOfMeasuresResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnitOfMeasuresResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnitOfMeasuresResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnitOfMeasuresResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnitOfMeasuresResponseValidationError) ErrorName() string {
+	return "GetUnitOfMeasuresResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnitOfMeasuresResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnitOfMeasuresResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnitOfMeasuresResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnitOfMeasuresResponseValidationError{}
+
+// Validate checks the field values on GetProductCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductCategoriesRequestMultiError, or nil if none found.
+func (m *GetProductCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsIncludeDeactivated
+
+	if len(errors) > 0 {
+		return GetProductCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductCategoriesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProductCategoriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetProductCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductCategoriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// GetProductCategoriesRequestValidationError is the validation error returned
+// by GetProductCategoriesRequest.Validate if the designated constraints
+// aren't met.
+type GetProductCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductCategoriesRequestValidationError) ErrorName() string {
+	return "GetProductCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductCategoriesRequestValidationError{}
+
+// Validate checks the field values on GetProductCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductCategoriesResponseMultiError, or nil if none found.
+func (m *GetProductCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProductCategoriesResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProductCategoriesResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProductCategoriesResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProductCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductCategoriesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProductCategoriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetProductCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductCategoriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// GetProductCategoriesResponseValidationError is the validation error returned
+// by GetProductCategoriesResponse.Validate if the designated constraints
+// aren't met.
+type GetProductCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductCategoriesResponseValidationError) ErrorName() string {
+	return "GetProductCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductCategoriesResponseValidationError{}
+
+// Validate checks the field values on GetProductCategoriesResponseItem with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProductCategoriesResponseItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductCategoriesResponseItem with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProductCategoriesResponseItemMultiError, or nil if none found.
+func (m *GetProductCategoriesResponseItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductCategoriesResponseItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProductCategory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProductCategoriesResponseItemValidationError{
+						field:  fmt.Sprintf("ProductCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProductCategoriesResponseItemValidationError{
+						field:  fmt.Sprintf("ProductCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProductCategoriesResponseItemValidationError{
+					field:  fmt.Sprintf("ProductCategory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUnitOfMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProductCategoriesResponseItemValidationError{
+						field:  fmt.Sprintf("UnitOfMeasures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProductCategoriesResponseItemValidationError{
+						field:  fmt.Sprintf("UnitOfMeasures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProductCategoriesResponseItemValidationError{
+					field:  fmt.Sprintf("UnitOfMeasures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProductCategoriesResponseItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductCategoriesResponseItemMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProductCategoriesResponseItem.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductCategoriesResponseItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductCategoriesResponseItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductCategoriesResponseItemMultiError) AllErrors() []error { return m }
+
+// GetProductCategoriesResponseItemValidationError is the validation error
+// returned by GetProductCategoriesResponseItem.Validate if the designated
+// constraints aren't met.
+type GetProductCategoriesResponseItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductCategoriesResponseItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductCategoriesResponseItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductCategoriesResponseItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductCategoriesResponseItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductCategoriesResponseItemValidationError) ErrorName() string {
+	return "GetProductCategoriesResponseItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductCategoriesResponseItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductCategoriesResponseItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductCategoriesResponseItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductCategoriesResponseItemValidationError{}
+
+// Validate checks the field values on GetProductTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductTypesRequestMultiError, or nil if none found.
+func (m *GetProductTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductCategoryId
+
+	// no validation rules for IsIncludeDeactivated
+
+	if len(errors) > 0 {
+		return GetProductTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProductTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductTypesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductTypesRequestMultiError) AllErrors() []error { return m }
+
+// GetProductTypesRequestValidationError is the validation error returned by
+// GetProductTypesRequest.Validate if the designated constraints aren't met.
+type GetProductTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductTypesRequestValidationError) ErrorName() string {
+	return "GetProductTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductTypesRequestValidationError{}
+
+// Validate checks the field values on GetProductTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProductTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProductTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProductTypesResponseMultiError, or nil if none found.
+func (m *GetProductTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProductTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProductTypesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProductTypesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProductTypesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProductTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProductTypesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProductTypesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProductTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProductTypesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProductTypesResponseMultiError) AllErrors() []error { return m }
+
+// GetProductTypesResponseValidationError is the validation error returned by
+// GetProductTypesResponse.Validate if the designated constraints aren't met.
+type GetProductTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProductTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProductTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProductTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProductTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProductTypesResponseValidationError) ErrorName() string {
+	return "GetProductTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProductTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProductTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProductTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProductTypesResponseValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for ActorId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPage() < 0 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *CreateWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	// no validation rules for Url
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookSubscriptionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookSubscriptionRequestValidationError is the validation error
+// returned by CreateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "CreateWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on WebhookSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookSubscriptionResponseMultiError, or nil if none found.
+func (m *WebhookSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by WebhookSubscriptionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WebhookSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookSubscriptionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// WebhookSubscriptionResponseValidationError is the validation error returned
+// by WebhookSubscriptionResponse.Validate if the designated constraints aren't met.
+type WebhookSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookSubscriptionResponseValidationError) ErrorName() string {
+	return "WebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsRequestMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StoreId
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookSubscriptionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsRequestValidationError is the validation error
+// returned by ListWebhookSubscriptionsRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookSubscriptionsRequestValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookSubscriptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsResponseMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookSubscriptionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListWebhookSubscriptionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsResponseValidationError is the validation error
+// returned by ListWebhookSubscriptionsResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e ProductValidationError) Field() string { return e.field }
+func (e ListWebhookSubscriptionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProductValidationError) Reason() string { return e.reason }
+func (e ListWebhookSubscriptionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProductValidationError) Cause() error { return e.cause }
+func (e ListWebhookSubscriptionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProductValidationError) Key() bool { return e.key }
+func (e ListWebhookSubscriptionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProductValidationError) ErrorName() string { return "ProductValidationError" }
+func (e ListWebhookSubscriptionsResponseValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProductValidationError) Error() string {
+func (e ListWebhookSubscriptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1469,14 +9531,14 @@ func (e ProductValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProduct.%s: %s%s",
+		"invalid %sListWebhookSubscriptionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProductValidationError{}
+var _ error = ListWebhookSubscriptionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1484,51 +9546,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProductValidationError{}
+} = ListWebhookSubscriptionsResponseValidationError{}
 
-// Validate checks the field values on ProductImage with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ProductImage) Validate() error {
+// Validate checks the field values on UpdateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateWebhookSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProductImage with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ProductImageMultiError, or
-// nil if none found.
-func (m *ProductImage) ValidateAll() error {
+// ValidateAll checks the field values on UpdateWebhookSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *UpdateWebhookSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProductImage) validate(all bool) error {
+func (m *UpdateWebhookSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for StoreId
 
-	// no validation rules for ImageId
+	// no validation rules for Id
 
-	// no validation rules for ImageBase64Str
+	// no validation rules for Url
 
-	// no validation rules for ImageUrl
+	// no validation rules for IsActive
 
 	if len(errors) > 0 {
-		return ProductImageMultiError(errors)
+		return UpdateWebhookSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProductImageMultiError is an error wrapping multiple validation errors
-// returned by ProductImage.ValidateAll() if the designated constraints aren't met.
-type ProductImageMultiError []error
+// UpdateWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateWebhookSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProductImageMultiError) Error() string {
+func (m UpdateWebhookSubscriptionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1537,11 +9602,12 @@ func (m ProductImageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProductImageMultiError) AllErrors() []error { return m }
+func (m UpdateWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// ProductImageValidationError is the validation error returned by
-// ProductImage.Validate if the designated constraints aren't met.
-type ProductImageValidationError struct {
+// UpdateWebhookSubscriptionRequestValidationError is the validation error
+// returned by UpdateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateWebhookSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1549,22 +9615,24 @@ type ProductImageValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProductImageValidationError) Field() string { return e.field }
+func (e UpdateWebhookSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProductImageValidationError) Reason() string { return e.reason }
+func (e UpdateWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProductImageValidationError) Cause() error { return e.cause }
+func (e UpdateWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProductImageValidationError) Key() bool { return e.key }
+func (e UpdateWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProductImageValidationError) ErrorName() string { return "ProductImageValidationError" }
+func (e UpdateWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "UpdateWebhookSubscriptionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProductImageValidationError) Error() string {
+func (e UpdateWebhookSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1576,14 +9644,14 @@ func (e ProductImageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProductImage.%s: %s%s",
+		"invalid %sUpdateWebhookSubscriptionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProductImageValidationError{}
+var _ error = UpdateWebhookSubscriptionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1591,73 +9659,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProductImageValidationError{}
+} = UpdateWebhookSubscriptionRequestValidationError{}
 
-// Validate checks the field values on CreateStoreRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateStoreRequest) Validate() error {
+// Validate checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteWebhookSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateStoreRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateStoreRequestMultiError, or nil if none found.
-func (m *CreateStoreRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteWebhookSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *DeleteWebhookSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateStoreRequest) validate(all bool) error {
+func (m *DeleteWebhookSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStore()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateStoreRequestValidationError{
-					field:  "Store",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateStoreRequestValidationError{
-					field:  "Store",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStore()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateStoreRequestValidationError{
-				field:  "Store",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for StoreId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CreateStoreRequestMultiError(errors)
+		return DeleteWebhookSubscriptionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateStoreRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateStoreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateStoreRequestMultiError []error
+// DeleteWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateStoreRequestMultiError) Error() string {
+func (m DeleteWebhookSubscriptionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1666,11 +9711,12 @@ func (m CreateStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateStoreRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
 
-// CreateStoreRequestValidationError is the validation error returned by
-// CreateStoreRequest.Validate if the designated constraints aren't met.
-type CreateStoreRequestValidationError struct {
+// DeleteWebhookSubscriptionRequestValidationError is the validation error
+// returned by DeleteWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1678,24 +9724,24 @@ type CreateStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStoreRequestValidationError) Field() string { return e.field }
+func (e DeleteWebhookSubscriptionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStoreRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStoreRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStoreRequestValidationError) Key() bool { return e.key }
+func (e DeleteWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStoreRequestValidationError) ErrorName() string {
-	return "CreateStoreRequestValidationError"
+func (e DeleteWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "DeleteWebhookSubscriptionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStoreRequestValidationError) Error() string {
+func (e DeleteWebhookSubscriptionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1707,14 +9753,14 @@ func (e CreateStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStoreRequest.%s: %s%s",
+		"invalid %sDeleteWebhookSubscriptionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStoreRequestValidationError{}
+var _ error = DeleteWebhookSubscriptionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1722,77 +9768,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStoreRequestValidationError{}
+} = DeleteWebhookSubscriptionRequestValidationError{}
 
-// Validate checks the field values on CreateStoreResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateStoreResponse) Validate() error {
+func (m *ListWebhookDeliveriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateStoreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateStoreResponseMultiError, or nil if none found.
-func (m *CreateStoreResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateStoreResponse) validate(all bool) error {
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for StoreId
 
-	// no validation rules for Message
+	// no validation rules for SubscriptionId
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetPage() < 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateStoreResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateStoreResponseMultiError(errors)
+		return ListWebhookDeliveriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateStoreResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateStoreResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateStoreResponseMultiError []error
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateStoreResponseMultiError) Error() string {
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1801,11 +9840,12 @@ func (m CreateStoreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateStoreResponseMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
 
-// CreateStoreResponseValidationError is the validation error returned by
-// CreateStoreResponse.Validate if the designated constraints aren't met.
-type CreateStoreResponseValidationError struct {
+// ListWebhookDeliveriesRequestValidationError is the validation error
+// returned by ListWebhookDeliveriesRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1813,24 +9853,24 @@ type CreateStoreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStoreResponseValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStoreResponseValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStoreResponseValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStoreResponseValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStoreResponseValidationError) ErrorName() string {
-	return "CreateStoreResponseValidationError"
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStoreResponseValidationError) Error() string {
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1842,14 +9882,14 @@ func (e CreateStoreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStoreResponse.%s: %s%s",
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStoreResponseValidationError{}
+var _ error = ListWebhookDeliveriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1857,46 +9897,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStoreResponseValidationError{}
+} = ListWebhookDeliveriesRequestValidationError{}
 
-// Validate checks the field values on GetStoreRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetStoreRequest) Validate() error {
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStoreRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetStoreRequestMultiError, or nil if none found.
-func (m *GetStoreRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWebhookDeliveriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStoreRequest) validate(all bool) error {
+func (m *ListWebhookDeliveriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StoreId
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetStoreRequestMultiError(errors)
+		return ListWebhookDeliveriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStoreRequestMultiError is an error wrapping multiple validation errors
-// returned by GetStoreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetStoreRequestMultiError []error
+// ListWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookDeliveriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStoreRequestMultiError) Error() string {
+func (m ListWebhookDeliveriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1905,11 +9981,12 @@ func (m GetStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStoreRequestMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
 
-// GetStoreRequestValidationError is the validation error returned by
-// GetStoreRequest.Validate if the designated constraints aren't met.
-type GetStoreRequestValidationError struct {
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1917,22 +9994,24 @@ type GetStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStoreRequestValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStoreRequestValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStoreRequestValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStoreRequestValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStoreRequestValidationError) ErrorName() string { return "GetStoreRequestValidationError" }
+func (e ListWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetStoreRequestValidationError) Error() string {
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1944,14 +10023,14 @@ func (e GetStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStoreRequest.%s: %s%s",
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStoreRequestValidationError{}
+var _ error = ListWebhookDeliveriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1959,77 +10038,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStoreRequestValidationError{}
+} = ListWebhookDeliveriesResponseValidationError{}
 
-// Validate checks the field values on GetStoreResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetStoreResponse) Validate() error {
+// Validate checks the field values on ReplayWebhookDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayWebhookDeliveryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStoreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetStoreResponseMultiError, or nil if none found.
-func (m *GetStoreResponse) ValidateAll() error {
+// ValidateAll checks the field values on ReplayWebhookDeliveryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReplayWebhookDeliveryRequestMultiError, or nil if none found.
+func (m *ReplayWebhookDeliveryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStoreResponse) validate(all bool) error {
+func (m *ReplayWebhookDeliveryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for StoreId
 
-	// no validation rules for Message
+	// no validation rules for SubscriptionId
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetStoreResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetStoreResponseMultiError(errors)
+		return ReplayWebhookDeliveryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStoreResponseMultiError is an error wrapping multiple validation errors
-// returned by GetStoreResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetStoreResponseMultiError []error
+// ReplayWebhookDeliveryRequestMultiError is an error wrapping multiple
+// validation errors returned by ReplayWebhookDeliveryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReplayWebhookDeliveryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStoreResponseMultiError) Error() string {
+func (m ReplayWebhookDeliveryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2038,11 +10090,12 @@ func (m GetStoreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStoreResponseMultiError) AllErrors() []error { return m }
+func (m ReplayWebhookDeliveryRequestMultiError) AllErrors() []error { return m }
 
-// GetStoreResponseValidationError is the validation error returned by
-// GetStoreResponse.Validate if the designated constraints aren't met.
-type GetStoreResponseValidationError struct {
+// ReplayWebhookDeliveryRequestValidationError is the validation error
+// returned by ReplayWebhookDeliveryRequest.Validate if the designated
+// constraints aren't met.
+type ReplayWebhookDeliveryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2050,22 +10103,24 @@ type GetStoreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStoreResponseValidationError) Field() string { return e.field }
+func (e ReplayWebhookDeliveryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStoreResponseValidationError) Reason() string { return e.reason }
+func (e ReplayWebhookDeliveryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStoreResponseValidationError) Cause() error { return e.cause }
+func (e ReplayWebhookDeliveryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStoreResponseValidationError) Key() bool { return e.key }
+func (e ReplayWebhookDeliveryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStoreResponseValidationError) ErrorName() string { return "GetStoreResponseValidationError" }
+func (e ReplayWebhookDeliveryRequestValidationError) ErrorName() string {
+	return "ReplayWebhookDeliveryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetStoreResponseValidationError) Error() string {
+func (e ReplayWebhookDeliveryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2077,14 +10132,14 @@ func (e GetStoreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStoreResponse.%s: %s%s",
+		"invalid %sReplayWebhookDeliveryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStoreResponseValidationError{}
+var _ error = ReplayWebhookDeliveryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2092,64 +10147,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStoreResponseValidationError{}
+} = ReplayWebhookDeliveryRequestValidationError{}
 
-// Validate checks the field values on UpdateStoreRequest with the rules
+// Validate checks the field values on WebhookDeliveryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStoreRequest) Validate() error {
+func (m *WebhookDeliveryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStoreRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WebhookDeliveryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStoreRequestMultiError, or nil if none found.
-func (m *UpdateStoreRequest) ValidateAll() error {
+// WebhookDeliveryResponseMultiError, or nil if none found.
+func (m *WebhookDeliveryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStoreRequest) validate(all bool) error {
+func (m *WebhookDeliveryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetStoreId()); l < 1 || l > 255 {
-		err := UpdateStoreRequestValidationError{
-			field:  "StoreId",
-			reason: "value length must be between 1 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Code
+
+	// no validation rules for Message
 
 	if all {
-		switch v := interface{}(m.GetStore()).(type) {
+		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateStoreRequestValidationError{
-					field:  "Store",
+				errors = append(errors, WebhookDeliveryResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateStoreRequestValidationError{
-					field:  "Store",
+				errors = append(errors, WebhookDeliveryResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStore()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateStoreRequestValidationError{
-				field:  "Store",
+			return WebhookDeliveryResponseValidationError{
+				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2157,19 +10205,19 @@ func (m *UpdateStoreRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateStoreRequestMultiError(errors)
+		return WebhookDeliveryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateStoreRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateStoreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateStoreRequestMultiError []error
+// WebhookDeliveryResponseMultiError is an error wrapping multiple validation
+// errors returned by WebhookDeliveryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookDeliveryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStoreRequestMultiError) Error() string {
+func (m WebhookDeliveryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2178,11 +10226,11 @@ func (m UpdateStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateStoreRequestMultiError) AllErrors() []error { return m }
+func (m WebhookDeliveryResponseMultiError) AllErrors() []error { return m }
 
-// UpdateStoreRequestValidationError is the validation error returned by
-// UpdateStoreRequest.Validate if the designated constraints aren't met.
-type UpdateStoreRequestValidationError struct {
+// WebhookDeliveryResponseValidationError is the validation error returned by
+// WebhookDeliveryResponse.Validate if the designated constraints aren't met.
+type WebhookDeliveryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2190,24 +10238,24 @@ type UpdateStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStoreRequestValidationError) Field() string { return e.field }
+func (e WebhookDeliveryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStoreRequestValidationError) Reason() string { return e.reason }
+func (e WebhookDeliveryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStoreRequestValidationError) Cause() error { return e.cause }
+func (e WebhookDeliveryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStoreRequestValidationError) Key() bool { return e.key }
+func (e WebhookDeliveryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStoreRequestValidationError) ErrorName() string {
-	return "UpdateStoreRequestValidationError"
+func (e WebhookDeliveryResponseValidationError) ErrorName() string {
+	return "WebhookDeliveryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStoreRequestValidationError) Error() string {
+func (e WebhookDeliveryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2219,14 +10267,14 @@ func (e UpdateStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStoreRequest.%s: %s%s",
+		"invalid %sWebhookDeliveryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStoreRequestValidationError{}
+var _ error = WebhookDeliveryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2234,77 +10282,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStoreRequestValidationError{}
+} = WebhookDeliveryResponseValidationError{}
 
-// Validate checks the field values on UpdateStoreResponse with the rules
+// Validate checks the field values on WatchStoreRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStoreResponse) Validate() error {
+func (m *WatchStoreRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStoreResponse with the rules
+// ValidateAll checks the field values on WatchStoreRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStoreResponseMultiError, or nil if none found.
-func (m *UpdateStoreResponse) ValidateAll() error {
+// WatchStoreRequestMultiError, or nil if none found.
+func (m *WatchStoreRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStoreResponse) validate(all bool) error {
+func (m *WatchStoreRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
+	// no validation rules for StoreId
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateStoreResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetAfterSequence() < 0 {
+		err := WatchStoreRequestValidationError{
+			field:  "AfterSequence",
+			reason: "value must be greater than or equal to 0",
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateStoreResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateStoreResponseMultiError(errors)
+		return WatchStoreRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateStoreResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateStoreResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateStoreResponseMultiError []error
+// WatchStoreRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchStoreRequest.ValidateAll() if the designated constraints aren't met.
+type WatchStoreRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStoreResponseMultiError) Error() string {
+func (m WatchStoreRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2313,11 +10340,11 @@ func (m UpdateStoreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateStoreResponseMultiError) AllErrors() []error { return m }
+func (m WatchStoreRequestMultiError) AllErrors() []error { return m }
 
-// UpdateStoreResponseValidationError is the validation error returned by
-// UpdateStoreResponse.Validate if the designated constraints aren't met.
-type UpdateStoreResponseValidationError struct {
+// WatchStoreRequestValidationError is the validation error returned by
+// WatchStoreRequest.Validate if the designated constraints aren't met.
+type WatchStoreRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2325,24 +10352,24 @@ type UpdateStoreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStoreResponseValidationError) Field() string { return e.field }
+func (e WatchStoreRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStoreResponseValidationError) Reason() string { return e.reason }
+func (e WatchStoreRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStoreResponseValidationError) Cause() error { return e.cause }
+func (e WatchStoreRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStoreResponseValidationError) Key() bool { return e.key }
+func (e WatchStoreRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStoreResponseValidationError) ErrorName() string {
-	return "UpdateStoreResponseValidationError"
+func (e WatchStoreRequestValidationError) ErrorName() string {
+	return "WatchStoreRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStoreResponseValidationError) Error() string {
+func (e WatchStoreRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2354,14 +10381,14 @@ func (e UpdateStoreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStoreResponse.%s: %s%s",
+		"invalid %sWatchStoreRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStoreResponseValidationError{}
+var _ error = WatchStoreRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2369,44 +10396,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStoreResponseValidationError{}
+} = WatchStoreRequestValidationError{}
 
-// Validate checks the field values on DeleteStoreRequest with the rules
+// Validate checks the field values on WatchProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteStoreRequest) Validate() error {
+func (m *WatchProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteStoreRequest with the rules
+// ValidateAll checks the field values on WatchProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteStoreRequestMultiError, or nil if none found.
-func (m *DeleteStoreRequest) ValidateAll() error {
+// WatchProductsRequestMultiError, or nil if none found.
+func (m *WatchProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteStoreRequest) validate(all bool) error {
+func (m *WatchProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for StoreId
+
+	if m.GetAfterSequence() < 0 {
+		err := WatchProductsRequestValidationError{
+			field:  "AfterSequence",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteStoreRequestMultiError(errors)
+		return WatchProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteStoreRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteStoreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteStoreRequestMultiError []error
+// WatchProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteStoreRequestMultiError) Error() string {
+func (m WatchProductsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2415,11 +10455,11 @@ func (m DeleteStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteStoreRequestMultiError) AllErrors() []error { return m }
+func (m WatchProductsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteStoreRequestValidationError is the validation error returned by
-// DeleteStoreRequest.Validate if the designated constraints aren't met.
-type DeleteStoreRequestValidationError struct {
+// WatchProductsRequestValidationError is the validation error returned by
+// WatchProductsRequest.Validate if the designated constraints aren't met.
+type WatchProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2427,24 +10467,24 @@ type DeleteStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStoreRequestValidationError) Field() string { return e.field }
+func (e WatchProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStoreRequestValidationError) Reason() string { return e.reason }
+func (e WatchProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStoreRequestValidationError) Cause() error { return e.cause }
+func (e WatchProductsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStoreRequestValidationError) Key() bool { return e.key }
+func (e WatchProductsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStoreRequestValidationError) ErrorName() string {
-	return "DeleteStoreRequestValidationError"
+func (e WatchProductsRequestValidationError) ErrorName() string {
+	return "WatchProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteStoreRequestValidationError) Error() string {
+func (e WatchProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2456,14 +10496,14 @@ func (e DeleteStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStoreRequest.%s: %s%s",
+		"invalid %sWatchProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStoreRequestValidationError{}
+var _ error = WatchProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2471,44 +10511,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStoreRequestValidationError{}
+} = WatchProductsRequestValidationError{}
 
-// Validate checks the field values on ListStoresRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListStoresRequest) Validate() error {
+// Validate checks the field values on BatchGetStoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetStoresRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListStoresRequest with the rules
+// ValidateAll checks the field values on BatchGetStoresRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListStoresRequestMultiError, or nil if none found.
-func (m *ListStoresRequest) ValidateAll() error {
+// BatchGetStoresRequestMultiError, or nil if none found.
+func (m *BatchGetStoresRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListStoresRequest) validate(all bool) error {
+func (m *BatchGetStoresRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if l := len(m.GetIds()); l < 1 || l > 500 {
+		err := BatchGetStoresRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListStoresRequestMultiError(errors)
+		return BatchGetStoresRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListStoresRequestMultiError is an error wrapping multiple validation errors
-// returned by ListStoresRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListStoresRequestMultiError []error
+// BatchGetStoresRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetStoresRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetStoresRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListStoresRequestMultiError) Error() string {
+func (m BatchGetStoresRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2517,11 +10568,11 @@ func (m ListStoresRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListStoresRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetStoresRequestMultiError) AllErrors() []error { return m }
 
-// ListStoresRequestValidationError is the validation error returned by
-// ListStoresRequest.Validate if the designated constraints aren't met.
-type ListStoresRequestValidationError struct {
+// BatchGetStoresRequestValidationError is the validation error returned by
+// BatchGetStoresRequest.Validate if the designated constraints aren't met.
+type BatchGetStoresRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2529,24 +10580,24 @@ type ListStoresRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStoresRequestValidationError) Field() string { return e.field }
+func (e BatchGetStoresRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStoresRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetStoresRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStoresRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetStoresRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStoresRequestValidationError) Key() bool { return e.key }
+func (e BatchGetStoresRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStoresRequestValidationError) ErrorName() string {
-	return "ListStoresRequestValidationError"
+func (e BatchGetStoresRequestValidationError) ErrorName() string {
+	return "BatchGetStoresRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStoresRequestValidationError) Error() string {
+func (e BatchGetStoresRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2558,14 +10609,14 @@ func (e ListStoresRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStoresRequest.%s: %s%s",
+		"invalid %sBatchGetStoresRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStoresRequestValidationError{}
+var _ error = BatchGetStoresRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2573,91 +10624,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStoresRequestValidationError{}
+} = BatchGetStoresRequestValidationError{}
 
-// Validate checks the field values on ListStoresResponse with the rules
+// Validate checks the field values on BatchGetStoreResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListStoresResponse) Validate() error {
+func (m *BatchGetStoreResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListStoresResponse with the rules
+// ValidateAll checks the field values on BatchGetStoreResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListStoresResponseMultiError, or nil if none found.
-func (m *ListStoresResponse) ValidateAll() error {
+// BatchGetStoreResultMultiError, or nil if none found.
+func (m *BatchGetStoreResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListStoresResponse) validate(all bool) error {
+func (m *BatchGetStoreResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
-
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListStoresResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListStoresResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListStoresResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Id
 
-	}
+	// no validation rules for Found
 
 	if all {
-		switch v := interface{}(m.GetUserStore()).(type) {
+		switch v := interface{}(m.GetStore()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListStoresResponseValidationError{
-					field:  "UserStore",
+				errors = append(errors, BatchGetStoreResultValidationError{
+					field:  "Store",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListStoresResponseValidationError{
-					field:  "UserStore",
+				errors = append(errors, BatchGetStoreResultValidationError{
+					field:  "Store",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUserStore()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStore()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListStoresResponseValidationError{
-				field:  "UserStore",
+			return BatchGetStoreResultValidationError{
+				field:  "Store",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2665,19 +10682,19 @@ func (m *ListStoresResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListStoresResponseMultiError(errors)
+		return BatchGetStoreResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListStoresResponseMultiError is an error wrapping multiple validation errors
-// returned by ListStoresResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListStoresResponseMultiError []error
+// BatchGetStoreResultMultiError is an error wrapping multiple validation
+// errors returned by BatchGetStoreResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetStoreResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListStoresResponseMultiError) Error() string {
+func (m BatchGetStoreResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2686,11 +10703,11 @@ func (m ListStoresResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListStoresResponseMultiError) AllErrors() []error { return m }
+func (m BatchGetStoreResultMultiError) AllErrors() []error { return m }
 
-// ListStoresResponseValidationError is the validation error returned by
-// ListStoresResponse.Validate if the designated constraints aren't met.
-type ListStoresResponseValidationError struct {
+// BatchGetStoreResultValidationError is the validation error returned by
+// BatchGetStoreResult.Validate if the designated constraints aren't met.
+type BatchGetStoreResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2698,24 +10715,24 @@ type ListStoresResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStoresResponseValidationError) Field() string { return e.field }
+func (e BatchGetStoreResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStoresResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetStoreResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStoresResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetStoreResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStoresResponseValidationError) Key() bool { return e.key }
+func (e BatchGetStoreResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStoresResponseValidationError) ErrorName() string {
-	return "ListStoresResponseValidationError"
+func (e BatchGetStoreResultValidationError) ErrorName() string {
+	return "BatchGetStoreResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStoresResponseValidationError) Error() string {
+func (e BatchGetStoreResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2727,14 +10744,14 @@ func (e ListStoresResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStoresResponse.%s: %s%s",
+		"invalid %sBatchGetStoreResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStoresResponseValidationError{}
+var _ error = BatchGetStoreResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -2742,44 +10759,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStoresResponseValidationError{}
+} = BatchGetStoreResultValidationError{}
 
-// Validate checks the field values on GetStoreByUserIDRequest with the rules
+// Validate checks the field values on BatchGetStoresResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStoreByUserIDRequest) Validate() error {
+func (m *BatchGetStoresResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStoreByUserIDRequest with the
+// ValidateAll checks the field values on BatchGetStoresResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStoreByUserIDRequestMultiError, or nil if none found.
-func (m *GetStoreByUserIDRequest) ValidateAll() error {
+// BatchGetStoresResponseMultiError, or nil if none found.
+func (m *BatchGetStoresResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStoreByUserIDRequest) validate(all bool) error {
+func (m *BatchGetStoresResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetStoresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetStoresResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetStoresResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetStoreByUserIDRequestMultiError(errors)
+		return BatchGetStoresResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStoreByUserIDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStoreByUserIDRequest.ValidateAll() if the designated
+// BatchGetStoresResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetStoresResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetStoreByUserIDRequestMultiError []error
+type BatchGetStoresResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStoreByUserIDRequestMultiError) Error() string {
+func (m BatchGetStoresResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2788,11 +10843,11 @@ func (m GetStoreByUserIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStoreByUserIDRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetStoresResponseMultiError) AllErrors() []error { return m }
 
-// GetStoreByUserIDRequestValidationError is the validation error returned by
-// GetStoreByUserIDRequest.Validate if the designated constraints aren't met.
-type GetStoreByUserIDRequestValidationError struct {
+// BatchGetStoresResponseValidationError is the validation error returned by
+// BatchGetStoresResponse.Validate if the designated constraints aren't met.
+type BatchGetStoresResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2800,24 +10855,24 @@ type GetStoreByUserIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStoreByUserIDRequestValidationError) Field() string { return e.field }
+func (e BatchGetStoresResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStoreByUserIDRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetStoresResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStoreByUserIDRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetStoresResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStoreByUserIDRequestValidationError) Key() bool { return e.key }
+func (e BatchGetStoresResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStoreByUserIDRequestValidationError) ErrorName() string {
-	return "GetStoreByUserIDRequestValidationError"
+func (e BatchGetStoresResponseValidationError) ErrorName() string {
+	return "BatchGetStoresResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStoreByUserIDRequestValidationError) Error() string {
+func (e BatchGetStoresResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2829,14 +10884,14 @@ func (e GetStoreByUserIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStoreByUserIDRequest.%s: %s%s",
+		"invalid %sBatchGetStoresResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStoreByUserIDRequestValidationError{}
+var _ error = BatchGetStoresResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2844,77 +10899,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStoreByUserIDRequestValidationError{}
+} = BatchGetStoresResponseValidationError{}
 
-// Validate checks the field values on GetStoreByUserIDResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetProductByBarcodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStoreByUserIDResponse) Validate() error {
+func (m *GetProductByBarcodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStoreByUserIDResponse with the
+// ValidateAll checks the field values on GetProductByBarcodeRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStoreByUserIDResponseMultiError, or nil if none found.
-func (m *GetStoreByUserIDResponse) ValidateAll() error {
+// GetProductByBarcodeRequestMultiError, or nil if none found.
+func (m *GetProductByBarcodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStoreByUserIDResponse) validate(all bool) error {
+func (m *GetProductByBarcodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
-
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetStoreByUserIDResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetStoreByUserIDResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetStoreByUserIDResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	// no validation rules for StoreId
+
+	if !_GetProductByBarcodeRequest_Barcode_Pattern.MatchString(m.GetBarcode()) {
+		err := GetProductByBarcodeRequestValidationError{
+			field:  "Barcode",
+			reason: "value does not match regex pattern \"^([0-9]{8}|[0-9]{12,14})$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetStoreByUserIDResponseMultiError(errors)
+		return GetProductByBarcodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStoreByUserIDResponseMultiError is an error wrapping multiple validation
-// errors returned by GetStoreByUserIDResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetStoreByUserIDResponseMultiError []error
+// GetProductByBarcodeRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProductByBarcodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetProductByBarcodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStoreByUserIDResponseMultiError) Error() string {
+func (m GetProductByBarcodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2923,11 +10958,11 @@ func (m GetStoreByUserIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStoreByUserIDResponseMultiError) AllErrors() []error { return m }
+func (m GetProductByBarcodeRequestMultiError) AllErrors() []error { return m }
 
-// GetStoreByUserIDResponseValidationError is the validation error returned by
-// GetStoreByUserIDResponse.Validate if the designated constraints aren't met.
-type GetStoreByUserIDResponseValidationError struct {
+// GetProductByBarcodeRequestValidationError is the validation error returned
+// by GetProductByBarcodeRequest.Validate if the designated constraints aren't met.
+type GetProductByBarcodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2935,24 +10970,24 @@ type GetStoreByUserIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStoreByUserIDResponseValidationError) Field() string { return e.field }
+func (e GetProductByBarcodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStoreByUserIDResponseValidationError) Reason() string { return e.reason }
+func (e GetProductByBarcodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStoreByUserIDResponseValidationError) Cause() error { return e.cause }
+func (e GetProductByBarcodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStoreByUserIDResponseValidationError) Key() bool { return e.key }
+func (e GetProductByBarcodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStoreByUserIDResponseValidationError) ErrorName() string {
-	return "GetStoreByUserIDResponseValidationError"
+func (e GetProductByBarcodeRequestValidationError) ErrorName() string {
+	return "GetProductByBarcodeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStoreByUserIDResponseValidationError) Error() string {
+func (e GetProductByBarcodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2964,14 +10999,14 @@ func (e GetStoreByUserIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStoreByUserIDResponse.%s: %s%s",
+		"invalid %sGetProductByBarcodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStoreByUserIDResponseValidationError{}
+var _ error = GetProductByBarcodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2979,48 +11014,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStoreByUserIDResponseValidationError{}
+} = GetProductByBarcodeRequestValidationError{}
 
-// Validate checks the field values on OpenCloseStoreRequest with the rules
+var _GetProductByBarcodeRequest_Barcode_Pattern = regexp.MustCompile("^([0-9]{8}|[0-9]{12,14})$")
+
+// Validate checks the field values on BatchGetProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OpenCloseStoreRequest) Validate() error {
+func (m *BatchGetProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OpenCloseStoreRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OpenCloseStoreRequestMultiError, or nil if none found.
-func (m *OpenCloseStoreRequest) ValidateAll() error {
+// BatchGetProductsRequestMultiError, or nil if none found.
+func (m *BatchGetProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OpenCloseStoreRequest) validate(all bool) error {
+func (m *BatchGetProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StoreId
-
-	// no validation rules for IsActive
+	if l := len(m.GetIds()); l < 1 || l > 500 {
+		err := BatchGetProductsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return OpenCloseStoreRequestMultiError(errors)
+		return BatchGetProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// OpenCloseStoreRequestMultiError is an error wrapping multiple validation
-// errors returned by OpenCloseStoreRequest.ValidateAll() if the designated
+// BatchGetProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProductsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type OpenCloseStoreRequestMultiError []error
+type BatchGetProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OpenCloseStoreRequestMultiError) Error() string {
+func (m BatchGetProductsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3029,11 +11073,11 @@ func (m OpenCloseStoreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OpenCloseStoreRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetProductsRequestMultiError) AllErrors() []error { return m }
 
-// OpenCloseStoreRequestValidationError is the validation error returned by
-// OpenCloseStoreRequest.Validate if the designated constraints aren't met.
-type OpenCloseStoreRequestValidationError struct {
+// BatchGetProductsRequestValidationError is the validation error returned by
+// BatchGetProductsRequest.Validate if the designated constraints aren't met.
+type BatchGetProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3041,24 +11085,24 @@ type OpenCloseStoreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OpenCloseStoreRequestValidationError) Field() string { return e.field }
+func (e BatchGetProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OpenCloseStoreRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OpenCloseStoreRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetProductsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OpenCloseStoreRequestValidationError) Key() bool { return e.key }
+func (e BatchGetProductsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OpenCloseStoreRequestValidationError) ErrorName() string {
-	return "OpenCloseStoreRequestValidationError"
+func (e BatchGetProductsRequestValidationError) ErrorName() string {
+	return "BatchGetProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OpenCloseStoreRequestValidationError) Error() string {
+func (e BatchGetProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3070,14 +11114,14 @@ func (e OpenCloseStoreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOpenCloseStoreRequest.%s: %s%s",
+		"invalid %sBatchGetProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OpenCloseStoreRequestValidationError{}
+var _ error = BatchGetProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3085,48 +11129,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OpenCloseStoreRequestValidationError{}
+} = BatchGetProductsRequestValidationError{}
 
-// Validate checks the field values on OpenCloseStoreResponse with the rules
+// Validate checks the field values on BatchGetProductResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OpenCloseStoreResponse) Validate() error {
+func (m *BatchGetProductResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OpenCloseStoreResponse with the rules
+// ValidateAll checks the field values on BatchGetProductResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OpenCloseStoreResponseMultiError, or nil if none found.
-func (m *OpenCloseStoreResponse) ValidateAll() error {
+// BatchGetProductResultMultiError, or nil if none found.
+func (m *BatchGetProductResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OpenCloseStoreResponse) validate(all bool) error {
+func (m *BatchGetProductResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Id
 
-	// no validation rules for Message
+	// no validation rules for Found
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchGetProductResultValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchGetProductResultValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchGetProductResultValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OpenCloseStoreResponseMultiError(errors)
+		return BatchGetProductResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// OpenCloseStoreResponseMultiError is an error wrapping multiple validation
-// errors returned by OpenCloseStoreResponse.ValidateAll() if the designated
+// BatchGetProductResultMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProductResult.ValidateAll() if the designated
 // constraints aren't met.
-type OpenCloseStoreResponseMultiError []error
+type BatchGetProductResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OpenCloseStoreResponseMultiError) Error() string {
+func (m BatchGetProductResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3135,11 +11208,11 @@ func (m OpenCloseStoreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OpenCloseStoreResponseMultiError) AllErrors() []error { return m }
+func (m BatchGetProductResultMultiError) AllErrors() []error { return m }
 
-// OpenCloseStoreResponseValidationError is the validation error returned by
-// OpenCloseStoreResponse.Validate if the designated constraints aren't met.
-type OpenCloseStoreResponseValidationError struct {
+// BatchGetProductResultValidationError is the validation error returned by
+// BatchGetProductResult.Validate if the designated constraints aren't met.
+type BatchGetProductResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3147,24 +11220,24 @@ type OpenCloseStoreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OpenCloseStoreResponseValidationError) Field() string { return e.field }
+func (e BatchGetProductResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OpenCloseStoreResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetProductResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OpenCloseStoreResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetProductResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OpenCloseStoreResponseValidationError) Key() bool { return e.key }
+func (e BatchGetProductResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OpenCloseStoreResponseValidationError) ErrorName() string {
-	return "OpenCloseStoreResponseValidationError"
+func (e BatchGetProductResultValidationError) ErrorName() string {
+	return "BatchGetProductResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OpenCloseStoreResponseValidationError) Error() string {
+func (e BatchGetProductResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3176,14 +11249,14 @@ func (e OpenCloseStoreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOpenCloseStoreResponse.%s: %s%s",
+		"invalid %sBatchGetProductResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OpenCloseStoreResponseValidationError{}
+var _ error = BatchGetProductResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -3191,49 +11264,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OpenCloseStoreResponseValidationError{}
+} = BatchGetProductResultValidationError{}
 
-// Validate checks the field values on InsertProductsRequest with the rules
+// Validate checks the field values on BatchGetProductsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InsertProductsRequest) Validate() error {
+func (m *BatchGetProductsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InsertProductsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InsertProductsRequestMultiError, or nil if none found.
-func (m *InsertProductsRequest) ValidateAll() error {
+// BatchGetProductsResponseMultiError, or nil if none found.
+func (m *BatchGetProductsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InsertProductsRequest) validate(all bool) error {
+func (m *BatchGetProductsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StoreId
+	// no validation rules for Code
 
-	for idx, item := range m.GetProductList() {
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InsertProductsRequestValidationError{
-						field:  fmt.Sprintf("ProductList[%v]", idx),
+					errors = append(errors, BatchGetProductsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, InsertProductsRequestValidationError{
-						field:  fmt.Sprintf("ProductList[%v]", idx),
+					errors = append(errors, BatchGetProductsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3241,8 +11316,8 @@ func (m *InsertProductsRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return InsertProductsRequestValidationError{
-					field:  fmt.Sprintf("ProductList[%v]", idx),
+				return BatchGetProductsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3252,19 +11327,19 @@ func (m *InsertProductsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return InsertProductsRequestMultiError(errors)
+		return BatchGetProductsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InsertProductsRequestMultiError is an error wrapping multiple validation
-// errors returned by InsertProductsRequest.ValidateAll() if the designated
+// BatchGetProductsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProductsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type InsertProductsRequestMultiError []error
+type BatchGetProductsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InsertProductsRequestMultiError) Error() string {
+func (m BatchGetProductsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3273,11 +11348,11 @@ func (m InsertProductsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InsertProductsRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetProductsResponseMultiError) AllErrors() []error { return m }
 
-// InsertProductsRequestValidationError is the validation error returned by
-// InsertProductsRequest.Validate if the designated constraints aren't met.
-type InsertProductsRequestValidationError struct {
+// BatchGetProductsResponseValidationError is the validation error returned by
+// BatchGetProductsResponse.Validate if the designated constraints aren't met.
+type BatchGetProductsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3285,24 +11360,24 @@ type InsertProductsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InsertProductsRequestValidationError) Field() string { return e.field }
+func (e BatchGetProductsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InsertProductsRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetProductsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InsertProductsRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetProductsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InsertProductsRequestValidationError) Key() bool { return e.key }
+func (e BatchGetProductsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InsertProductsRequestValidationError) ErrorName() string {
-	return "InsertProductsRequestValidationError"
+func (e BatchGetProductsResponseValidationError) ErrorName() string {
+	return "BatchGetProductsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InsertProductsRequestValidationError) Error() string {
+func (e BatchGetProductsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3314,14 +11389,14 @@ func (e InsertProductsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInsertProductsRequest.%s: %s%s",
+		"invalid %sBatchGetProductsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InsertProductsRequestValidationError{}
+var _ error = BatchGetProductsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3329,24 +11404,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InsertProductsRequestValidationError{}
+} = BatchGetProductsResponseValidationError{}
 
-// Validate checks the field values on UpdateProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateProductDiscountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProductRequest) Validate() error {
+func (m *CreateProductDiscountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateProductRequestMultiError, or nil if none found.
-func (m *UpdateProductRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateProductDiscountRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateProductDiscountRequestMultiError, or nil if none found.
+func (m *CreateProductDiscountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProductRequest) validate(all bool) error {
+func (m *CreateProductDiscountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3355,29 +11430,91 @@ func (m *UpdateProductRequest) validate(all bool) error {
 
 	// no validation rules for ProductId
 
+	// no validation rules for DiscountType
+
+	// no validation rules for Value
+
 	if all {
-		switch v := interface{}(m.GetProduct()).(type) {
+		switch v := interface{}(m.GetStartsAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProductRequestValidationError{
-					field:  "Product",
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "StartsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProductRequestValidationError{
-					field:  "Product",
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "StartsAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateProductRequestValidationError{
-				field:  "Product",
+			return CreateProductDiscountRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProductDiscountRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProductDiscountRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProductDiscountRequestValidationError{
+				field:  "Amount",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3385,19 +11522,19 @@ func (m *UpdateProductRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateProductRequestMultiError(errors)
+		return CreateProductDiscountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProductRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProductRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateProductRequestMultiError []error
+// CreateProductDiscountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateProductDiscountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateProductDiscountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProductRequestMultiError) Error() string {
+func (m CreateProductDiscountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3406,11 +11543,12 @@ func (m UpdateProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+func (m CreateProductDiscountRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProductRequestValidationError is the validation error returned by
-// UpdateProductRequest.Validate if the designated constraints aren't met.
-type UpdateProductRequestValidationError struct {
+// CreateProductDiscountRequestValidationError is the validation error
+// returned by CreateProductDiscountRequest.Validate if the designated
+// constraints aren't met.
+type CreateProductDiscountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3418,24 +11556,24 @@ type UpdateProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProductRequestValidationError) Field() string { return e.field }
+func (e CreateProductDiscountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+func (e CreateProductDiscountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+func (e CreateProductDiscountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+func (e CreateProductDiscountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProductRequestValidationError) ErrorName() string {
-	return "UpdateProductRequestValidationError"
+func (e CreateProductDiscountRequestValidationError) ErrorName() string {
+	return "CreateProductDiscountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProductRequestValidationError) Error() string {
+func (e CreateProductDiscountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3447,14 +11585,14 @@ func (e UpdateProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProductRequest.%s: %s%s",
+		"invalid %sCreateProductDiscountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProductRequestValidationError{}
+var _ error = CreateProductDiscountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3462,46 +11600,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProductRequestValidationError{}
+} = CreateProductDiscountRequestValidationError{}
 
-// Validate checks the field values on DeleteProductRequest with the rules
+// Validate checks the field values on ProductDiscountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductRequest) Validate() error {
+func (m *ProductDiscountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ProductDiscountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProductRequestMultiError, or nil if none found.
-func (m *DeleteProductRequest) ValidateAll() error {
+// ProductDiscountResponseMultiError, or nil if none found.
+func (m *ProductDiscountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductRequest) validate(all bool) error {
+func (m *ProductDiscountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductId
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductDiscountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductDiscountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductDiscountResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteProductRequestMultiError(errors)
+		return ProductDiscountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProductRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// ProductDiscountResponseMultiError is an error wrapping multiple validation
+// errors returned by ProductDiscountResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteProductRequestMultiError []error
+type ProductDiscountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductRequestMultiError) Error() string {
+func (m ProductDiscountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3510,11 +11679,11 @@ func (m DeleteProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+func (m ProductDiscountResponseMultiError) AllErrors() []error { return m }
 
-// DeleteProductRequestValidationError is the validation error returned by
-// DeleteProductRequest.Validate if the designated constraints aren't met.
-type DeleteProductRequestValidationError struct {
+// ProductDiscountResponseValidationError is the validation error returned by
+// ProductDiscountResponse.Validate if the designated constraints aren't met.
+type ProductDiscountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3522,24 +11691,24 @@ type DeleteProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductRequestValidationError) Field() string { return e.field }
+func (e ProductDiscountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+func (e ProductDiscountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+func (e ProductDiscountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+func (e ProductDiscountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductRequestValidationError) ErrorName() string {
-	return "DeleteProductRequestValidationError"
+func (e ProductDiscountResponseValidationError) ErrorName() string {
+	return "ProductDiscountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductRequestValidationError) Error() string {
+func (e ProductDiscountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3551,14 +11720,14 @@ func (e DeleteProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductRequest.%s: %s%s",
+		"invalid %sProductDiscountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductRequestValidationError{}
+var _ error = ProductDiscountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3566,73 +11735,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductRequestValidationError{}
+} = ProductDiscountResponseValidationError{}
 
-// Validate checks the field values on UpsertUnitOfMeasureRequest with the
+// Validate checks the field values on ListProductDiscountsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertUnitOfMeasureRequest) Validate() error {
+func (m *ListProductDiscountsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertUnitOfMeasureRequest with the
+// ValidateAll checks the field values on ListProductDiscountsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertUnitOfMeasureRequestMultiError, or nil if none found.
-func (m *UpsertUnitOfMeasureRequest) ValidateAll() error {
+// ListProductDiscountsRequestMultiError, or nil if none found.
+func (m *ListProductDiscountsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertUnitOfMeasureRequest) validate(all bool) error {
+func (m *ListProductDiscountsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUom()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertUnitOfMeasureRequestValidationError{
-					field:  "Uom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertUnitOfMeasureRequestValidationError{
-					field:  "Uom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertUnitOfMeasureRequestValidationError{
-				field:  "Uom",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ProductId
 
 	if len(errors) > 0 {
-		return UpsertUnitOfMeasureRequestMultiError(errors)
+		return ListProductDiscountsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertUnitOfMeasureRequestMultiError is an error wrapping multiple
-// validation errors returned by UpsertUnitOfMeasureRequest.ValidateAll() if
+// ListProductDiscountsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListProductDiscountsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type UpsertUnitOfMeasureRequestMultiError []error
+type ListProductDiscountsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertUnitOfMeasureRequestMultiError) Error() string {
+func (m ListProductDiscountsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3641,11 +11783,11 @@ func (m UpsertUnitOfMeasureRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertUnitOfMeasureRequestMultiError) AllErrors() []error { return m }
+func (m ListProductDiscountsRequestMultiError) AllErrors() []error { return m }
 
-// UpsertUnitOfMeasureRequestValidationError is the validation error returned
-// by UpsertUnitOfMeasureRequest.Validate if the designated constraints aren't met.
-type UpsertUnitOfMeasureRequestValidationError struct {
+// ListProductDiscountsRequestValidationError is the validation error returned
+// by ListProductDiscountsRequest.Validate if the designated constraints aren't met.
+type ListProductDiscountsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3653,24 +11795,24 @@ type UpsertUnitOfMeasureRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertUnitOfMeasureRequestValidationError) Field() string { return e.field }
+func (e ListProductDiscountsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertUnitOfMeasureRequestValidationError) Reason() string { return e.reason }
+func (e ListProductDiscountsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertUnitOfMeasureRequestValidationError) Cause() error { return e.cause }
+func (e ListProductDiscountsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertUnitOfMeasureRequestValidationError) Key() bool { return e.key }
+func (e ListProductDiscountsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertUnitOfMeasureRequestValidationError) ErrorName() string {
-	return "UpsertUnitOfMeasureRequestValidationError"
+func (e ListProductDiscountsRequestValidationError) ErrorName() string {
+	return "ListProductDiscountsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertUnitOfMeasureRequestValidationError) Error() string {
+func (e ListProductDiscountsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3682,14 +11824,14 @@ func (e UpsertUnitOfMeasureRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertUnitOfMeasureRequest.%s: %s%s",
+		"invalid %sListProductDiscountsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertUnitOfMeasureRequestValidationError{}
+var _ error = ListProductDiscountsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3697,24 +11839,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertUnitOfMeasureRequestValidationError{}
+} = ListProductDiscountsRequestValidationError{}
 
-// Validate checks the field values on UpsertUnitOfMeasureResponse with the
+// Validate checks the field values on ListProductDiscountsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertUnitOfMeasureResponse) Validate() error {
+func (m *ListProductDiscountsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertUnitOfMeasureResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpsertUnitOfMeasureResponseMultiError, or nil if none found.
-func (m *UpsertUnitOfMeasureResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProductDiscountsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListProductDiscountsResponseMultiError, or nil if none found.
+func (m *ListProductDiscountsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertUnitOfMeasureResponse) validate(all bool) error {
+func (m *ListProductDiscountsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3725,20 +11867,54 @@ func (m *UpsertUnitOfMeasureResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductDiscountsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductDiscountsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProductDiscountsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return UpsertUnitOfMeasureResponseMultiError(errors)
+		return ListProductDiscountsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertUnitOfMeasureResponseMultiError is an error wrapping multiple
-// validation errors returned by UpsertUnitOfMeasureResponse.ValidateAll() if
+// ListProductDiscountsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProductDiscountsResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpsertUnitOfMeasureResponseMultiError []error
+type ListProductDiscountsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertUnitOfMeasureResponseMultiError) Error() string {
+func (m ListProductDiscountsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3747,12 +11923,12 @@ func (m UpsertUnitOfMeasureResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertUnitOfMeasureResponseMultiError) AllErrors() []error { return m }
+func (m ListProductDiscountsResponseMultiError) AllErrors() []error { return m }
 
-// UpsertUnitOfMeasureResponseValidationError is the validation error returned
-// by UpsertUnitOfMeasureResponse.Validate if the designated constraints
-// aren't met.
-type UpsertUnitOfMeasureResponseValidationError struct {
+// ListProductDiscountsResponseValidationError is the validation error
+// returned by ListProductDiscountsResponse.Validate if the designated
+// constraints aren't met.
+type ListProductDiscountsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3760,24 +11936,24 @@ type UpsertUnitOfMeasureResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertUnitOfMeasureResponseValidationError) Field() string { return e.field }
+func (e ListProductDiscountsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertUnitOfMeasureResponseValidationError) Reason() string { return e.reason }
+func (e ListProductDiscountsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertUnitOfMeasureResponseValidationError) Cause() error { return e.cause }
+func (e ListProductDiscountsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertUnitOfMeasureResponseValidationError) Key() bool { return e.key }
+func (e ListProductDiscountsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertUnitOfMeasureResponseValidationError) ErrorName() string {
-	return "UpsertUnitOfMeasureResponseValidationError"
+func (e ListProductDiscountsResponseValidationError) ErrorName() string {
+	return "ListProductDiscountsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertUnitOfMeasureResponseValidationError) Error() string {
+func (e ListProductDiscountsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3789,14 +11965,14 @@ func (e UpsertUnitOfMeasureResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertUnitOfMeasureResponse.%s: %s%s",
+		"invalid %sListProductDiscountsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertUnitOfMeasureResponseValidationError{}
+var _ error = ListProductDiscountsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3804,84 +11980,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertUnitOfMeasureResponseValidationError{}
+} = ListProductDiscountsResponseValidationError{}
 
-// Validate checks the field values on UpdateUnitOfMeasureRequest with the
+// Validate checks the field values on DeleteProductDiscountRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUnitOfMeasureRequest) Validate() error {
+func (m *DeleteProductDiscountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUnitOfMeasureRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateUnitOfMeasureRequestMultiError, or nil if none found.
-func (m *UpdateUnitOfMeasureRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProductDiscountRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteProductDiscountRequestMultiError, or nil if none found.
+func (m *DeleteProductDiscountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUnitOfMeasureRequest) validate(all bool) error {
+func (m *DeleteProductDiscountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUomId() <= 1 {
-		err := UpdateUnitOfMeasureRequestValidationError{
-			field:  "UomId",
-			reason: "value must be greater than 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProductId
 
-	if all {
-		switch v := interface{}(m.GetUom()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUnitOfMeasureRequestValidationError{
-					field:  "Uom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUnitOfMeasureRequestValidationError{
-					field:  "Uom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUnitOfMeasureRequestValidationError{
-				field:  "Uom",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateUnitOfMeasureRequestMultiError(errors)
+		return DeleteProductDiscountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUnitOfMeasureRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUnitOfMeasureRequest.ValidateAll() if
+// DeleteProductDiscountRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteProductDiscountRequest.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateUnitOfMeasureRequestMultiError []error
+type DeleteProductDiscountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUnitOfMeasureRequestMultiError) Error() string {
+func (m DeleteProductDiscountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3890,11 +12030,12 @@ func (m UpdateUnitOfMeasureRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUnitOfMeasureRequestMultiError) AllErrors() []error { return m }
+func (m DeleteProductDiscountRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUnitOfMeasureRequestValidationError is the validation error returned
-// by UpdateUnitOfMeasureRequest.Validate if the designated constraints aren't met.
-type UpdateUnitOfMeasureRequestValidationError struct {
+// DeleteProductDiscountRequestValidationError is the validation error
+// returned by DeleteProductDiscountRequest.Validate if the designated
+// constraints aren't met.
+type DeleteProductDiscountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3902,24 +12043,24 @@ type UpdateUnitOfMeasureRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUnitOfMeasureRequestValidationError) Field() string { return e.field }
+func (e DeleteProductDiscountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUnitOfMeasureRequestValidationError) Reason() string { return e.reason }
+func (e DeleteProductDiscountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUnitOfMeasureRequestValidationError) Cause() error { return e.cause }
+func (e DeleteProductDiscountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUnitOfMeasureRequestValidationError) Key() bool { return e.key }
+func (e DeleteProductDiscountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUnitOfMeasureRequestValidationError) ErrorName() string {
-	return "UpdateUnitOfMeasureRequestValidationError"
+func (e DeleteProductDiscountRequestValidationError) ErrorName() string {
+	return "DeleteProductDiscountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUnitOfMeasureRequestValidationError) Error() string {
+func (e DeleteProductDiscountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3931,14 +12072,14 @@ func (e UpdateUnitOfMeasureRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUnitOfMeasureRequest.%s: %s%s",
+		"invalid %sDeleteProductDiscountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUnitOfMeasureRequestValidationError{}
+var _ error = DeleteProductDiscountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3946,48 +12087,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUnitOfMeasureRequestValidationError{}
+} = DeleteProductDiscountRequestValidationError{}
 
-// Validate checks the field values on UpdateUnitOfMeasureResponse with the
+// Validate checks the field values on ListProductPriceHistoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUnitOfMeasureResponse) Validate() error {
+func (m *ListProductPriceHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUnitOfMeasureResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateUnitOfMeasureResponseMultiError, or nil if none found.
-func (m *UpdateUnitOfMeasureResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProductPriceHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListProductPriceHistoryRequestMultiError, or nil if none found.
+func (m *ListProductPriceHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUnitOfMeasureResponse) validate(all bool) error {
+func (m *ListProductPriceHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for ProductId
 
-	// no validation rules for Message
+	if m.GetPage() < 0 {
+		err := ListProductPriceHistoryRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListProductPriceHistoryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateUnitOfMeasureResponseMultiError(errors)
+		return ListProductPriceHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUnitOfMeasureResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUnitOfMeasureResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUnitOfMeasureResponseMultiError []error
+// ListProductPriceHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by ListProductPriceHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListProductPriceHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUnitOfMeasureResponseMultiError) Error() string {
+func (m ListProductPriceHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3996,12 +12157,12 @@ func (m UpdateUnitOfMeasureResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUnitOfMeasureResponseMultiError) AllErrors() []error { return m }
+func (m ListProductPriceHistoryRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUnitOfMeasureResponseValidationError is the validation error returned
-// by UpdateUnitOfMeasureResponse.Validate if the designated constraints
-// aren't met.
-type UpdateUnitOfMeasureResponseValidationError struct {
+// ListProductPriceHistoryRequestValidationError is the validation error
+// returned by ListProductPriceHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ListProductPriceHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4009,24 +12170,24 @@ type UpdateUnitOfMeasureResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUnitOfMeasureResponseValidationError) Field() string { return e.field }
+func (e ListProductPriceHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUnitOfMeasureResponseValidationError) Reason() string { return e.reason }
+func (e ListProductPriceHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUnitOfMeasureResponseValidationError) Cause() error { return e.cause }
+func (e ListProductPriceHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUnitOfMeasureResponseValidationError) Key() bool { return e.key }
+func (e ListProductPriceHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUnitOfMeasureResponseValidationError) ErrorName() string {
-	return "UpdateUnitOfMeasureResponseValidationError"
+func (e ListProductPriceHistoryRequestValidationError) ErrorName() string {
+	return "ListProductPriceHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUnitOfMeasureResponseValidationError) Error() string {
+func (e ListProductPriceHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4038,14 +12199,14 @@ func (e UpdateUnitOfMeasureResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUnitOfMeasureResponse.%s: %s%s",
+		"invalid %sListProductPriceHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUnitOfMeasureResponseValidationError{}
+var _ error = ListProductPriceHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4053,75 +12214,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUnitOfMeasureResponseValidationError{}
+} = ListProductPriceHistoryRequestValidationError{}
 
-// Validate checks the field values on UpsertProductCategoryRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertProductCategoryRequest) Validate() error {
+// Validate checks the field values on ListProductPriceHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProductPriceHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertProductCategoryRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpsertProductCategoryRequestMultiError, or nil if none found.
-func (m *UpsertProductCategoryRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProductPriceHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListProductPriceHistoryResponseMultiError, or nil if none found.
+func (m *ListProductPriceHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertProductCategoryRequest) validate(all bool) error {
+func (m *ListProductPriceHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Code
 
-	if all {
-		switch v := interface{}(m.GetProductCategory()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertProductCategoryRequestValidationError{
-					field:  "ProductCategory",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProductPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProductPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertProductCategoryRequestValidationError{
-					field:  "ProductCategory",
+				return ListProductPriceHistoryResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProductCategory()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertProductCategoryRequestValidationError{
-				field:  "ProductCategory",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpsertProductCategoryRequestMultiError(errors)
+		return ListProductPriceHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertProductCategoryRequestMultiError is an error wrapping multiple
-// validation errors returned by UpsertProductCategoryRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpsertProductCategoryRequestMultiError []error
+// ListProductPriceHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProductPriceHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListProductPriceHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertProductCategoryRequestMultiError) Error() string {
+func (m ListProductPriceHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4130,12 +12299,12 @@ func (m UpsertProductCategoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertProductCategoryRequestMultiError) AllErrors() []error { return m }
+func (m ListProductPriceHistoryResponseMultiError) AllErrors() []error { return m }
 
-// UpsertProductCategoryRequestValidationError is the validation error returned
-// by UpsertProductCategoryRequest.Validate if the designated constraints
-// aren't met.
-type UpsertProductCategoryRequestValidationError struct {
+// ListProductPriceHistoryResponseValidationError is the validation error
+// returned by ListProductPriceHistoryResponse.Validate if the designated
+// constraints aren't met.
+type ListProductPriceHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4143,24 +12312,24 @@ type UpsertProductCategoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertProductCategoryRequestValidationError) Field() string { return e.field }
+func (e ListProductPriceHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertProductCategoryRequestValidationError) Reason() string { return e.reason }
+func (e ListProductPriceHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertProductCategoryRequestValidationError) Cause() error { return e.cause }
+func (e ListProductPriceHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertProductCategoryRequestValidationError) Key() bool { return e.key }
+func (e ListProductPriceHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertProductCategoryRequestValidationError) ErrorName() string {
-	return "UpsertProductCategoryRequestValidationError"
+func (e ListProductPriceHistoryResponseValidationError) ErrorName() string {
+	return "ListProductPriceHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertProductCategoryRequestValidationError) Error() string {
+func (e ListProductPriceHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4172,14 +12341,14 @@ func (e UpsertProductCategoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertProductCategoryRequest.%s: %s%s",
+		"invalid %sListProductPriceHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertProductCategoryRequestValidationError{}
+var _ error = ListProductPriceHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4187,48 +12356,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertProductCategoryRequestValidationError{}
+} = ListProductPriceHistoryResponseValidationError{}
 
-// Validate checks the field values on UpsertProductCategoryResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertProductCategoryResponse) Validate() error {
+func (m *GetCategoryTreeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertProductCategoryResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpsertProductCategoryResponseMultiError, or nil if none found.
-func (m *UpsertProductCategoryResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetCategoryTreeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeRequestMultiError, or nil if none found.
+func (m *GetCategoryTreeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertProductCategoryResponse) validate(all bool) error {
+func (m *GetCategoryTreeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for RootId
 
-	// no validation rules for Message
+	// no validation rules for IsIncludeDeactivated
 
 	if len(errors) > 0 {
-		return UpsertProductCategoryResponseMultiError(errors)
+		return GetCategoryTreeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertProductCategoryResponseMultiError is an error wrapping multiple
-// validation errors returned by UpsertProductCategoryResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpsertProductCategoryResponseMultiError []error
+// GetCategoryTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertProductCategoryResponseMultiError) Error() string {
+func (m GetCategoryTreeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4237,12 +12406,11 @@ func (m UpsertProductCategoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertProductCategoryResponseMultiError) AllErrors() []error { return m }
+func (m GetCategoryTreeRequestMultiError) AllErrors() []error { return m }
 
-// UpsertProductCategoryResponseValidationError is the validation error
-// returned by UpsertProductCategoryResponse.Validate if the designated
-// constraints aren't met.
-type UpsertProductCategoryResponseValidationError struct {
+// GetCategoryTreeRequestValidationError is the validation error returned by
+// GetCategoryTreeRequest.Validate if the designated constraints aren't met.
+type GetCategoryTreeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4250,24 +12418,24 @@ type UpsertProductCategoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertProductCategoryResponseValidationError) Field() string { return e.field }
+func (e GetCategoryTreeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertProductCategoryResponseValidationError) Reason() string { return e.reason }
+func (e GetCategoryTreeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertProductCategoryResponseValidationError) Cause() error { return e.cause }
+func (e GetCategoryTreeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertProductCategoryResponseValidationError) Key() bool { return e.key }
+func (e GetCategoryTreeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertProductCategoryResponseValidationError) ErrorName() string {
-	return "UpsertProductCategoryResponseValidationError"
+func (e GetCategoryTreeRequestValidationError) ErrorName() string {
+	return "GetCategoryTreeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertProductCategoryResponseValidationError) Error() string {
+func (e GetCategoryTreeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4279,14 +12447,14 @@ func (e UpsertProductCategoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertProductCategoryResponse.%s: %s%s",
+		"invalid %sGetCategoryTreeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertProductCategoryResponseValidationError{}
+var _ error = GetCategoryTreeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4294,73 +12462,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertProductCategoryResponseValidationError{}
+} = GetCategoryTreeRequestValidationError{}
 
-// Validate checks the field values on UpsertProductTypeRequest with the rules
+// Validate checks the field values on GetCategoryTreeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertProductTypeRequest) Validate() error {
+func (m *GetCategoryTreeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertProductTypeRequest with the
+// ValidateAll checks the field values on GetCategoryTreeResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertProductTypeRequestMultiError, or nil if none found.
-func (m *UpsertProductTypeRequest) ValidateAll() error {
+// GetCategoryTreeResponseMultiError, or nil if none found.
+func (m *GetCategoryTreeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertProductTypeRequest) validate(all bool) error {
+func (m *GetCategoryTreeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProductType()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertProductTypeRequestValidationError{
-					field:  "ProductType",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertProductTypeRequestValidationError{
-					field:  "ProductType",
+				return GetCategoryTreeResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProductType()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertProductTypeRequestValidationError{
-				field:  "ProductType",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpsertProductTypeRequestMultiError(errors)
+		return GetCategoryTreeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertProductTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by UpsertProductTypeRequest.ValidateAll() if the designated
+// GetCategoryTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpsertProductTypeRequestMultiError []error
+type GetCategoryTreeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertProductTypeRequestMultiError) Error() string {
+func (m GetCategoryTreeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4369,11 +12546,11 @@ func (m UpsertProductTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertProductTypeRequestMultiError) AllErrors() []error { return m }
+func (m GetCategoryTreeResponseMultiError) AllErrors() []error { return m }
 
-// UpsertProductTypeRequestValidationError is the validation error returned by
-// UpsertProductTypeRequest.Validate if the designated constraints aren't met.
-type UpsertProductTypeRequestValidationError struct {
+// GetCategoryTreeResponseValidationError is the validation error returned by
+// GetCategoryTreeResponse.Validate if the designated constraints aren't met.
+type GetCategoryTreeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4381,24 +12558,24 @@ type UpsertProductTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertProductTypeRequestValidationError) Field() string { return e.field }
+func (e GetCategoryTreeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertProductTypeRequestValidationError) Reason() string { return e.reason }
+func (e GetCategoryTreeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertProductTypeRequestValidationError) Cause() error { return e.cause }
+func (e GetCategoryTreeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertProductTypeRequestValidationError) Key() bool { return e.key }
+func (e GetCategoryTreeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertProductTypeRequestValidationError) ErrorName() string {
-	return "UpsertProductTypeRequestValidationError"
+func (e GetCategoryTreeResponseValidationError) ErrorName() string {
+	return "GetCategoryTreeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertProductTypeRequestValidationError) Error() string {
+func (e GetCategoryTreeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4410,14 +12587,14 @@ func (e UpsertProductTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertProductTypeRequest.%s: %s%s",
+		"invalid %sGetCategoryTreeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertProductTypeRequestValidationError{}
+var _ error = GetCategoryTreeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4425,48 +12602,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertProductTypeRequestValidationError{}
+} = GetCategoryTreeResponseValidationError{}
 
-// Validate checks the field values on UpsertProductTypeResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MoveProductCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertProductTypeResponse) Validate() error {
+func (m *MoveProductCategoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertProductTypeResponse with the
+// ValidateAll checks the field values on MoveProductCategoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertProductTypeResponseMultiError, or nil if none found.
-func (m *UpsertProductTypeResponse) ValidateAll() error {
+// MoveProductCategoryRequestMultiError, or nil if none found.
+func (m *MoveProductCategoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertProductTypeResponse) validate(all bool) error {
+func (m *MoveProductCategoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Id
 
-	// no validation rules for Message
+	// no validation rules for ParentId
 
 	if len(errors) > 0 {
-		return UpsertProductTypeResponseMultiError(errors)
+		return MoveProductCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertProductTypeResponseMultiError is an error wrapping multiple validation
-// errors returned by UpsertProductTypeResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpsertProductTypeResponseMultiError []error
+// MoveProductCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by MoveProductCategoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MoveProductCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertProductTypeResponseMultiError) Error() string {
+func (m MoveProductCategoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4475,11 +12652,11 @@ func (m UpsertProductTypeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertProductTypeResponseMultiError) AllErrors() []error { return m }
+func (m MoveProductCategoryRequestMultiError) AllErrors() []error { return m }
 
-// UpsertProductTypeResponseValidationError is the validation error returned by
-// UpsertProductTypeResponse.Validate if the designated constraints aren't met.
-type UpsertProductTypeResponseValidationError struct {
+// MoveProductCategoryRequestValidationError is the validation error returned
+// by MoveProductCategoryRequest.Validate if the designated constraints aren't met.
+type MoveProductCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4487,24 +12664,24 @@ type UpsertProductTypeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertProductTypeResponseValidationError) Field() string { return e.field }
+func (e MoveProductCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertProductTypeResponseValidationError) Reason() string { return e.reason }
+func (e MoveProductCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertProductTypeResponseValidationError) Cause() error { return e.cause }
+func (e MoveProductCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertProductTypeResponseValidationError) Key() bool { return e.key }
+func (e MoveProductCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertProductTypeResponseValidationError) ErrorName() string {
-	return "UpsertProductTypeResponseValidationError"
+func (e MoveProductCategoryRequestValidationError) ErrorName() string {
+	return "MoveProductCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertProductTypeResponseValidationError) Error() string {
+func (e MoveProductCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4516,14 +12693,14 @@ func (e UpsertProductTypeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertProductTypeResponse.%s: %s%s",
+		"invalid %sMoveProductCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertProductTypeResponseValidationError{}
+var _ error = MoveProductCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4531,50 +12708,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertProductTypeResponseValidationError{}
-
-// Validate checks the field values on GetProductListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+} = MoveProductCategoryRequestValidationError{}
+
+// Validate checks the field values on RenameProductCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductListRequest) Validate() error {
+func (m *RenameProductCategoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProductListRequestMultiError, or nil if none found.
-func (m *GetProductListRequest) ValidateAll() error {
+// ValidateAll checks the field values on RenameProductCategoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RenameProductCategoryRequestMultiError, or nil if none found.
+func (m *RenameProductCategoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductListRequest) validate(all bool) error {
+func (m *RenameProductCategoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for StoreId
-
-	// no validation rules for ProductTypeId
+	// no validation rules for Id
 
-	// no validation rules for IsIncludeDeactivated
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := RenameProductCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetProductListRequestMultiError(errors)
+		return RenameProductCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductListRequestMultiError is an error wrapping multiple validation
-// errors returned by GetProductListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetProductListRequestMultiError []error
+// RenameProductCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by RenameProductCategoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RenameProductCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductListRequestMultiError) Error() string {
+func (m RenameProductCategoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4583,11 +12767,12 @@ func (m GetProductListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductListRequestMultiError) AllErrors() []error { return m }
+func (m RenameProductCategoryRequestMultiError) AllErrors() []error { return m }
 
-// GetProductListRequestValidationError is the validation error returned by
-// GetProductListRequest.Validate if the designated constraints aren't met.
-type GetProductListRequestValidationError struct {
+// RenameProductCategoryRequestValidationError is the validation error
+// returned by RenameProductCategoryRequest.Validate if the designated
+// constraints aren't met.
+type RenameProductCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4595,24 +12780,24 @@ type GetProductListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductListRequestValidationError) Field() string { return e.field }
+func (e RenameProductCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductListRequestValidationError) Reason() string { return e.reason }
+func (e RenameProductCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductListRequestValidationError) Cause() error { return e.cause }
+func (e RenameProductCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductListRequestValidationError) Key() bool { return e.key }
+func (e RenameProductCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductListRequestValidationError) ErrorName() string {
-	return "GetProductListRequestValidationError"
+func (e RenameProductCategoryRequestValidationError) ErrorName() string {
+	return "RenameProductCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductListRequestValidationError) Error() string {
+func (e RenameProductCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4624,14 +12809,14 @@ func (e GetProductListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductListRequest.%s: %s%s",
+		"invalid %sRenameProductCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductListRequestValidationError{}
+var _ error = RenameProductCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4639,24 +12824,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductListRequestValidationError{}
+} = RenameProductCategoryRequestValidationError{}
 
-// Validate checks the field values on GetProductListResponse with the rules
+// Validate checks the field values on ProductCategoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductListResponse) Validate() error {
+func (m *ProductCategoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductListResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ProductCategoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductListResponseMultiError, or nil if none found.
-func (m *GetProductListResponse) ValidateAll() error {
+// ProductCategoryResponseMultiError, or nil if none found.
+func (m *ProductCategoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductListResponse) validate(all bool) error {
+func (m *ProductCategoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4667,54 +12852,49 @@ func (m *GetProductListResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProductListResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProductListResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductCategoryResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetProductListResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, ProductCategoryResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductCategoryResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetProductListResponseMultiError(errors)
+		return ProductCategoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductListResponseMultiError is an error wrapping multiple validation
-// errors returned by GetProductListResponse.ValidateAll() if the designated
+// ProductCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ProductCategoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetProductListResponseMultiError []error
+type ProductCategoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductListResponseMultiError) Error() string {
+func (m ProductCategoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4723,11 +12903,11 @@ func (m GetProductListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductListResponseMultiError) AllErrors() []error { return m }
+func (m ProductCategoryResponseMultiError) AllErrors() []error { return m }
 
-// GetProductListResponseValidationError is the validation error returned by
-// GetProductListResponse.Validate if the designated constraints aren't met.
-type GetProductListResponseValidationError struct {
+// ProductCategoryResponseValidationError is the validation error returned by
+// ProductCategoryResponse.Validate if the designated constraints aren't met.
+type ProductCategoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4735,24 +12915,24 @@ type GetProductListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductListResponseValidationError) Field() string { return e.field }
+func (e ProductCategoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductListResponseValidationError) Reason() string { return e.reason }
+func (e ProductCategoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductListResponseValidationError) Cause() error { return e.cause }
+func (e ProductCategoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductListResponseValidationError) Key() bool { return e.key }
+func (e ProductCategoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductListResponseValidationError) ErrorName() string {
-	return "GetProductListResponseValidationError"
+func (e ProductCategoryResponseValidationError) ErrorName() string {
+	return "ProductCategoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductListResponseValidationError) Error() string {
+func (e ProductCategoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4764,14 +12944,14 @@ func (e GetProductListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductListResponse.%s: %s%s",
+		"invalid %sProductCategoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductListResponseValidationError{}
+var _ error = ProductCategoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4779,24 +12959,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductListResponseValidationError{}
+} = ProductCategoryResponseValidationError{}
 
-// Validate checks the field values on GetProductByIdRequest with the rules
+// Validate checks the field values on CreateProductLotRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductByIdRequest) Validate() error {
+func (m *CreateProductLotRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductByIdRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateProductLotRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductByIdRequestMultiError, or nil if none found.
-func (m *GetProductByIdRequest) ValidateAll() error {
+// CreateProductLotRequestMultiError, or nil if none found.
+func (m *CreateProductLotRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductByIdRequest) validate(all bool) error {
+func (m *CreateProductLotRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4805,20 +12985,60 @@ func (m *GetProductByIdRequest) validate(all bool) error {
 
 	// no validation rules for ProductId
 
+	if m.GetLot() == nil {
+		err := CreateProductLotRequestValidationError{
+			field:  "Lot",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProductLotRequestValidationError{
+					field:  "Lot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProductLotRequestValidationError{
+					field:  "Lot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProductLotRequestValidationError{
+				field:  "Lot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetProductByIdRequestMultiError(errors)
+		return CreateProductLotRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductByIdRequestMultiError is an error wrapping multiple validation
-// errors returned by GetProductByIdRequest.ValidateAll() if the designated
+// CreateProductLotRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProductLotRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetProductByIdRequestMultiError []error
+type CreateProductLotRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductByIdRequestMultiError) Error() string {
+func (m CreateProductLotRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4827,11 +13047,11 @@ func (m GetProductByIdRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductByIdRequestMultiError) AllErrors() []error { return m }
+func (m CreateProductLotRequestMultiError) AllErrors() []error { return m }
 
-// GetProductByIdRequestValidationError is the validation error returned by
-// GetProductByIdRequest.Validate if the designated constraints aren't met.
-type GetProductByIdRequestValidationError struct {
+// CreateProductLotRequestValidationError is the validation error returned by
+// CreateProductLotRequest.Validate if the designated constraints aren't met.
+type CreateProductLotRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4839,24 +13059,24 @@ type GetProductByIdRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductByIdRequestValidationError) Field() string { return e.field }
+func (e CreateProductLotRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductByIdRequestValidationError) Reason() string { return e.reason }
+func (e CreateProductLotRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductByIdRequestValidationError) Cause() error { return e.cause }
+func (e CreateProductLotRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductByIdRequestValidationError) Key() bool { return e.key }
+func (e CreateProductLotRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductByIdRequestValidationError) ErrorName() string {
-	return "GetProductByIdRequestValidationError"
+func (e CreateProductLotRequestValidationError) ErrorName() string {
+	return "CreateProductLotRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductByIdRequestValidationError) Error() string {
+func (e CreateProductLotRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4868,14 +13088,14 @@ func (e GetProductByIdRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductByIdRequest.%s: %s%s",
+		"invalid %sCreateProductLotRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductByIdRequestValidationError{}
+var _ error = CreateProductLotRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4883,24 +13103,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductByIdRequestValidationError{}
+} = CreateProductLotRequestValidationError{}
 
-// Validate checks the field values on GetProductByIdResponse with the rules
+// Validate checks the field values on ProductLotResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductByIdResponse) Validate() error {
+func (m *ProductLotResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductByIdResponse with the rules
+// ValidateAll checks the field values on ProductLotResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductByIdResponseMultiError, or nil if none found.
-func (m *GetProductByIdResponse) ValidateAll() error {
+// ProductLotResponseMultiError, or nil if none found.
+func (m *ProductLotResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductByIdResponse) validate(all bool) error {
+func (m *ProductLotResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4915,7 +13135,7 @@ func (m *GetProductByIdResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetProductByIdResponseValidationError{
+				errors = append(errors, ProductLotResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4923,7 +13143,7 @@ func (m *GetProductByIdResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetProductByIdResponseValidationError{
+				errors = append(errors, ProductLotResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4932,7 +13152,7 @@ func (m *GetProductByIdResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetProductByIdResponseValidationError{
+			return ProductLotResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4941,19 +13161,19 @@ func (m *GetProductByIdResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetProductByIdResponseMultiError(errors)
+		return ProductLotResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductByIdResponseMultiError is an error wrapping multiple validation
-// errors returned by GetProductByIdResponse.ValidateAll() if the designated
+// ProductLotResponseMultiError is an error wrapping multiple validation
+// errors returned by ProductLotResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetProductByIdResponseMultiError []error
+type ProductLotResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductByIdResponseMultiError) Error() string {
+func (m ProductLotResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4962,11 +13182,11 @@ func (m GetProductByIdResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductByIdResponseMultiError) AllErrors() []error { return m }
+func (m ProductLotResponseMultiError) AllErrors() []error { return m }
 
-// GetProductByIdResponseValidationError is the validation error returned by
-// GetProductByIdResponse.Validate if the designated constraints aren't met.
-type GetProductByIdResponseValidationError struct {
+// ProductLotResponseValidationError is the validation error returned by
+// ProductLotResponse.Validate if the designated constraints aren't met.
+type ProductLotResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4974,24 +13194,24 @@ type GetProductByIdResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductByIdResponseValidationError) Field() string { return e.field }
+func (e ProductLotResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductByIdResponseValidationError) Reason() string { return e.reason }
+func (e ProductLotResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductByIdResponseValidationError) Cause() error { return e.cause }
+func (e ProductLotResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductByIdResponseValidationError) Key() bool { return e.key }
+func (e ProductLotResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductByIdResponseValidationError) ErrorName() string {
-	return "GetProductByIdResponseValidationError"
+func (e ProductLotResponseValidationError) ErrorName() string {
+	return "ProductLotResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductByIdResponseValidationError) Error() string {
+func (e ProductLotResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5003,14 +13223,14 @@ func (e GetProductByIdResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductByIdResponse.%s: %s%s",
+		"invalid %sProductLotResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductByIdResponseValidationError{}
+var _ error = ProductLotResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5018,46 +13238,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductByIdResponseValidationError{}
+} = ProductLotResponseValidationError{}
 
-// Validate checks the field values on GetUnitOfMeasuresRequest with the rules
+// Validate checks the field values on ListProductLotsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUnitOfMeasuresRequest) Validate() error {
+func (m *ListProductLotsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitOfMeasuresRequest with the
+// ValidateAll checks the field values on ListProductLotsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUnitOfMeasuresRequestMultiError, or nil if none found.
-func (m *GetUnitOfMeasuresRequest) ValidateAll() error {
+// ListProductLotsRequestMultiError, or nil if none found.
+func (m *ListProductLotsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitOfMeasuresRequest) validate(all bool) error {
+func (m *ListProductLotsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsIncludeDeactivated
+	// no validation rules for ProductId
 
 	if len(errors) > 0 {
-		return GetUnitOfMeasuresRequestMultiError(errors)
+		return ListProductLotsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitOfMeasuresRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUnitOfMeasuresRequest.ValidateAll() if the designated
+// ListProductLotsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProductLotsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetUnitOfMeasuresRequestMultiError []error
+type ListProductLotsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitOfMeasuresRequestMultiError) Error() string {
+func (m ListProductLotsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5066,11 +13286,11 @@ func (m GetUnitOfMeasuresRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitOfMeasuresRequestMultiError) AllErrors() []error { return m }
+func (m ListProductLotsRequestMultiError) AllErrors() []error { return m }
 
-// GetUnitOfMeasuresRequestValidationError is the validation error returned by
-// GetUnitOfMeasuresRequest.Validate if the designated constraints aren't met.
-type GetUnitOfMeasuresRequestValidationError struct {
+// ListProductLotsRequestValidationError is the validation error returned by
+// ListProductLotsRequest.Validate if the designated constraints aren't met.
+type ListProductLotsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5078,24 +13298,24 @@ type GetUnitOfMeasuresRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitOfMeasuresRequestValidationError) Field() string { return e.field }
+func (e ListProductLotsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitOfMeasuresRequestValidationError) Reason() string { return e.reason }
+func (e ListProductLotsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitOfMeasuresRequestValidationError) Cause() error { return e.cause }
+func (e ListProductLotsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitOfMeasuresRequestValidationError) Key() bool { return e.key }
+func (e ListProductLotsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitOfMeasuresRequestValidationError) ErrorName() string {
-	return "GetUnitOfMeasuresRequestValidationError"
+func (e ListProductLotsRequestValidationError) ErrorName() string {
+	return "ListProductLotsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUnitOfMeasuresRequestValidationError) Error() string {
+func (e ListProductLotsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5107,14 +13327,14 @@ func (e GetUnitOfMeasuresRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitOfMeasuresRequest.%s: %s%s",
+		"invalid %sListProductLotsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitOfMeasuresRequestValidationError{}
+var _ error = ListProductLotsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5122,24 +13342,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitOfMeasuresRequestValidationError{}
+} = ListProductLotsRequestValidationError{}
 
-// Validate checks the field values on GetUnitOfMeasuresResponse with the rules
+// Validate checks the field values on ListProductLotsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUnitOfMeasuresResponse) Validate() error {
+func (m *ListProductLotsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitOfMeasuresResponse with the
+// ValidateAll checks the field values on ListProductLotsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUnitOfMeasuresResponseMultiError, or nil if none found.
-func (m *GetUnitOfMeasuresResponse) ValidateAll() error {
+// ListProductLotsResponseMultiError, or nil if none found.
+func (m *ListProductLotsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
+func (m *ListProductLotsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5157,7 +13377,7 @@ func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUnitOfMeasuresResponseValidationError{
+					errors = append(errors, ListProductLotsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -5165,7 +13385,7 @@ func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUnitOfMeasuresResponseValidationError{
+					errors = append(errors, ListProductLotsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -5174,7 +13394,7 @@ func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUnitOfMeasuresResponseValidationError{
+				return ListProductLotsResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5185,19 +13405,19 @@ func (m *GetUnitOfMeasuresResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUnitOfMeasuresResponseMultiError(errors)
+		return ListProductLotsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitOfMeasuresResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUnitOfMeasuresResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetUnitOfMeasuresResponseMultiError []error
+// ListProductLotsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProductLotsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProductLotsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitOfMeasuresResponseMultiError) Error() string {
+func (m ListProductLotsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5206,11 +13426,11 @@ func (m GetUnitOfMeasuresResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitOfMeasuresResponseMultiError) AllErrors() []error { return m }
+func (m ListProductLotsResponseMultiError) AllErrors() []error { return m }
 
-// GetUnitOfMeasuresResponseValidationError is the validation error returned by
-// GetUnitOfMeasuresResponse.Validate if the designated constraints aren't met.
-type GetUnitOfMeasuresResponseValidationError struct {
+// ListProductLotsResponseValidationError is the validation error returned by
+// ListProductLotsResponse.Validate if the designated constraints aren't met.
+type ListProductLotsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5218,24 +13438,24 @@ type GetUnitOfMeasuresResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitOfMeasuresResponseValidationError) Field() string { return e.field }
+func (e ListProductLotsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitOfMeasuresResponseValidationError) Reason() string { return e.reason }
+func (e ListProductLotsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitOfMeasuresResponseValidationError) Cause() error { return e.cause }
+func (e ListProductLotsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitOfMeasuresResponseValidationError) Key() bool { return e.key }
+func (e ListProductLotsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitOfMeasuresResponseValidationError) ErrorName() string {
-	return "GetUnitOfMeasuresResponseValidationError"
+func (e ListProductLotsResponseValidationError) ErrorName() string {
+	return "ListProductLotsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUnitOfMeasuresResponseValidationError) Error() string {
+func (e ListProductLotsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5247,14 +13467,14 @@ func (e GetUnitOfMeasuresResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitOfMeasuresResponse.%s: %s%s",
+		"invalid %sListProductLotsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitOfMeasuresResponseValidationError{}
+var _ error = ListProductLotsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5262,46 +13482,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitOfMeasuresResponseValidationError{}
+} = ListProductLotsResponseValidationError{}
 
-// Validate checks the field values on GetProductCategoriesRequest with the
+// Validate checks the field values on GetExpiringProductsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductCategoriesRequest) Validate() error {
+func (m *GetExpiringProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductCategoriesRequest with the
+// ValidateAll checks the field values on GetExpiringProductsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductCategoriesRequestMultiError, or nil if none found.
-func (m *GetProductCategoriesRequest) ValidateAll() error {
+// GetExpiringProductsRequestMultiError, or nil if none found.
+func (m *GetExpiringProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductCategoriesRequest) validate(all bool) error {
+func (m *GetExpiringProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsIncludeDeactivated
+	// no validation rules for StoreId
+
+	if val := m.GetDays(); val < 0 || val > 3650 {
+		err := GetExpiringProductsRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetProductCategoriesRequestMultiError(errors)
+		return GetExpiringProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductCategoriesRequestMultiError is an error wrapping multiple
-// validation errors returned by GetProductCategoriesRequest.ValidateAll() if
+// GetExpiringProductsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExpiringProductsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetProductCategoriesRequestMultiError []error
+type GetExpiringProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductCategoriesRequestMultiError) Error() string {
+func (m GetExpiringProductsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5310,12 +13541,11 @@ func (m GetProductCategoriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductCategoriesRequestMultiError) AllErrors() []error { return m }
+func (m GetExpiringProductsRequestMultiError) AllErrors() []error { return m }
 
-// GetProductCategoriesRequestValidationError is the validation error returned
-// by GetProductCategoriesRequest.Validate if the designated constraints
-// aren't met.
-type GetProductCategoriesRequestValidationError struct {
+// GetExpiringProductsRequestValidationError is the validation error returned
+// by GetExpiringProductsRequest.Validate if the designated constraints aren't met.
+type GetExpiringProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5323,24 +13553,24 @@ type GetProductCategoriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductCategoriesRequestValidationError) Field() string { return e.field }
+func (e GetExpiringProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductCategoriesRequestValidationError) Reason() string { return e.reason }
+func (e GetExpiringProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductCategoriesRequestValidationError) Cause() error { return e.cause }
+func (e GetExpiringProductsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductCategoriesRequestValidationError) Key() bool { return e.key }
+func (e GetExpiringProductsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductCategoriesRequestValidationError) ErrorName() string {
-	return "GetProductCategoriesRequestValidationError"
+func (e GetExpiringProductsRequestValidationError) ErrorName() string {
+	return "GetExpiringProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductCategoriesRequestValidationError) Error() string {
+func (e GetExpiringProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5352,14 +13582,14 @@ func (e GetProductCategoriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductCategoriesRequest.%s: %s%s",
+		"invalid %sGetExpiringProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductCategoriesRequestValidationError{}
+var _ error = GetExpiringProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5367,77 +13597,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductCategoriesRequestValidationError{}
+} = GetExpiringProductsRequestValidationError{}
 
-// Validate checks the field values on GetProductCategoriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductCategoriesResponse) Validate() error {
+// Validate checks the field values on Translation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Translation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductCategoriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProductCategoriesResponseMultiError, or nil if none found.
-func (m *GetProductCategoriesResponse) ValidateAll() error {
+// ValidateAll checks the field values on Translation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TranslationMultiError, or
+// nil if none found.
+func (m *Translation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductCategoriesResponse) validate(all bool) error {
+func (m *Translation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
+	if _, ok := _Translation_Locale_InLookup[m.GetLocale()]; !ok {
+		err := TranslationValidationError{
+			field:  "Locale",
+			reason: "value must be in list [id en]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetProductCategoriesResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetProductCategoriesResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := TranslationValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetProductCategoriesResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetProductCategoriesResponseMultiError(errors)
+		return TranslationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductCategoriesResponseMultiError is an error wrapping multiple
-// validation errors returned by GetProductCategoriesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetProductCategoriesResponseMultiError []error
+// TranslationMultiError is an error wrapping multiple validation errors
+// returned by Translation.ValidateAll() if the designated constraints aren't met.
+type TranslationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductCategoriesResponseMultiError) Error() string {
+func (m TranslationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5446,12 +13664,11 @@ func (m GetProductCategoriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductCategoriesResponseMultiError) AllErrors() []error { return m }
+func (m TranslationMultiError) AllErrors() []error { return m }
 
-// GetProductCategoriesResponseValidationError is the validation error returned
-// by GetProductCategoriesResponse.Validate if the designated constraints
-// aren't met.
-type GetProductCategoriesResponseValidationError struct {
+// TranslationValidationError is the validation error returned by
+// Translation.Validate if the designated constraints aren't met.
+type TranslationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5459,24 +13676,22 @@ type GetProductCategoriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductCategoriesResponseValidationError) Field() string { return e.field }
+func (e TranslationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductCategoriesResponseValidationError) Reason() string { return e.reason }
+func (e TranslationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductCategoriesResponseValidationError) Cause() error { return e.cause }
+func (e TranslationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductCategoriesResponseValidationError) Key() bool { return e.key }
+func (e TranslationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductCategoriesResponseValidationError) ErrorName() string {
-	return "GetProductCategoriesResponseValidationError"
-}
+func (e TranslationValidationError) ErrorName() string { return "TranslationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProductCategoriesResponseValidationError) Error() string {
+func (e TranslationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5488,14 +13703,14 @@ func (e GetProductCategoriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductCategoriesResponse.%s: %s%s",
+		"invalid %sTranslation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductCategoriesResponseValidationError{}
+var _ error = TranslationValidationError{}
 
 var _ interface {
 	Field() string
@@ -5503,48 +13718,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductCategoriesResponseValidationError{}
+} = TranslationValidationError{}
 
-// Validate checks the field values on GetProductCategoriesResponseItem with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetProductCategoriesResponseItem) Validate() error {
+var _Translation_Locale_InLookup = map[string]struct{}{
+	"id": {},
+	"en": {},
+}
+
+// Validate checks the field values on UpsertTranslationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTranslationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductCategoriesResponseItem with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetProductCategoriesResponseItemMultiError, or nil if none found.
-func (m *GetProductCategoriesResponseItem) ValidateAll() error {
+// ValidateAll checks the field values on UpsertTranslationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertTranslationsRequestMultiError, or nil if none found.
+func (m *UpsertTranslationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductCategoriesResponseItem) validate(all bool) error {
+func (m *UpsertTranslationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProductCategory() {
+	if _, ok := _UpsertTranslationsRequest_EntityType_InLookup[m.GetEntityType()]; !ok {
+		err := UpsertTranslationsRequestValidationError{
+			field:  "EntityType",
+			reason: "value must be in list [product_category product_type unit_of_measure]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntityId() <= 0 {
+		err := UpsertTranslationsRequestValidationError{
+			field:  "EntityId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTranslations()) < 1 {
+		err := UpsertTranslationsRequestValidationError{
+			field:  "Translations",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTranslations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProductCategoriesResponseItemValidationError{
-						field:  fmt.Sprintf("ProductCategory[%v]", idx),
+					errors = append(errors, UpsertTranslationsRequestValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProductCategoriesResponseItemValidationError{
-						field:  fmt.Sprintf("ProductCategory[%v]", idx),
+					errors = append(errors, UpsertTranslationsRequestValidationError{
+						field:  fmt.Sprintf("Translations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5552,8 +13804,8 @@ func (m *GetProductCategoriesResponseItem) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetProductCategoriesResponseItemValidationError{
-					field:  fmt.Sprintf("ProductCategory[%v]", idx),
+				return UpsertTranslationsRequestValidationError{
+					field:  fmt.Sprintf("Translations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5563,20 +13815,19 @@ func (m *GetProductCategoriesResponseItem) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetProductCategoriesResponseItemMultiError(errors)
+		return UpsertTranslationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductCategoriesResponseItemMultiError is an error wrapping multiple
-// validation errors returned by
-// GetProductCategoriesResponseItem.ValidateAll() if the designated
-// constraints aren't met.
-type GetProductCategoriesResponseItemMultiError []error
+// UpsertTranslationsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertTranslationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertTranslationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductCategoriesResponseItemMultiError) Error() string {
+func (m UpsertTranslationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5585,12 +13836,11 @@ func (m GetProductCategoriesResponseItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductCategoriesResponseItemMultiError) AllErrors() []error { return m }
+func (m UpsertTranslationsRequestMultiError) AllErrors() []error { return m }
 
-// GetProductCategoriesResponseItemValidationError is the validation error
-// returned by GetProductCategoriesResponseItem.Validate if the designated
-// constraints aren't met.
-type GetProductCategoriesResponseItemValidationError struct {
+// UpsertTranslationsRequestValidationError is the validation error returned
+// by UpsertTranslationsRequest.Validate if the designated constraints aren't met.
+type UpsertTranslationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5598,24 +13848,24 @@ type GetProductCategoriesResponseItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductCategoriesResponseItemValidationError) Field() string { return e.field }
+func (e UpsertTranslationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductCategoriesResponseItemValidationError) Reason() string { return e.reason }
+func (e UpsertTranslationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductCategoriesResponseItemValidationError) Cause() error { return e.cause }
+func (e UpsertTranslationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductCategoriesResponseItemValidationError) Key() bool { return e.key }
+func (e UpsertTranslationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductCategoriesResponseItemValidationError) ErrorName() string {
-	return "GetProductCategoriesResponseItemValidationError"
+func (e UpsertTranslationsRequestValidationError) ErrorName() string {
+	return "UpsertTranslationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductCategoriesResponseItemValidationError) Error() string {
+func (e UpsertTranslationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5627,14 +13877,14 @@ func (e GetProductCategoriesResponseItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductCategoriesResponseItem.%s: %s%s",
+		"invalid %sUpsertTranslationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductCategoriesResponseItemValidationError{}
+var _ error = UpsertTranslationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5642,48 +13892,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductCategoriesResponseItemValidationError{}
+} = UpsertTranslationsRequestValidationError{}
 
-// Validate checks the field values on GetProductTypesRequest with the rules
+var _UpsertTranslationsRequest_EntityType_InLookup = map[string]struct{}{
+	"product_category": {},
+	"product_type":     {},
+	"unit_of_measure":  {},
+}
+
+// Validate checks the field values on GetTranslationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductTypesRequest) Validate() error {
+func (m *GetTranslationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductTypesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetTranslationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductTypesRequestMultiError, or nil if none found.
-func (m *GetProductTypesRequest) ValidateAll() error {
+// GetTranslationsRequestMultiError, or nil if none found.
+func (m *GetTranslationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductTypesRequest) validate(all bool) error {
+func (m *GetTranslationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductCategoryId
+	if _, ok := _GetTranslationsRequest_EntityType_InLookup[m.GetEntityType()]; !ok {
+		err := GetTranslationsRequestValidationError{
+			field:  "EntityType",
+			reason: "value must be in list [product_category product_type unit_of_measure]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for IsIncludeDeactivated
+	if m.GetEntityId() <= 0 {
+		err := GetTranslationsRequestValidationError{
+			field:  "EntityId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetProductTypesRequestMultiError(errors)
+		return GetTranslationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductTypesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetProductTypesRequest.ValidateAll() if the designated
+// GetTranslationsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTranslationsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetProductTypesRequestMultiError []error
+type GetTranslationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductTypesRequestMultiError) Error() string {
+func (m GetTranslationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5692,11 +13966,11 @@ func (m GetProductTypesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductTypesRequestMultiError) AllErrors() []error { return m }
+func (m GetTranslationsRequestMultiError) AllErrors() []error { return m }
 
-// GetProductTypesRequestValidationError is the validation error returned by
-// GetProductTypesRequest.Validate if the designated constraints aren't met.
-type GetProductTypesRequestValidationError struct {
+// GetTranslationsRequestValidationError is the validation error returned by
+// GetTranslationsRequest.Validate if the designated constraints aren't met.
+type GetTranslationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5704,24 +13978,24 @@ type GetProductTypesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductTypesRequestValidationError) Field() string { return e.field }
+func (e GetTranslationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductTypesRequestValidationError) Reason() string { return e.reason }
+func (e GetTranslationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductTypesRequestValidationError) Cause() error { return e.cause }
+func (e GetTranslationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductTypesRequestValidationError) Key() bool { return e.key }
+func (e GetTranslationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductTypesRequestValidationError) ErrorName() string {
-	return "GetProductTypesRequestValidationError"
+func (e GetTranslationsRequestValidationError) ErrorName() string {
+	return "GetTranslationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductTypesRequestValidationError) Error() string {
+func (e GetTranslationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5733,14 +14007,14 @@ func (e GetProductTypesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductTypesRequest.%s: %s%s",
+		"invalid %sGetTranslationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductTypesRequestValidationError{}
+var _ error = GetTranslationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5748,24 +14022,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductTypesRequestValidationError{}
+} = GetTranslationsRequestValidationError{}
 
-// Validate checks the field values on GetProductTypesResponse with the rules
+var _GetTranslationsRequest_EntityType_InLookup = map[string]struct{}{
+	"product_category": {},
+	"product_type":     {},
+	"unit_of_measure":  {},
+}
+
+// Validate checks the field values on TranslationsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProductTypesResponse) Validate() error {
+func (m *TranslationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProductTypesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TranslationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProductTypesResponseMultiError, or nil if none found.
-func (m *GetProductTypesResponse) ValidateAll() error {
+// TranslationsResponseMultiError, or nil if none found.
+func (m *TranslationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProductTypesResponse) validate(all bool) error {
+func (m *TranslationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5783,7 +14063,7 @@ func (m *GetProductTypesResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProductTypesResponseValidationError{
+					errors = append(errors, TranslationsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -5791,7 +14071,7 @@ func (m *GetProductTypesResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProductTypesResponseValidationError{
+					errors = append(errors, TranslationsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -5800,7 +14080,7 @@ func (m *GetProductTypesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetProductTypesResponseValidationError{
+				return TranslationsResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5811,19 +14091,19 @@ func (m *GetProductTypesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetProductTypesResponseMultiError(errors)
+		return TranslationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProductTypesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetProductTypesResponse.ValidateAll() if the designated
+// TranslationsResponseMultiError is an error wrapping multiple validation
+// errors returned by TranslationsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetProductTypesResponseMultiError []error
+type TranslationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProductTypesResponseMultiError) Error() string {
+func (m TranslationsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5832,11 +14112,11 @@ func (m GetProductTypesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProductTypesResponseMultiError) AllErrors() []error { return m }
+func (m TranslationsResponseMultiError) AllErrors() []error { return m }
 
-// GetProductTypesResponseValidationError is the validation error returned by
-// GetProductTypesResponse.Validate if the designated constraints aren't met.
-type GetProductTypesResponseValidationError struct {
+// TranslationsResponseValidationError is the validation error returned by
+// TranslationsResponse.Validate if the designated constraints aren't met.
+type TranslationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5844,24 +14124,24 @@ type GetProductTypesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProductTypesResponseValidationError) Field() string { return e.field }
+func (e TranslationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProductTypesResponseValidationError) Reason() string { return e.reason }
+func (e TranslationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProductTypesResponseValidationError) Cause() error { return e.cause }
+func (e TranslationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProductTypesResponseValidationError) Key() bool { return e.key }
+func (e TranslationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProductTypesResponseValidationError) ErrorName() string {
-	return "GetProductTypesResponseValidationError"
+func (e TranslationsResponseValidationError) ErrorName() string {
+	return "TranslationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProductTypesResponseValidationError) Error() string {
+func (e TranslationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5873,14 +14153,14 @@ func (e GetProductTypesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProductTypesResponse.%s: %s%s",
+		"invalid %sTranslationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProductTypesResponseValidationError{}
+var _ error = TranslationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5888,4 +14168,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProductTypesResponseValidationError{}
+} = TranslationsResponseValidationError{}
@@ -20,26 +20,52 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	StoreService_CreateStore_FullMethodName           = "/StoreService/CreateStore"
-	StoreService_GetStore_FullMethodName              = "/StoreService/GetStore"
-	StoreService_UpdateStore_FullMethodName           = "/StoreService/UpdateStore"
-	StoreService_DeleteStore_FullMethodName           = "/StoreService/DeleteStore"
-	StoreService_ListStores_FullMethodName            = "/StoreService/ListStores"
-	StoreService_GetStoreByUserID_FullMethodName      = "/StoreService/GetStoreByUserID"
-	StoreService_OpenCloseStore_FullMethodName        = "/StoreService/OpenCloseStore"
-	StoreService_GetProductById_FullMethodName        = "/StoreService/GetProductById"
-	StoreService_GetProductList_FullMethodName        = "/StoreService/GetProductList"
-	StoreService_InsertProducts_FullMethodName        = "/StoreService/InsertProducts"
-	StoreService_UpdateProduct_FullMethodName         = "/StoreService/UpdateProduct"
-	StoreService_DeleteProduct_FullMethodName         = "/StoreService/DeleteProduct"
-	StoreService_GetUnitOfMeasures_FullMethodName     = "/StoreService/GetUnitOfMeasures"
-	StoreService_UpsertUnitOfMeasure_FullMethodName   = "/StoreService/UpsertUnitOfMeasure"
-	StoreService_UpdateUnitOfMeasure_FullMethodName   = "/StoreService/UpdateUnitOfMeasure"
-	StoreService_GetProductCategories_FullMethodName  = "/StoreService/GetProductCategories"
-	StoreService_UpsertProductCategory_FullMethodName = "/StoreService/UpsertProductCategory"
-	StoreService_UpdateProductCategory_FullMethodName = "/StoreService/UpdateProductCategory"
-	StoreService_GetProductTypes_FullMethodName       = "/StoreService/GetProductTypes"
-	StoreService_UpsertProductType_FullMethodName     = "/StoreService/UpsertProductType"
+	StoreService_CreateStore_FullMethodName                      = "/StoreService/CreateStore"
+	StoreService_GetStore_FullMethodName                         = "/StoreService/GetStore"
+	StoreService_BatchGetStores_FullMethodName                   = "/StoreService/BatchGetStores"
+	StoreService_UpdateStore_FullMethodName                      = "/StoreService/UpdateStore"
+	StoreService_DeleteStore_FullMethodName                      = "/StoreService/DeleteStore"
+	StoreService_ListStores_FullMethodName                       = "/StoreService/ListStores"
+	StoreService_GetStoreByUserID_FullMethodName                 = "/StoreService/GetStoreByUserID"
+	StoreService_OpenCloseStore_FullMethodName                   = "/StoreService/OpenCloseStore"
+	StoreService_GetProductById_FullMethodName                   = "/StoreService/GetProductById"
+	StoreService_GetProductByBarcode_FullMethodName              = "/StoreService/GetProductByBarcode"
+	StoreService_BatchGetProducts_FullMethodName                 = "/StoreService/BatchGetProducts"
+	StoreService_GetProductList_FullMethodName                   = "/StoreService/GetProductList"
+	StoreService_InsertProducts_FullMethodName                   = "/StoreService/InsertProducts"
+	StoreService_UpdateProduct_FullMethodName                    = "/StoreService/UpdateProduct"
+	StoreService_DeleteProduct_FullMethodName                    = "/StoreService/DeleteProduct"
+	StoreService_GetUnitOfMeasures_FullMethodName                = "/StoreService/GetUnitOfMeasures"
+	StoreService_UpsertUnitOfMeasure_FullMethodName              = "/StoreService/UpsertUnitOfMeasure"
+	StoreService_UpdateUnitOfMeasure_FullMethodName              = "/StoreService/UpdateUnitOfMeasure"
+	StoreService_GetProductCategories_FullMethodName             = "/StoreService/GetProductCategories"
+	StoreService_UpsertProductCategory_FullMethodName            = "/StoreService/UpsertProductCategory"
+	StoreService_UpdateProductCategory_FullMethodName            = "/StoreService/UpdateProductCategory"
+	StoreService_GetCategoryTree_FullMethodName                  = "/StoreService/GetCategoryTree"
+	StoreService_MoveProductCategory_FullMethodName              = "/StoreService/MoveProductCategory"
+	StoreService_RenameProductCategory_FullMethodName            = "/StoreService/RenameProductCategory"
+	StoreService_GetProductTypes_FullMethodName                  = "/StoreService/GetProductTypes"
+	StoreService_UpsertProductType_FullMethodName                = "/StoreService/UpsertProductType"
+	StoreService_UpsertProductAttributeDefinition_FullMethodName = "/StoreService/UpsertProductAttributeDefinition"
+	StoreService_ListProductAttributeDefinitions_FullMethodName  = "/StoreService/ListProductAttributeDefinitions"
+	StoreService_ListAuditEvents_FullMethodName                  = "/StoreService/ListAuditEvents"
+	StoreService_WatchStore_FullMethodName                       = "/StoreService/WatchStore"
+	StoreService_WatchProducts_FullMethodName                    = "/StoreService/WatchProducts"
+	StoreService_CreateWebhookSubscription_FullMethodName        = "/StoreService/CreateWebhookSubscription"
+	StoreService_ListWebhookSubscriptions_FullMethodName         = "/StoreService/ListWebhookSubscriptions"
+	StoreService_UpdateWebhookSubscription_FullMethodName        = "/StoreService/UpdateWebhookSubscription"
+	StoreService_DeleteWebhookSubscription_FullMethodName        = "/StoreService/DeleteWebhookSubscription"
+	StoreService_ListWebhookDeliveries_FullMethodName            = "/StoreService/ListWebhookDeliveries"
+	StoreService_ReplayWebhookDelivery_FullMethodName            = "/StoreService/ReplayWebhookDelivery"
+	StoreService_CreateProductDiscount_FullMethodName            = "/StoreService/CreateProductDiscount"
+	StoreService_ListProductDiscounts_FullMethodName             = "/StoreService/ListProductDiscounts"
+	StoreService_DeleteProductDiscount_FullMethodName            = "/StoreService/DeleteProductDiscount"
+	StoreService_ListProductPriceHistory_FullMethodName          = "/StoreService/ListProductPriceHistory"
+	StoreService_CreateProductLot_FullMethodName                 = "/StoreService/CreateProductLot"
+	StoreService_ListProductLots_FullMethodName                  = "/StoreService/ListProductLots"
+	StoreService_GetExpiringProducts_FullMethodName              = "/StoreService/GetExpiringProducts"
+	StoreService_UpsertTranslations_FullMethodName               = "/StoreService/UpsertTranslations"
+	StoreService_GetTranslations_FullMethodName                  = "/StoreService/GetTranslations"
 )
 
 // StoreServiceClient is the client API for StoreService service.
@@ -50,6 +76,8 @@ type StoreServiceClient interface {
 	CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*CreateStoreResponse, error)
 	// Get a store by ID
 	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
+	// Get many stores by ID, the results follow the order of the requested ids
+	BatchGetStores(ctx context.Context, in *BatchGetStoresRequest, opts ...grpc.CallOption) (*BatchGetStoresResponse, error)
 	// Update an existing store
 	UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*UpdateStoreResponse, error)
 	// Delete a store by ID
@@ -61,6 +89,9 @@ type StoreServiceClient interface {
 	// Open close store
 	OpenCloseStore(ctx context.Context, in *OpenCloseStoreRequest, opts ...grpc.CallOption) (*OpenCloseStoreResponse, error)
 	GetProductById(ctx context.Context, in *GetProductByIdRequest, opts ...grpc.CallOption) (*GetProductByIdResponse, error)
+	// Get many products by ID, the results follow the order of the requested ids
+	GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByIdResponse, error)
+	BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsResponse, error)
 	GetProductList(ctx context.Context, in *GetProductListRequest, opts ...grpc.CallOption) (*GetProductListResponse, error)
 	InsertProducts(ctx context.Context, in *InsertProductsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -71,8 +102,49 @@ type StoreServiceClient interface {
 	GetProductCategories(ctx context.Context, in *GetProductCategoriesRequest, opts ...grpc.CallOption) (*GetProductCategoriesResponse, error)
 	UpsertProductCategory(ctx context.Context, in *UpsertProductCategoryRequest, opts ...grpc.CallOption) (*UpsertProductCategoryResponse, error)
 	UpdateProductCategory(ctx context.Context, in *UpsertProductCategoryRequest, opts ...grpc.CallOption) (*UpsertProductCategoryResponse, error)
+	// Get the categories as a tree, below root_id or from the root categories when root_id is empty
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	// Move a category with its subcategories below another parent, or to the root when parent_id is empty
+	MoveProductCategory(ctx context.Context, in *MoveProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error)
+	RenameProductCategory(ctx context.Context, in *RenameProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error)
 	GetProductTypes(ctx context.Context, in *GetProductTypesRequest, opts ...grpc.CallOption) (*GetProductTypesResponse, error)
 	UpsertProductType(ctx context.Context, in *UpsertProductTypeRequest, opts ...grpc.CallOption) (*UpsertProductTypeResponse, error)
+	// Define an attribute the products of a type can record, the attribute is updated when its id is set
+	UpsertProductAttributeDefinition(ctx context.Context, in *UpsertProductAttributeDefinitionRequest, opts ...grpc.CallOption) (*ProductAttributeDefinitionResponse, error)
+	ListProductAttributeDefinitions(ctx context.Context, in *ListProductAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListProductAttributeDefinitionsResponse, error)
+	// List the audit events of changes, only for admins
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Stream the changes of a store status and profile, the stream is resumed after after_sequence
+	WatchStore(ctx context.Context, in *WatchStoreRequest, opts ...grpc.CallOption) (StoreService_WatchStoreClient, error)
+	// Stream the product upserts, deletes and stock changes of a store, the stream is resumed after after_sequence
+	WatchProducts(ctx context.Context, in *WatchProductsRequest, opts ...grpc.CallOption) (StoreService_WatchProductsClient, error)
+	// Register a webhook receiving the events of a store
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// Update a webhook, setting is_active re-enables a disabled webhook
+	UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the deliveries of a webhook, the latest first
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Deliver an event to a webhook again
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveryResponse, error)
+	// Schedule a discount of a product
+	CreateProductDiscount(ctx context.Context, in *CreateProductDiscountRequest, opts ...grpc.CallOption) (*ProductDiscountResponse, error)
+	// List the discounts of a product, the latest first
+	ListProductDiscounts(ctx context.Context, in *ListProductDiscountsRequest, opts ...grpc.CallOption) (*ListProductDiscountsResponse, error)
+	// Remove a discount of a product
+	DeleteProductDiscount(ctx context.Context, in *DeleteProductDiscountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the price changes of a product, the latest first
+	ListProductPriceHistory(ctx context.Context, in *ListProductPriceHistoryRequest, opts ...grpc.CallOption) (*ListProductPriceHistoryResponse, error)
+	// Record a lot of a product, its quantity is added to the stock of the product
+	CreateProductLot(ctx context.Context, in *CreateProductLotRequest, opts ...grpc.CallOption) (*ProductLotResponse, error)
+	// List the lots of a product, the first to expire first
+	ListProductLots(ctx context.Context, in *ListProductLotsRequest, opts ...grpc.CallOption) (*ListProductLotsResponse, error)
+	// List the lots of a store with stock left which expire within days, or have expired already
+	GetExpiringProducts(ctx context.Context, in *GetExpiringProductsRequest, opts ...grpc.CallOption) (*ListProductLotsResponse, error)
+	// Set the names of a category, product type or unit of measure in other locales
+	UpsertTranslations(ctx context.Context, in *UpsertTranslationsRequest, opts ...grpc.CallOption) (*TranslationsResponse, error)
+	GetTranslations(ctx context.Context, in *GetTranslationsRequest, opts ...grpc.CallOption) (*TranslationsResponse, error)
 }
 
 type storeServiceClient struct {
@@ -101,6 +173,15 @@ func (c *storeServiceClient) GetStore(ctx context.Context, in *GetStoreRequest,
 	return out, nil
 }
 
+func (c *storeServiceClient) BatchGetStores(ctx context.Context, in *BatchGetStoresRequest, opts ...grpc.CallOption) (*BatchGetStoresResponse, error) {
+	out := new(BatchGetStoresResponse)
+	err := c.cc.Invoke(ctx, StoreService_BatchGetStores_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeServiceClient) UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*UpdateStoreResponse, error) {
 	out := new(UpdateStoreResponse)
 	err := c.cc.Invoke(ctx, StoreService_UpdateStore_FullMethodName, in, out, opts...)
@@ -155,6 +236,24 @@ func (c *storeServiceClient) GetProductById(ctx context.Context, in *GetProductB
 	return out, nil
 }
 
+func (c *storeServiceClient) GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByIdResponse, error) {
+	out := new(GetProductByIdResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetProductByBarcode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsResponse, error) {
+	out := new(BatchGetProductsResponse)
+	err := c.cc.Invoke(ctx, StoreService_BatchGetProducts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeServiceClient) GetProductList(ctx context.Context, in *GetProductListRequest, opts ...grpc.CallOption) (*GetProductListResponse, error) {
 	out := new(GetProductListResponse)
 	err := c.cc.Invoke(ctx, StoreService_GetProductList_FullMethodName, in, out, opts...)
@@ -245,6 +344,33 @@ func (c *storeServiceClient) UpdateProductCategory(ctx context.Context, in *Upse
 	return out, nil
 }
 
+func (c *storeServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetCategoryTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) MoveProductCategory(ctx context.Context, in *MoveProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error) {
+	out := new(ProductCategoryResponse)
+	err := c.cc.Invoke(ctx, StoreService_MoveProductCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) RenameProductCategory(ctx context.Context, in *RenameProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error) {
+	out := new(ProductCategoryResponse)
+	err := c.cc.Invoke(ctx, StoreService_RenameProductCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeServiceClient) GetProductTypes(ctx context.Context, in *GetProductTypesRequest, opts ...grpc.CallOption) (*GetProductTypesResponse, error) {
 	out := new(GetProductTypesResponse)
 	err := c.cc.Invoke(ctx, StoreService_GetProductTypes_FullMethodName, in, out, opts...)
@@ -263,6 +389,232 @@ func (c *storeServiceClient) UpsertProductType(ctx context.Context, in *UpsertPr
 	return out, nil
 }
 
+func (c *storeServiceClient) UpsertProductAttributeDefinition(ctx context.Context, in *UpsertProductAttributeDefinitionRequest, opts ...grpc.CallOption) (*ProductAttributeDefinitionResponse, error) {
+	out := new(ProductAttributeDefinitionResponse)
+	err := c.cc.Invoke(ctx, StoreService_UpsertProductAttributeDefinition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListProductAttributeDefinitions(ctx context.Context, in *ListProductAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListProductAttributeDefinitionsResponse, error) {
+	out := new(ListProductAttributeDefinitionsResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListProductAttributeDefinitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) WatchStore(ctx context.Context, in *WatchStoreRequest, opts ...grpc.CallOption) (StoreService_WatchStoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[0], StoreService_WatchStore_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeServiceWatchStoreClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StoreService_WatchStoreClient interface {
+	Recv() (*StoreEvent, error)
+	grpc.ClientStream
+}
+
+type storeServiceWatchStoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeServiceWatchStoreClient) Recv() (*StoreEvent, error) {
+	m := new(StoreEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeServiceClient) WatchProducts(ctx context.Context, in *WatchProductsRequest, opts ...grpc.CallOption) (StoreService_WatchProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreService_ServiceDesc.Streams[1], StoreService_WatchProducts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeServiceWatchProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StoreService_WatchProductsClient interface {
+	Recv() (*StoreEvent, error)
+	grpc.ClientStream
+}
+
+type storeServiceWatchProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeServiceWatchProductsClient) Recv() (*StoreEvent, error) {
+	m := new(StoreEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscriptionResponse, error) {
+	out := new(WebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, StoreService_CreateWebhookSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListWebhookSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscriptionResponse, error) {
+	out := new(WebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, StoreService_UpdateWebhookSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StoreService_DeleteWebhookSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDeliveryResponse, error) {
+	out := new(WebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, StoreService_ReplayWebhookDelivery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) CreateProductDiscount(ctx context.Context, in *CreateProductDiscountRequest, opts ...grpc.CallOption) (*ProductDiscountResponse, error) {
+	out := new(ProductDiscountResponse)
+	err := c.cc.Invoke(ctx, StoreService_CreateProductDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListProductDiscounts(ctx context.Context, in *ListProductDiscountsRequest, opts ...grpc.CallOption) (*ListProductDiscountsResponse, error) {
+	out := new(ListProductDiscountsResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListProductDiscounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) DeleteProductDiscount(ctx context.Context, in *DeleteProductDiscountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StoreService_DeleteProductDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListProductPriceHistory(ctx context.Context, in *ListProductPriceHistoryRequest, opts ...grpc.CallOption) (*ListProductPriceHistoryResponse, error) {
+	out := new(ListProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListProductPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) CreateProductLot(ctx context.Context, in *CreateProductLotRequest, opts ...grpc.CallOption) (*ProductLotResponse, error) {
+	out := new(ProductLotResponse)
+	err := c.cc.Invoke(ctx, StoreService_CreateProductLot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) ListProductLots(ctx context.Context, in *ListProductLotsRequest, opts ...grpc.CallOption) (*ListProductLotsResponse, error) {
+	out := new(ListProductLotsResponse)
+	err := c.cc.Invoke(ctx, StoreService_ListProductLots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GetExpiringProducts(ctx context.Context, in *GetExpiringProductsRequest, opts ...grpc.CallOption) (*ListProductLotsResponse, error) {
+	out := new(ListProductLotsResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetExpiringProducts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) UpsertTranslations(ctx context.Context, in *UpsertTranslationsRequest, opts ...grpc.CallOption) (*TranslationsResponse, error) {
+	out := new(TranslationsResponse)
+	err := c.cc.Invoke(ctx, StoreService_UpsertTranslations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeServiceClient) GetTranslations(ctx context.Context, in *GetTranslationsRequest, opts ...grpc.CallOption) (*TranslationsResponse, error) {
+	out := new(TranslationsResponse)
+	err := c.cc.Invoke(ctx, StoreService_GetTranslations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreServiceServer is the server API for StoreService service.
 // All implementations must embed UnimplementedStoreServiceServer
 // for forward compatibility
@@ -271,6 +623,8 @@ type StoreServiceServer interface {
 	CreateStore(context.Context, *CreateStoreRequest) (*CreateStoreResponse, error)
 	// Get a store by ID
 	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
+	// Get many stores by ID, the results follow the order of the requested ids
+	BatchGetStores(context.Context, *BatchGetStoresRequest) (*BatchGetStoresResponse, error)
 	// Update an existing store
 	UpdateStore(context.Context, *UpdateStoreRequest) (*UpdateStoreResponse, error)
 	// Delete a store by ID
@@ -282,6 +636,9 @@ type StoreServiceServer interface {
 	// Open close store
 	OpenCloseStore(context.Context, *OpenCloseStoreRequest) (*OpenCloseStoreResponse, error)
 	GetProductById(context.Context, *GetProductByIdRequest) (*GetProductByIdResponse, error)
+	// Get many products by ID, the results follow the order of the requested ids
+	GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByIdResponse, error)
+	BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsResponse, error)
 	GetProductList(context.Context, *GetProductListRequest) (*GetProductListResponse, error)
 	InsertProducts(context.Context, *InsertProductsRequest) (*GenericResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*GenericResponse, error)
@@ -292,8 +649,49 @@ type StoreServiceServer interface {
 	GetProductCategories(context.Context, *GetProductCategoriesRequest) (*GetProductCategoriesResponse, error)
 	UpsertProductCategory(context.Context, *UpsertProductCategoryRequest) (*UpsertProductCategoryResponse, error)
 	UpdateProductCategory(context.Context, *UpsertProductCategoryRequest) (*UpsertProductCategoryResponse, error)
+	// Get the categories as a tree, below root_id or from the root categories when root_id is empty
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	// Move a category with its subcategories below another parent, or to the root when parent_id is empty
+	MoveProductCategory(context.Context, *MoveProductCategoryRequest) (*ProductCategoryResponse, error)
+	RenameProductCategory(context.Context, *RenameProductCategoryRequest) (*ProductCategoryResponse, error)
 	GetProductTypes(context.Context, *GetProductTypesRequest) (*GetProductTypesResponse, error)
 	UpsertProductType(context.Context, *UpsertProductTypeRequest) (*UpsertProductTypeResponse, error)
+	// Define an attribute the products of a type can record, the attribute is updated when its id is set
+	UpsertProductAttributeDefinition(context.Context, *UpsertProductAttributeDefinitionRequest) (*ProductAttributeDefinitionResponse, error)
+	ListProductAttributeDefinitions(context.Context, *ListProductAttributeDefinitionsRequest) (*ListProductAttributeDefinitionsResponse, error)
+	// List the audit events of changes, only for admins
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Stream the changes of a store status and profile, the stream is resumed after after_sequence
+	WatchStore(*WatchStoreRequest, StoreService_WatchStoreServer) error
+	// Stream the product upserts, deletes and stock changes of a store, the stream is resumed after after_sequence
+	WatchProducts(*WatchProductsRequest, StoreService_WatchProductsServer) error
+	// Register a webhook receiving the events of a store
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// Update a webhook, setting is_active re-enables a disabled webhook
+	UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*WebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error)
+	// List the deliveries of a webhook, the latest first
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Deliver an event to a webhook again
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*WebhookDeliveryResponse, error)
+	// Schedule a discount of a product
+	CreateProductDiscount(context.Context, *CreateProductDiscountRequest) (*ProductDiscountResponse, error)
+	// List the discounts of a product, the latest first
+	ListProductDiscounts(context.Context, *ListProductDiscountsRequest) (*ListProductDiscountsResponse, error)
+	// Remove a discount of a product
+	DeleteProductDiscount(context.Context, *DeleteProductDiscountRequest) (*emptypb.Empty, error)
+	// List the price changes of a product, the latest first
+	ListProductPriceHistory(context.Context, *ListProductPriceHistoryRequest) (*ListProductPriceHistoryResponse, error)
+	// Record a lot of a product, its quantity is added to the stock of the product
+	CreateProductLot(context.Context, *CreateProductLotRequest) (*ProductLotResponse, error)
+	// List the lots of a product, the first to expire first
+	ListProductLots(context.Context, *ListProductLotsRequest) (*ListProductLotsResponse, error)
+	// List the lots of a store with stock left which expire within days, or have expired already
+	GetExpiringProducts(context.Context, *GetExpiringProductsRequest) (*ListProductLotsResponse, error)
+	// Set the names of a category, product type or unit of measure in other locales
+	UpsertTranslations(context.Context, *UpsertTranslationsRequest) (*TranslationsResponse, error)
+	GetTranslations(context.Context, *GetTranslationsRequest) (*TranslationsResponse, error)
 	mustEmbedUnimplementedStoreServiceServer()
 }
 
@@ -307,6 +705,9 @@ func (UnimplementedStoreServiceServer) CreateStore(context.Context, *CreateStore
 func (UnimplementedStoreServiceServer) GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStore not implemented")
 }
+func (UnimplementedStoreServiceServer) BatchGetStores(context.Context, *BatchGetStoresRequest) (*BatchGetStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetStores not implemented")
+}
 func (UnimplementedStoreServiceServer) UpdateStore(context.Context, *UpdateStoreRequest) (*UpdateStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStore not implemented")
 }
@@ -325,6 +726,12 @@ func (UnimplementedStoreServiceServer) OpenCloseStore(context.Context, *OpenClos
 func (UnimplementedStoreServiceServer) GetProductById(context.Context, *GetProductByIdRequest) (*GetProductByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductById not implemented")
 }
+func (UnimplementedStoreServiceServer) GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByBarcode not implemented")
+}
+func (UnimplementedStoreServiceServer) BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetProducts not implemented")
+}
 func (UnimplementedStoreServiceServer) GetProductList(context.Context, *GetProductListRequest) (*GetProductListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductList not implemented")
 }
@@ -355,12 +762,81 @@ func (UnimplementedStoreServiceServer) UpsertProductCategory(context.Context, *U
 func (UnimplementedStoreServiceServer) UpdateProductCategory(context.Context, *UpsertProductCategoryRequest) (*UpsertProductCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductCategory not implemented")
 }
+func (UnimplementedStoreServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedStoreServiceServer) MoveProductCategory(context.Context, *MoveProductCategoryRequest) (*ProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveProductCategory not implemented")
+}
+func (UnimplementedStoreServiceServer) RenameProductCategory(context.Context, *RenameProductCategoryRequest) (*ProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameProductCategory not implemented")
+}
 func (UnimplementedStoreServiceServer) GetProductTypes(context.Context, *GetProductTypesRequest) (*GetProductTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductTypes not implemented")
 }
 func (UnimplementedStoreServiceServer) UpsertProductType(context.Context, *UpsertProductTypeRequest) (*UpsertProductTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertProductType not implemented")
 }
+func (UnimplementedStoreServiceServer) UpsertProductAttributeDefinition(context.Context, *UpsertProductAttributeDefinitionRequest) (*ProductAttributeDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProductAttributeDefinition not implemented")
+}
+func (UnimplementedStoreServiceServer) ListProductAttributeDefinitions(context.Context, *ListProductAttributeDefinitionsRequest) (*ListProductAttributeDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductAttributeDefinitions not implemented")
+}
+func (UnimplementedStoreServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedStoreServiceServer) WatchStore(*WatchStoreRequest, StoreService_WatchStoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStore not implemented")
+}
+func (UnimplementedStoreServiceServer) WatchProducts(*WatchProductsRequest, StoreService_WatchProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProducts not implemented")
+}
+func (UnimplementedStoreServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedStoreServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedStoreServiceServer) UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*WebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhookSubscription not implemented")
+}
+func (UnimplementedStoreServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedStoreServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedStoreServiceServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*WebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedStoreServiceServer) CreateProductDiscount(context.Context, *CreateProductDiscountRequest) (*ProductDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductDiscount not implemented")
+}
+func (UnimplementedStoreServiceServer) ListProductDiscounts(context.Context, *ListProductDiscountsRequest) (*ListProductDiscountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductDiscounts not implemented")
+}
+func (UnimplementedStoreServiceServer) DeleteProductDiscount(context.Context, *DeleteProductDiscountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductDiscount not implemented")
+}
+func (UnimplementedStoreServiceServer) ListProductPriceHistory(context.Context, *ListProductPriceHistoryRequest) (*ListProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductPriceHistory not implemented")
+}
+func (UnimplementedStoreServiceServer) CreateProductLot(context.Context, *CreateProductLotRequest) (*ProductLotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductLot not implemented")
+}
+func (UnimplementedStoreServiceServer) ListProductLots(context.Context, *ListProductLotsRequest) (*ListProductLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductLots not implemented")
+}
+func (UnimplementedStoreServiceServer) GetExpiringProducts(context.Context, *GetExpiringProductsRequest) (*ListProductLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiringProducts not implemented")
+}
+func (UnimplementedStoreServiceServer) UpsertTranslations(context.Context, *UpsertTranslationsRequest) (*TranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTranslations not implemented")
+}
+func (UnimplementedStoreServiceServer) GetTranslations(context.Context, *GetTranslationsRequest) (*TranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTranslations not implemented")
+}
 func (UnimplementedStoreServiceServer) mustEmbedUnimplementedStoreServiceServer() {}
 
 // UnsafeStoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -410,6 +886,24 @@ func _StoreService_GetStore_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoreService_BatchGetStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).BatchGetStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_BatchGetStores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).BatchGetStores(ctx, req.(*BatchGetStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoreService_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateStoreRequest)
 	if err := dec(in); err != nil {
@@ -518,6 +1012,42 @@ func _StoreService_GetProductById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoreService_GetProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetProductByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetProductByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetProductByBarcode(ctx, req.(*GetProductByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_BatchGetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).BatchGetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_BatchGetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).BatchGetProducts(ctx, req.(*BatchGetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoreService_GetProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductListRequest)
 	if err := dec(in); err != nil {
@@ -698,38 +1228,458 @@ func _StoreService_UpdateProductCategory_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreService_GetProductTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductTypesRequest)
+func _StoreService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreServiceServer).GetProductTypes(ctx, in)
+		return srv.(StoreServiceServer).GetCategoryTree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StoreService_GetProductTypes_FullMethodName,
+		FullMethod: StoreService_GetCategoryTree_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreServiceServer).GetProductTypes(ctx, req.(*GetProductTypesRequest))
+		return srv.(StoreServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreService_UpsertProductType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertProductTypeRequest)
+func _StoreService_MoveProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProductCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreServiceServer).UpsertProductType(ctx, in)
+		return srv.(StoreServiceServer).MoveProductCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StoreService_UpsertProductType_FullMethodName,
+		FullMethod: StoreService_MoveProductCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreServiceServer).UpsertProductType(ctx, req.(*UpsertProductTypeRequest))
+		return srv.(StoreServiceServer).MoveProductCategory(ctx, req.(*MoveProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_RenameProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameProductCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).RenameProductCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_RenameProductCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).RenameProductCategory(ctx, req.(*RenameProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetProductTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetProductTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetProductTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetProductTypes(ctx, req.(*GetProductTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_UpsertProductType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProductTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpsertProductType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpsertProductType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpsertProductType(ctx, req.(*UpsertProductTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_UpsertProductAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProductAttributeDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpsertProductAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpsertProductAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpsertProductAttributeDefinition(ctx, req.(*UpsertProductAttributeDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListProductAttributeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductAttributeDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListProductAttributeDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListProductAttributeDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListProductAttributeDefinitions(ctx, req.(*ListProductAttributeDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_WatchStore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStoreRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StoreServiceServer).WatchStore(m, &storeServiceWatchStoreServer{stream})
+}
+
+type StoreService_WatchStoreServer interface {
+	Send(*StoreEvent) error
+	grpc.ServerStream
+}
+
+type storeServiceWatchStoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeServiceWatchStoreServer) Send(m *StoreEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StoreService_WatchProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StoreServiceServer).WatchProducts(m, &storeServiceWatchProductsServer{stream})
+}
+
+type StoreService_WatchProductsServer interface {
+	Send(*StoreEvent) error
+	grpc.ServerStream
+}
+
+type storeServiceWatchProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeServiceWatchProductsServer) Send(m *StoreEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StoreService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_UpdateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpdateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpdateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpdateWebhookSubscription(ctx, req.(*UpdateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ReplayWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_CreateProductDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CreateProductDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CreateProductDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CreateProductDiscount(ctx, req.(*CreateProductDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListProductDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductDiscountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListProductDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListProductDiscounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListProductDiscounts(ctx, req.(*ListProductDiscountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_DeleteProductDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).DeleteProductDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_DeleteProductDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).DeleteProductDiscount(ctx, req.(*DeleteProductDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListProductPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListProductPriceHistory(ctx, req.(*ListProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_CreateProductLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductLotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).CreateProductLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_CreateProductLot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).CreateProductLot(ctx, req.(*CreateProductLotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_ListProductLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).ListProductLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_ListProductLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).ListProductLots(ctx, req.(*ListProductLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetExpiringProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpiringProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetExpiringProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetExpiringProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetExpiringProducts(ctx, req.(*GetExpiringProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_UpsertTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).UpsertTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_UpsertTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).UpsertTranslations(ctx, req.(*UpsertTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreService_GetTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServiceServer).GetTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreService_GetTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServiceServer).GetTranslations(ctx, req.(*GetTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -749,6 +1699,10 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStore",
 			Handler:    _StoreService_GetStore_Handler,
 		},
+		{
+			MethodName: "BatchGetStores",
+			Handler:    _StoreService_BatchGetStores_Handler,
+		},
 		{
 			MethodName: "UpdateStore",
 			Handler:    _StoreService_UpdateStore_Handler,
@@ -773,6 +1727,14 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductById",
 			Handler:    _StoreService_GetProductById_Handler,
 		},
+		{
+			MethodName: "GetProductByBarcode",
+			Handler:    _StoreService_GetProductByBarcode_Handler,
+		},
+		{
+			MethodName: "BatchGetProducts",
+			Handler:    _StoreService_BatchGetProducts_Handler,
+		},
 		{
 			MethodName: "GetProductList",
 			Handler:    _StoreService_GetProductList_Handler,
@@ -813,6 +1775,18 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProductCategory",
 			Handler:    _StoreService_UpdateProductCategory_Handler,
 		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _StoreService_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "MoveProductCategory",
+			Handler:    _StoreService_MoveProductCategory_Handler,
+		},
+		{
+			MethodName: "RenameProductCategory",
+			Handler:    _StoreService_RenameProductCategory_Handler,
+		},
 		{
 			MethodName: "GetProductTypes",
 			Handler:    _StoreService_GetProductTypes_Handler,
@@ -821,7 +1795,90 @@ var StoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertProductType",
 			Handler:    _StoreService_UpsertProductType_Handler,
 		},
+		{
+			MethodName: "UpsertProductAttributeDefinition",
+			Handler:    _StoreService_UpsertProductAttributeDefinition_Handler,
+		},
+		{
+			MethodName: "ListProductAttributeDefinitions",
+			Handler:    _StoreService_ListProductAttributeDefinitions_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _StoreService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _StoreService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _StoreService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookSubscription",
+			Handler:    _StoreService_UpdateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _StoreService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _StoreService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _StoreService_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "CreateProductDiscount",
+			Handler:    _StoreService_CreateProductDiscount_Handler,
+		},
+		{
+			MethodName: "ListProductDiscounts",
+			Handler:    _StoreService_ListProductDiscounts_Handler,
+		},
+		{
+			MethodName: "DeleteProductDiscount",
+			Handler:    _StoreService_DeleteProductDiscount_Handler,
+		},
+		{
+			MethodName: "ListProductPriceHistory",
+			Handler:    _StoreService_ListProductPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateProductLot",
+			Handler:    _StoreService_CreateProductLot_Handler,
+		},
+		{
+			MethodName: "ListProductLots",
+			Handler:    _StoreService_ListProductLots_Handler,
+		},
+		{
+			MethodName: "GetExpiringProducts",
+			Handler:    _StoreService_GetExpiringProducts_Handler,
+		},
+		{
+			MethodName: "UpsertTranslations",
+			Handler:    _StoreService_UpsertTranslations_Handler,
+		},
+		{
+			MethodName: "GetTranslations",
+			Handler:    _StoreService_GetTranslations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStore",
+			Handler:       _StoreService_WatchStore_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchProducts",
+			Handler:       _StoreService_WatchProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/store/store.proto",
 }
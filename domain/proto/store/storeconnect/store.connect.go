@@ -39,6 +39,9 @@ const (
 	StoreServiceCreateStoreProcedure = "/StoreService/CreateStore"
 	// StoreServiceGetStoreProcedure is the fully-qualified name of the StoreService's GetStore RPC.
 	StoreServiceGetStoreProcedure = "/StoreService/GetStore"
+	// StoreServiceBatchGetStoresProcedure is the fully-qualified name of the StoreService's
+	// BatchGetStores RPC.
+	StoreServiceBatchGetStoresProcedure = "/StoreService/BatchGetStores"
 	// StoreServiceUpdateStoreProcedure is the fully-qualified name of the StoreService's UpdateStore
 	// RPC.
 	StoreServiceUpdateStoreProcedure = "/StoreService/UpdateStore"
@@ -56,6 +59,12 @@ const (
 	// StoreServiceGetProductByIdProcedure is the fully-qualified name of the StoreService's
 	// GetProductById RPC.
 	StoreServiceGetProductByIdProcedure = "/StoreService/GetProductById"
+	// StoreServiceGetProductByBarcodeProcedure is the fully-qualified name of the StoreService's
+	// GetProductByBarcode RPC.
+	StoreServiceGetProductByBarcodeProcedure = "/StoreService/GetProductByBarcode"
+	// StoreServiceBatchGetProductsProcedure is the fully-qualified name of the StoreService's
+	// BatchGetProducts RPC.
+	StoreServiceBatchGetProductsProcedure = "/StoreService/BatchGetProducts"
 	// StoreServiceGetProductListProcedure is the fully-qualified name of the StoreService's
 	// GetProductList RPC.
 	StoreServiceGetProductListProcedure = "/StoreService/GetProductList"
@@ -86,37 +95,131 @@ const (
 	// StoreServiceUpdateProductCategoryProcedure is the fully-qualified name of the StoreService's
 	// UpdateProductCategory RPC.
 	StoreServiceUpdateProductCategoryProcedure = "/StoreService/UpdateProductCategory"
+	// StoreServiceGetCategoryTreeProcedure is the fully-qualified name of the StoreService's
+	// GetCategoryTree RPC.
+	StoreServiceGetCategoryTreeProcedure = "/StoreService/GetCategoryTree"
+	// StoreServiceMoveProductCategoryProcedure is the fully-qualified name of the StoreService's
+	// MoveProductCategory RPC.
+	StoreServiceMoveProductCategoryProcedure = "/StoreService/MoveProductCategory"
+	// StoreServiceRenameProductCategoryProcedure is the fully-qualified name of the StoreService's
+	// RenameProductCategory RPC.
+	StoreServiceRenameProductCategoryProcedure = "/StoreService/RenameProductCategory"
 	// StoreServiceGetProductTypesProcedure is the fully-qualified name of the StoreService's
 	// GetProductTypes RPC.
 	StoreServiceGetProductTypesProcedure = "/StoreService/GetProductTypes"
 	// StoreServiceUpsertProductTypeProcedure is the fully-qualified name of the StoreService's
 	// UpsertProductType RPC.
 	StoreServiceUpsertProductTypeProcedure = "/StoreService/UpsertProductType"
+	// StoreServiceUpsertProductAttributeDefinitionProcedure is the fully-qualified name of the
+	// StoreService's UpsertProductAttributeDefinition RPC.
+	StoreServiceUpsertProductAttributeDefinitionProcedure = "/StoreService/UpsertProductAttributeDefinition"
+	// StoreServiceListProductAttributeDefinitionsProcedure is the fully-qualified name of the
+	// StoreService's ListProductAttributeDefinitions RPC.
+	StoreServiceListProductAttributeDefinitionsProcedure = "/StoreService/ListProductAttributeDefinitions"
+	// StoreServiceListAuditEventsProcedure is the fully-qualified name of the StoreService's
+	// ListAuditEvents RPC.
+	StoreServiceListAuditEventsProcedure = "/StoreService/ListAuditEvents"
+	// StoreServiceWatchStoreProcedure is the fully-qualified name of the StoreService's WatchStore RPC.
+	StoreServiceWatchStoreProcedure = "/StoreService/WatchStore"
+	// StoreServiceWatchProductsProcedure is the fully-qualified name of the StoreService's
+	// WatchProducts RPC.
+	StoreServiceWatchProductsProcedure = "/StoreService/WatchProducts"
+	// StoreServiceCreateWebhookSubscriptionProcedure is the fully-qualified name of the StoreService's
+	// CreateWebhookSubscription RPC.
+	StoreServiceCreateWebhookSubscriptionProcedure = "/StoreService/CreateWebhookSubscription"
+	// StoreServiceListWebhookSubscriptionsProcedure is the fully-qualified name of the StoreService's
+	// ListWebhookSubscriptions RPC.
+	StoreServiceListWebhookSubscriptionsProcedure = "/StoreService/ListWebhookSubscriptions"
+	// StoreServiceUpdateWebhookSubscriptionProcedure is the fully-qualified name of the StoreService's
+	// UpdateWebhookSubscription RPC.
+	StoreServiceUpdateWebhookSubscriptionProcedure = "/StoreService/UpdateWebhookSubscription"
+	// StoreServiceDeleteWebhookSubscriptionProcedure is the fully-qualified name of the StoreService's
+	// DeleteWebhookSubscription RPC.
+	StoreServiceDeleteWebhookSubscriptionProcedure = "/StoreService/DeleteWebhookSubscription"
+	// StoreServiceListWebhookDeliveriesProcedure is the fully-qualified name of the StoreService's
+	// ListWebhookDeliveries RPC.
+	StoreServiceListWebhookDeliveriesProcedure = "/StoreService/ListWebhookDeliveries"
+	// StoreServiceReplayWebhookDeliveryProcedure is the fully-qualified name of the StoreService's
+	// ReplayWebhookDelivery RPC.
+	StoreServiceReplayWebhookDeliveryProcedure = "/StoreService/ReplayWebhookDelivery"
+	// StoreServiceCreateProductDiscountProcedure is the fully-qualified name of the StoreService's
+	// CreateProductDiscount RPC.
+	StoreServiceCreateProductDiscountProcedure = "/StoreService/CreateProductDiscount"
+	// StoreServiceListProductDiscountsProcedure is the fully-qualified name of the StoreService's
+	// ListProductDiscounts RPC.
+	StoreServiceListProductDiscountsProcedure = "/StoreService/ListProductDiscounts"
+	// StoreServiceDeleteProductDiscountProcedure is the fully-qualified name of the StoreService's
+	// DeleteProductDiscount RPC.
+	StoreServiceDeleteProductDiscountProcedure = "/StoreService/DeleteProductDiscount"
+	// StoreServiceListProductPriceHistoryProcedure is the fully-qualified name of the StoreService's
+	// ListProductPriceHistory RPC.
+	StoreServiceListProductPriceHistoryProcedure = "/StoreService/ListProductPriceHistory"
+	// StoreServiceCreateProductLotProcedure is the fully-qualified name of the StoreService's
+	// CreateProductLot RPC.
+	StoreServiceCreateProductLotProcedure = "/StoreService/CreateProductLot"
+	// StoreServiceListProductLotsProcedure is the fully-qualified name of the StoreService's
+	// ListProductLots RPC.
+	StoreServiceListProductLotsProcedure = "/StoreService/ListProductLots"
+	// StoreServiceGetExpiringProductsProcedure is the fully-qualified name of the StoreService's
+	// GetExpiringProducts RPC.
+	StoreServiceGetExpiringProductsProcedure = "/StoreService/GetExpiringProducts"
+	// StoreServiceUpsertTranslationsProcedure is the fully-qualified name of the StoreService's
+	// UpsertTranslations RPC.
+	StoreServiceUpsertTranslationsProcedure = "/StoreService/UpsertTranslations"
+	// StoreServiceGetTranslationsProcedure is the fully-qualified name of the StoreService's
+	// GetTranslations RPC.
+	StoreServiceGetTranslationsProcedure = "/StoreService/GetTranslations"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	storeServiceServiceDescriptor                     = store.File_proto_store_store_proto.Services().ByName("StoreService")
-	storeServiceCreateStoreMethodDescriptor           = storeServiceServiceDescriptor.Methods().ByName("CreateStore")
-	storeServiceGetStoreMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("GetStore")
-	storeServiceUpdateStoreMethodDescriptor           = storeServiceServiceDescriptor.Methods().ByName("UpdateStore")
-	storeServiceDeleteStoreMethodDescriptor           = storeServiceServiceDescriptor.Methods().ByName("DeleteStore")
-	storeServiceListStoresMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("ListStores")
-	storeServiceGetStoreByUserIDMethodDescriptor      = storeServiceServiceDescriptor.Methods().ByName("GetStoreByUserID")
-	storeServiceOpenCloseStoreMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("OpenCloseStore")
-	storeServiceGetProductByIdMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("GetProductById")
-	storeServiceGetProductListMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("GetProductList")
-	storeServiceInsertProductsMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("InsertProducts")
-	storeServiceUpdateProductMethodDescriptor         = storeServiceServiceDescriptor.Methods().ByName("UpdateProduct")
-	storeServiceDeleteProductMethodDescriptor         = storeServiceServiceDescriptor.Methods().ByName("DeleteProduct")
-	storeServiceGetUnitOfMeasuresMethodDescriptor     = storeServiceServiceDescriptor.Methods().ByName("GetUnitOfMeasures")
-	storeServiceUpsertUnitOfMeasureMethodDescriptor   = storeServiceServiceDescriptor.Methods().ByName("UpsertUnitOfMeasure")
-	storeServiceUpdateUnitOfMeasureMethodDescriptor   = storeServiceServiceDescriptor.Methods().ByName("UpdateUnitOfMeasure")
-	storeServiceGetProductCategoriesMethodDescriptor  = storeServiceServiceDescriptor.Methods().ByName("GetProductCategories")
-	storeServiceUpsertProductCategoryMethodDescriptor = storeServiceServiceDescriptor.Methods().ByName("UpsertProductCategory")
-	storeServiceUpdateProductCategoryMethodDescriptor = storeServiceServiceDescriptor.Methods().ByName("UpdateProductCategory")
-	storeServiceGetProductTypesMethodDescriptor       = storeServiceServiceDescriptor.Methods().ByName("GetProductTypes")
-	storeServiceUpsertProductTypeMethodDescriptor     = storeServiceServiceDescriptor.Methods().ByName("UpsertProductType")
+	storeServiceServiceDescriptor                                = store.File_proto_store_store_proto.Services().ByName("StoreService")
+	storeServiceCreateStoreMethodDescriptor                      = storeServiceServiceDescriptor.Methods().ByName("CreateStore")
+	storeServiceGetStoreMethodDescriptor                         = storeServiceServiceDescriptor.Methods().ByName("GetStore")
+	storeServiceBatchGetStoresMethodDescriptor                   = storeServiceServiceDescriptor.Methods().ByName("BatchGetStores")
+	storeServiceUpdateStoreMethodDescriptor                      = storeServiceServiceDescriptor.Methods().ByName("UpdateStore")
+	storeServiceDeleteStoreMethodDescriptor                      = storeServiceServiceDescriptor.Methods().ByName("DeleteStore")
+	storeServiceListStoresMethodDescriptor                       = storeServiceServiceDescriptor.Methods().ByName("ListStores")
+	storeServiceGetStoreByUserIDMethodDescriptor                 = storeServiceServiceDescriptor.Methods().ByName("GetStoreByUserID")
+	storeServiceOpenCloseStoreMethodDescriptor                   = storeServiceServiceDescriptor.Methods().ByName("OpenCloseStore")
+	storeServiceGetProductByIdMethodDescriptor                   = storeServiceServiceDescriptor.Methods().ByName("GetProductById")
+	storeServiceGetProductByBarcodeMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("GetProductByBarcode")
+	storeServiceBatchGetProductsMethodDescriptor                 = storeServiceServiceDescriptor.Methods().ByName("BatchGetProducts")
+	storeServiceGetProductListMethodDescriptor                   = storeServiceServiceDescriptor.Methods().ByName("GetProductList")
+	storeServiceInsertProductsMethodDescriptor                   = storeServiceServiceDescriptor.Methods().ByName("InsertProducts")
+	storeServiceUpdateProductMethodDescriptor                    = storeServiceServiceDescriptor.Methods().ByName("UpdateProduct")
+	storeServiceDeleteProductMethodDescriptor                    = storeServiceServiceDescriptor.Methods().ByName("DeleteProduct")
+	storeServiceGetUnitOfMeasuresMethodDescriptor                = storeServiceServiceDescriptor.Methods().ByName("GetUnitOfMeasures")
+	storeServiceUpsertUnitOfMeasureMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("UpsertUnitOfMeasure")
+	storeServiceUpdateUnitOfMeasureMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("UpdateUnitOfMeasure")
+	storeServiceGetProductCategoriesMethodDescriptor             = storeServiceServiceDescriptor.Methods().ByName("GetProductCategories")
+	storeServiceUpsertProductCategoryMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("UpsertProductCategory")
+	storeServiceUpdateProductCategoryMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("UpdateProductCategory")
+	storeServiceGetCategoryTreeMethodDescriptor                  = storeServiceServiceDescriptor.Methods().ByName("GetCategoryTree")
+	storeServiceMoveProductCategoryMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("MoveProductCategory")
+	storeServiceRenameProductCategoryMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("RenameProductCategory")
+	storeServiceGetProductTypesMethodDescriptor                  = storeServiceServiceDescriptor.Methods().ByName("GetProductTypes")
+	storeServiceUpsertProductTypeMethodDescriptor                = storeServiceServiceDescriptor.Methods().ByName("UpsertProductType")
+	storeServiceUpsertProductAttributeDefinitionMethodDescriptor = storeServiceServiceDescriptor.Methods().ByName("UpsertProductAttributeDefinition")
+	storeServiceListProductAttributeDefinitionsMethodDescriptor  = storeServiceServiceDescriptor.Methods().ByName("ListProductAttributeDefinitions")
+	storeServiceListAuditEventsMethodDescriptor                  = storeServiceServiceDescriptor.Methods().ByName("ListAuditEvents")
+	storeServiceWatchStoreMethodDescriptor                       = storeServiceServiceDescriptor.Methods().ByName("WatchStore")
+	storeServiceWatchProductsMethodDescriptor                    = storeServiceServiceDescriptor.Methods().ByName("WatchProducts")
+	storeServiceCreateWebhookSubscriptionMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("CreateWebhookSubscription")
+	storeServiceListWebhookSubscriptionsMethodDescriptor         = storeServiceServiceDescriptor.Methods().ByName("ListWebhookSubscriptions")
+	storeServiceUpdateWebhookSubscriptionMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("UpdateWebhookSubscription")
+	storeServiceDeleteWebhookSubscriptionMethodDescriptor        = storeServiceServiceDescriptor.Methods().ByName("DeleteWebhookSubscription")
+	storeServiceListWebhookDeliveriesMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("ListWebhookDeliveries")
+	storeServiceReplayWebhookDeliveryMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("ReplayWebhookDelivery")
+	storeServiceCreateProductDiscountMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("CreateProductDiscount")
+	storeServiceListProductDiscountsMethodDescriptor             = storeServiceServiceDescriptor.Methods().ByName("ListProductDiscounts")
+	storeServiceDeleteProductDiscountMethodDescriptor            = storeServiceServiceDescriptor.Methods().ByName("DeleteProductDiscount")
+	storeServiceListProductPriceHistoryMethodDescriptor          = storeServiceServiceDescriptor.Methods().ByName("ListProductPriceHistory")
+	storeServiceCreateProductLotMethodDescriptor                 = storeServiceServiceDescriptor.Methods().ByName("CreateProductLot")
+	storeServiceListProductLotsMethodDescriptor                  = storeServiceServiceDescriptor.Methods().ByName("ListProductLots")
+	storeServiceGetExpiringProductsMethodDescriptor              = storeServiceServiceDescriptor.Methods().ByName("GetExpiringProducts")
+	storeServiceUpsertTranslationsMethodDescriptor               = storeServiceServiceDescriptor.Methods().ByName("UpsertTranslations")
+	storeServiceGetTranslationsMethodDescriptor                  = storeServiceServiceDescriptor.Methods().ByName("GetTranslations")
 )
 
 // StoreServiceClient is a client for the StoreService service.
@@ -125,6 +228,8 @@ type StoreServiceClient interface {
 	CreateStore(context.Context, *connect.Request[store.CreateStoreRequest]) (*connect.Response[store.CreateStoreResponse], error)
 	// Get a store by ID
 	GetStore(context.Context, *connect.Request[store.GetStoreRequest]) (*connect.Response[store.GetStoreResponse], error)
+	// Get many stores by ID, the results follow the order of the requested ids
+	BatchGetStores(context.Context, *connect.Request[store.BatchGetStoresRequest]) (*connect.Response[store.BatchGetStoresResponse], error)
 	// Update an existing store
 	UpdateStore(context.Context, *connect.Request[store.UpdateStoreRequest]) (*connect.Response[store.UpdateStoreResponse], error)
 	// Delete a store by ID
@@ -136,6 +241,9 @@ type StoreServiceClient interface {
 	// Open close store
 	OpenCloseStore(context.Context, *connect.Request[store.OpenCloseStoreRequest]) (*connect.Response[store.OpenCloseStoreResponse], error)
 	GetProductById(context.Context, *connect.Request[store.GetProductByIdRequest]) (*connect.Response[store.GetProductByIdResponse], error)
+	// Get many products by ID, the results follow the order of the requested ids
+	GetProductByBarcode(context.Context, *connect.Request[store.GetProductByBarcodeRequest]) (*connect.Response[store.GetProductByIdResponse], error)
+	BatchGetProducts(context.Context, *connect.Request[store.BatchGetProductsRequest]) (*connect.Response[store.BatchGetProductsResponse], error)
 	GetProductList(context.Context, *connect.Request[store.GetProductListRequest]) (*connect.Response[store.GetProductListResponse], error)
 	InsertProducts(context.Context, *connect.Request[store.InsertProductsRequest]) (*connect.Response[store.GenericResponse], error)
 	UpdateProduct(context.Context, *connect.Request[store.UpdateProductRequest]) (*connect.Response[store.GenericResponse], error)
@@ -146,8 +254,49 @@ type StoreServiceClient interface {
 	GetProductCategories(context.Context, *connect.Request[store.GetProductCategoriesRequest]) (*connect.Response[store.GetProductCategoriesResponse], error)
 	UpsertProductCategory(context.Context, *connect.Request[store.UpsertProductCategoryRequest]) (*connect.Response[store.UpsertProductCategoryResponse], error)
 	UpdateProductCategory(context.Context, *connect.Request[store.UpsertProductCategoryRequest]) (*connect.Response[store.UpsertProductCategoryResponse], error)
+	// Get the categories as a tree, below root_id or from the root categories when root_id is empty
+	GetCategoryTree(context.Context, *connect.Request[store.GetCategoryTreeRequest]) (*connect.Response[store.GetCategoryTreeResponse], error)
+	// Move a category with its subcategories below another parent, or to the root when parent_id is empty
+	MoveProductCategory(context.Context, *connect.Request[store.MoveProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error)
+	RenameProductCategory(context.Context, *connect.Request[store.RenameProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error)
 	GetProductTypes(context.Context, *connect.Request[store.GetProductTypesRequest]) (*connect.Response[store.GetProductTypesResponse], error)
 	UpsertProductType(context.Context, *connect.Request[store.UpsertProductTypeRequest]) (*connect.Response[store.UpsertProductTypeResponse], error)
+	// Define an attribute the products of a type can record, the attribute is updated when its id is set
+	UpsertProductAttributeDefinition(context.Context, *connect.Request[store.UpsertProductAttributeDefinitionRequest]) (*connect.Response[store.ProductAttributeDefinitionResponse], error)
+	ListProductAttributeDefinitions(context.Context, *connect.Request[store.ListProductAttributeDefinitionsRequest]) (*connect.Response[store.ListProductAttributeDefinitionsResponse], error)
+	// List the audit events of changes, only for admins
+	ListAuditEvents(context.Context, *connect.Request[store.ListAuditEventsRequest]) (*connect.Response[store.ListAuditEventsResponse], error)
+	// Stream the changes of a store status and profile, the stream is resumed after after_sequence
+	WatchStore(context.Context, *connect.Request[store.WatchStoreRequest]) (*connect.ServerStreamForClient[store.StoreEvent], error)
+	// Stream the product upserts, deletes and stock changes of a store, the stream is resumed after after_sequence
+	WatchProducts(context.Context, *connect.Request[store.WatchProductsRequest]) (*connect.ServerStreamForClient[store.StoreEvent], error)
+	// Register a webhook receiving the events of a store
+	CreateWebhookSubscription(context.Context, *connect.Request[store.CreateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error)
+	ListWebhookSubscriptions(context.Context, *connect.Request[store.ListWebhookSubscriptionsRequest]) (*connect.Response[store.ListWebhookSubscriptionsResponse], error)
+	// Update a webhook, setting is_active re-enables a disabled webhook
+	UpdateWebhookSubscription(context.Context, *connect.Request[store.UpdateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error)
+	DeleteWebhookSubscription(context.Context, *connect.Request[store.DeleteWebhookSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// List the deliveries of a webhook, the latest first
+	ListWebhookDeliveries(context.Context, *connect.Request[store.ListWebhookDeliveriesRequest]) (*connect.Response[store.ListWebhookDeliveriesResponse], error)
+	// Deliver an event to a webhook again
+	ReplayWebhookDelivery(context.Context, *connect.Request[store.ReplayWebhookDeliveryRequest]) (*connect.Response[store.WebhookDeliveryResponse], error)
+	// Schedule a discount of a product
+	CreateProductDiscount(context.Context, *connect.Request[store.CreateProductDiscountRequest]) (*connect.Response[store.ProductDiscountResponse], error)
+	// List the discounts of a product, the latest first
+	ListProductDiscounts(context.Context, *connect.Request[store.ListProductDiscountsRequest]) (*connect.Response[store.ListProductDiscountsResponse], error)
+	// Remove a discount of a product
+	DeleteProductDiscount(context.Context, *connect.Request[store.DeleteProductDiscountRequest]) (*connect.Response[emptypb.Empty], error)
+	// List the price changes of a product, the latest first
+	ListProductPriceHistory(context.Context, *connect.Request[store.ListProductPriceHistoryRequest]) (*connect.Response[store.ListProductPriceHistoryResponse], error)
+	// Record a lot of a product, its quantity is added to the stock of the product
+	CreateProductLot(context.Context, *connect.Request[store.CreateProductLotRequest]) (*connect.Response[store.ProductLotResponse], error)
+	// List the lots of a product, the first to expire first
+	ListProductLots(context.Context, *connect.Request[store.ListProductLotsRequest]) (*connect.Response[store.ListProductLotsResponse], error)
+	// List the lots of a store with stock left which expire within days, or have expired already
+	GetExpiringProducts(context.Context, *connect.Request[store.GetExpiringProductsRequest]) (*connect.Response[store.ListProductLotsResponse], error)
+	// Set the names of a category, product type or unit of measure in other locales
+	UpsertTranslations(context.Context, *connect.Request[store.UpsertTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error)
+	GetTranslations(context.Context, *connect.Request[store.GetTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error)
 }
 
 // NewStoreServiceClient constructs a client for the StoreService service. By default, it uses the
@@ -172,6 +321,12 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(storeServiceGetStoreMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		batchGetStores: connect.NewClient[store.BatchGetStoresRequest, store.BatchGetStoresResponse](
+			httpClient,
+			baseURL+StoreServiceBatchGetStoresProcedure,
+			connect.WithSchema(storeServiceBatchGetStoresMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateStore: connect.NewClient[store.UpdateStoreRequest, store.UpdateStoreResponse](
 			httpClient,
 			baseURL+StoreServiceUpdateStoreProcedure,
@@ -208,6 +363,18 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(storeServiceGetProductByIdMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getProductByBarcode: connect.NewClient[store.GetProductByBarcodeRequest, store.GetProductByIdResponse](
+			httpClient,
+			baseURL+StoreServiceGetProductByBarcodeProcedure,
+			connect.WithSchema(storeServiceGetProductByBarcodeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchGetProducts: connect.NewClient[store.BatchGetProductsRequest, store.BatchGetProductsResponse](
+			httpClient,
+			baseURL+StoreServiceBatchGetProductsProcedure,
+			connect.WithSchema(storeServiceBatchGetProductsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getProductList: connect.NewClient[store.GetProductListRequest, store.GetProductListResponse](
 			httpClient,
 			baseURL+StoreServiceGetProductListProcedure,
@@ -268,6 +435,24 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(storeServiceUpdateProductCategoryMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getCategoryTree: connect.NewClient[store.GetCategoryTreeRequest, store.GetCategoryTreeResponse](
+			httpClient,
+			baseURL+StoreServiceGetCategoryTreeProcedure,
+			connect.WithSchema(storeServiceGetCategoryTreeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		moveProductCategory: connect.NewClient[store.MoveProductCategoryRequest, store.ProductCategoryResponse](
+			httpClient,
+			baseURL+StoreServiceMoveProductCategoryProcedure,
+			connect.WithSchema(storeServiceMoveProductCategoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		renameProductCategory: connect.NewClient[store.RenameProductCategoryRequest, store.ProductCategoryResponse](
+			httpClient,
+			baseURL+StoreServiceRenameProductCategoryProcedure,
+			connect.WithSchema(storeServiceRenameProductCategoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getProductTypes: connect.NewClient[store.GetProductTypesRequest, store.GetProductTypesResponse](
 			httpClient,
 			baseURL+StoreServiceGetProductTypesProcedure,
@@ -280,31 +465,177 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(storeServiceUpsertProductTypeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		upsertProductAttributeDefinition: connect.NewClient[store.UpsertProductAttributeDefinitionRequest, store.ProductAttributeDefinitionResponse](
+			httpClient,
+			baseURL+StoreServiceUpsertProductAttributeDefinitionProcedure,
+			connect.WithSchema(storeServiceUpsertProductAttributeDefinitionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listProductAttributeDefinitions: connect.NewClient[store.ListProductAttributeDefinitionsRequest, store.ListProductAttributeDefinitionsResponse](
+			httpClient,
+			baseURL+StoreServiceListProductAttributeDefinitionsProcedure,
+			connect.WithSchema(storeServiceListProductAttributeDefinitionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[store.ListAuditEventsRequest, store.ListAuditEventsResponse](
+			httpClient,
+			baseURL+StoreServiceListAuditEventsProcedure,
+			connect.WithSchema(storeServiceListAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watchStore: connect.NewClient[store.WatchStoreRequest, store.StoreEvent](
+			httpClient,
+			baseURL+StoreServiceWatchStoreProcedure,
+			connect.WithSchema(storeServiceWatchStoreMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watchProducts: connect.NewClient[store.WatchProductsRequest, store.StoreEvent](
+			httpClient,
+			baseURL+StoreServiceWatchProductsProcedure,
+			connect.WithSchema(storeServiceWatchProductsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhookSubscription: connect.NewClient[store.CreateWebhookSubscriptionRequest, store.WebhookSubscriptionResponse](
+			httpClient,
+			baseURL+StoreServiceCreateWebhookSubscriptionProcedure,
+			connect.WithSchema(storeServiceCreateWebhookSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookSubscriptions: connect.NewClient[store.ListWebhookSubscriptionsRequest, store.ListWebhookSubscriptionsResponse](
+			httpClient,
+			baseURL+StoreServiceListWebhookSubscriptionsProcedure,
+			connect.WithSchema(storeServiceListWebhookSubscriptionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookSubscription: connect.NewClient[store.UpdateWebhookSubscriptionRequest, store.WebhookSubscriptionResponse](
+			httpClient,
+			baseURL+StoreServiceUpdateWebhookSubscriptionProcedure,
+			connect.WithSchema(storeServiceUpdateWebhookSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookSubscription: connect.NewClient[store.DeleteWebhookSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+StoreServiceDeleteWebhookSubscriptionProcedure,
+			connect.WithSchema(storeServiceDeleteWebhookSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[store.ListWebhookDeliveriesRequest, store.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+StoreServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(storeServiceListWebhookDeliveriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		replayWebhookDelivery: connect.NewClient[store.ReplayWebhookDeliveryRequest, store.WebhookDeliveryResponse](
+			httpClient,
+			baseURL+StoreServiceReplayWebhookDeliveryProcedure,
+			connect.WithSchema(storeServiceReplayWebhookDeliveryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createProductDiscount: connect.NewClient[store.CreateProductDiscountRequest, store.ProductDiscountResponse](
+			httpClient,
+			baseURL+StoreServiceCreateProductDiscountProcedure,
+			connect.WithSchema(storeServiceCreateProductDiscountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listProductDiscounts: connect.NewClient[store.ListProductDiscountsRequest, store.ListProductDiscountsResponse](
+			httpClient,
+			baseURL+StoreServiceListProductDiscountsProcedure,
+			connect.WithSchema(storeServiceListProductDiscountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProductDiscount: connect.NewClient[store.DeleteProductDiscountRequest, emptypb.Empty](
+			httpClient,
+			baseURL+StoreServiceDeleteProductDiscountProcedure,
+			connect.WithSchema(storeServiceDeleteProductDiscountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listProductPriceHistory: connect.NewClient[store.ListProductPriceHistoryRequest, store.ListProductPriceHistoryResponse](
+			httpClient,
+			baseURL+StoreServiceListProductPriceHistoryProcedure,
+			connect.WithSchema(storeServiceListProductPriceHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createProductLot: connect.NewClient[store.CreateProductLotRequest, store.ProductLotResponse](
+			httpClient,
+			baseURL+StoreServiceCreateProductLotProcedure,
+			connect.WithSchema(storeServiceCreateProductLotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listProductLots: connect.NewClient[store.ListProductLotsRequest, store.ListProductLotsResponse](
+			httpClient,
+			baseURL+StoreServiceListProductLotsProcedure,
+			connect.WithSchema(storeServiceListProductLotsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getExpiringProducts: connect.NewClient[store.GetExpiringProductsRequest, store.ListProductLotsResponse](
+			httpClient,
+			baseURL+StoreServiceGetExpiringProductsProcedure,
+			connect.WithSchema(storeServiceGetExpiringProductsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		upsertTranslations: connect.NewClient[store.UpsertTranslationsRequest, store.TranslationsResponse](
+			httpClient,
+			baseURL+StoreServiceUpsertTranslationsProcedure,
+			connect.WithSchema(storeServiceUpsertTranslationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTranslations: connect.NewClient[store.GetTranslationsRequest, store.TranslationsResponse](
+			httpClient,
+			baseURL+StoreServiceGetTranslationsProcedure,
+			connect.WithSchema(storeServiceGetTranslationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // storeServiceClient implements StoreServiceClient.
 type storeServiceClient struct {
-	createStore           *connect.Client[store.CreateStoreRequest, store.CreateStoreResponse]
-	getStore              *connect.Client[store.GetStoreRequest, store.GetStoreResponse]
-	updateStore           *connect.Client[store.UpdateStoreRequest, store.UpdateStoreResponse]
-	deleteStore           *connect.Client[store.DeleteStoreRequest, emptypb.Empty]
-	listStores            *connect.Client[store.ListStoresRequest, store.ListStoresResponse]
-	getStoreByUserID      *connect.Client[store.GetStoreByUserIDRequest, store.GetStoreByUserIDResponse]
-	openCloseStore        *connect.Client[store.OpenCloseStoreRequest, store.OpenCloseStoreResponse]
-	getProductById        *connect.Client[store.GetProductByIdRequest, store.GetProductByIdResponse]
-	getProductList        *connect.Client[store.GetProductListRequest, store.GetProductListResponse]
-	insertProducts        *connect.Client[store.InsertProductsRequest, store.GenericResponse]
-	updateProduct         *connect.Client[store.UpdateProductRequest, store.GenericResponse]
-	deleteProduct         *connect.Client[store.DeleteProductRequest, emptypb.Empty]
-	getUnitOfMeasures     *connect.Client[store.GetUnitOfMeasuresRequest, store.GetUnitOfMeasuresResponse]
-	upsertUnitOfMeasure   *connect.Client[store.UpsertUnitOfMeasureRequest, store.UpsertUnitOfMeasureResponse]
-	updateUnitOfMeasure   *connect.Client[store.UpdateUnitOfMeasureRequest, store.UpdateUnitOfMeasureResponse]
-	getProductCategories  *connect.Client[store.GetProductCategoriesRequest, store.GetProductCategoriesResponse]
-	upsertProductCategory *connect.Client[store.UpsertProductCategoryRequest, store.UpsertProductCategoryResponse]
-	updateProductCategory *connect.Client[store.UpsertProductCategoryRequest, store.UpsertProductCategoryResponse]
-	getProductTypes       *connect.Client[store.GetProductTypesRequest, store.GetProductTypesResponse]
-	upsertProductType     *connect.Client[store.UpsertProductTypeRequest, store.UpsertProductTypeResponse]
+	createStore                      *connect.Client[store.CreateStoreRequest, store.CreateStoreResponse]
+	getStore                         *connect.Client[store.GetStoreRequest, store.GetStoreResponse]
+	batchGetStores                   *connect.Client[store.BatchGetStoresRequest, store.BatchGetStoresResponse]
+	updateStore                      *connect.Client[store.UpdateStoreRequest, store.UpdateStoreResponse]
+	deleteStore                      *connect.Client[store.DeleteStoreRequest, emptypb.Empty]
+	listStores                       *connect.Client[store.ListStoresRequest, store.ListStoresResponse]
+	getStoreByUserID                 *connect.Client[store.GetStoreByUserIDRequest, store.GetStoreByUserIDResponse]
+	openCloseStore                   *connect.Client[store.OpenCloseStoreRequest, store.OpenCloseStoreResponse]
+	getProductById                   *connect.Client[store.GetProductByIdRequest, store.GetProductByIdResponse]
+	getProductByBarcode              *connect.Client[store.GetProductByBarcodeRequest, store.GetProductByIdResponse]
+	batchGetProducts                 *connect.Client[store.BatchGetProductsRequest, store.BatchGetProductsResponse]
+	getProductList                   *connect.Client[store.GetProductListRequest, store.GetProductListResponse]
+	insertProducts                   *connect.Client[store.InsertProductsRequest, store.GenericResponse]
+	updateProduct                    *connect.Client[store.UpdateProductRequest, store.GenericResponse]
+	deleteProduct                    *connect.Client[store.DeleteProductRequest, emptypb.Empty]
+	getUnitOfMeasures                *connect.Client[store.GetUnitOfMeasuresRequest, store.GetUnitOfMeasuresResponse]
+	upsertUnitOfMeasure              *connect.Client[store.UpsertUnitOfMeasureRequest, store.UpsertUnitOfMeasureResponse]
+	updateUnitOfMeasure              *connect.Client[store.UpdateUnitOfMeasureRequest, store.UpdateUnitOfMeasureResponse]
+	getProductCategories             *connect.Client[store.GetProductCategoriesRequest, store.GetProductCategoriesResponse]
+	upsertProductCategory            *connect.Client[store.UpsertProductCategoryRequest, store.UpsertProductCategoryResponse]
+	updateProductCategory            *connect.Client[store.UpsertProductCategoryRequest, store.UpsertProductCategoryResponse]
+	getCategoryTree                  *connect.Client[store.GetCategoryTreeRequest, store.GetCategoryTreeResponse]
+	moveProductCategory              *connect.Client[store.MoveProductCategoryRequest, store.ProductCategoryResponse]
+	renameProductCategory            *connect.Client[store.RenameProductCategoryRequest, store.ProductCategoryResponse]
+	getProductTypes                  *connect.Client[store.GetProductTypesRequest, store.GetProductTypesResponse]
+	upsertProductType                *connect.Client[store.UpsertProductTypeRequest, store.UpsertProductTypeResponse]
+	upsertProductAttributeDefinition *connect.Client[store.UpsertProductAttributeDefinitionRequest, store.ProductAttributeDefinitionResponse]
+	listProductAttributeDefinitions  *connect.Client[store.ListProductAttributeDefinitionsRequest, store.ListProductAttributeDefinitionsResponse]
+	listAuditEvents                  *connect.Client[store.ListAuditEventsRequest, store.ListAuditEventsResponse]
+	watchStore                       *connect.Client[store.WatchStoreRequest, store.StoreEvent]
+	watchProducts                    *connect.Client[store.WatchProductsRequest, store.StoreEvent]
+	createWebhookSubscription        *connect.Client[store.CreateWebhookSubscriptionRequest, store.WebhookSubscriptionResponse]
+	listWebhookSubscriptions         *connect.Client[store.ListWebhookSubscriptionsRequest, store.ListWebhookSubscriptionsResponse]
+	updateWebhookSubscription        *connect.Client[store.UpdateWebhookSubscriptionRequest, store.WebhookSubscriptionResponse]
+	deleteWebhookSubscription        *connect.Client[store.DeleteWebhookSubscriptionRequest, emptypb.Empty]
+	listWebhookDeliveries            *connect.Client[store.ListWebhookDeliveriesRequest, store.ListWebhookDeliveriesResponse]
+	replayWebhookDelivery            *connect.Client[store.ReplayWebhookDeliveryRequest, store.WebhookDeliveryResponse]
+	createProductDiscount            *connect.Client[store.CreateProductDiscountRequest, store.ProductDiscountResponse]
+	listProductDiscounts             *connect.Client[store.ListProductDiscountsRequest, store.ListProductDiscountsResponse]
+	deleteProductDiscount            *connect.Client[store.DeleteProductDiscountRequest, emptypb.Empty]
+	listProductPriceHistory          *connect.Client[store.ListProductPriceHistoryRequest, store.ListProductPriceHistoryResponse]
+	createProductLot                 *connect.Client[store.CreateProductLotRequest, store.ProductLotResponse]
+	listProductLots                  *connect.Client[store.ListProductLotsRequest, store.ListProductLotsResponse]
+	getExpiringProducts              *connect.Client[store.GetExpiringProductsRequest, store.ListProductLotsResponse]
+	upsertTranslations               *connect.Client[store.UpsertTranslationsRequest, store.TranslationsResponse]
+	getTranslations                  *connect.Client[store.GetTranslationsRequest, store.TranslationsResponse]
 }
 
 // CreateStore calls StoreService.CreateStore.
@@ -317,6 +648,11 @@ func (c *storeServiceClient) GetStore(ctx context.Context, req *connect.Request[
 	return c.getStore.CallUnary(ctx, req)
 }
 
+// BatchGetStores calls StoreService.BatchGetStores.
+func (c *storeServiceClient) BatchGetStores(ctx context.Context, req *connect.Request[store.BatchGetStoresRequest]) (*connect.Response[store.BatchGetStoresResponse], error) {
+	return c.batchGetStores.CallUnary(ctx, req)
+}
+
 // UpdateStore calls StoreService.UpdateStore.
 func (c *storeServiceClient) UpdateStore(ctx context.Context, req *connect.Request[store.UpdateStoreRequest]) (*connect.Response[store.UpdateStoreResponse], error) {
 	return c.updateStore.CallUnary(ctx, req)
@@ -347,6 +683,16 @@ func (c *storeServiceClient) GetProductById(ctx context.Context, req *connect.Re
 	return c.getProductById.CallUnary(ctx, req)
 }
 
+// GetProductByBarcode calls StoreService.GetProductByBarcode.
+func (c *storeServiceClient) GetProductByBarcode(ctx context.Context, req *connect.Request[store.GetProductByBarcodeRequest]) (*connect.Response[store.GetProductByIdResponse], error) {
+	return c.getProductByBarcode.CallUnary(ctx, req)
+}
+
+// BatchGetProducts calls StoreService.BatchGetProducts.
+func (c *storeServiceClient) BatchGetProducts(ctx context.Context, req *connect.Request[store.BatchGetProductsRequest]) (*connect.Response[store.BatchGetProductsResponse], error) {
+	return c.batchGetProducts.CallUnary(ctx, req)
+}
+
 // GetProductList calls StoreService.GetProductList.
 func (c *storeServiceClient) GetProductList(ctx context.Context, req *connect.Request[store.GetProductListRequest]) (*connect.Response[store.GetProductListResponse], error) {
 	return c.getProductList.CallUnary(ctx, req)
@@ -397,6 +743,21 @@ func (c *storeServiceClient) UpdateProductCategory(ctx context.Context, req *con
 	return c.updateProductCategory.CallUnary(ctx, req)
 }
 
+// GetCategoryTree calls StoreService.GetCategoryTree.
+func (c *storeServiceClient) GetCategoryTree(ctx context.Context, req *connect.Request[store.GetCategoryTreeRequest]) (*connect.Response[store.GetCategoryTreeResponse], error) {
+	return c.getCategoryTree.CallUnary(ctx, req)
+}
+
+// MoveProductCategory calls StoreService.MoveProductCategory.
+func (c *storeServiceClient) MoveProductCategory(ctx context.Context, req *connect.Request[store.MoveProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error) {
+	return c.moveProductCategory.CallUnary(ctx, req)
+}
+
+// RenameProductCategory calls StoreService.RenameProductCategory.
+func (c *storeServiceClient) RenameProductCategory(ctx context.Context, req *connect.Request[store.RenameProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error) {
+	return c.renameProductCategory.CallUnary(ctx, req)
+}
+
 // GetProductTypes calls StoreService.GetProductTypes.
 func (c *storeServiceClient) GetProductTypes(ctx context.Context, req *connect.Request[store.GetProductTypesRequest]) (*connect.Response[store.GetProductTypesResponse], error) {
 	return c.getProductTypes.CallUnary(ctx, req)
@@ -407,12 +768,114 @@ func (c *storeServiceClient) UpsertProductType(ctx context.Context, req *connect
 	return c.upsertProductType.CallUnary(ctx, req)
 }
 
+// UpsertProductAttributeDefinition calls StoreService.UpsertProductAttributeDefinition.
+func (c *storeServiceClient) UpsertProductAttributeDefinition(ctx context.Context, req *connect.Request[store.UpsertProductAttributeDefinitionRequest]) (*connect.Response[store.ProductAttributeDefinitionResponse], error) {
+	return c.upsertProductAttributeDefinition.CallUnary(ctx, req)
+}
+
+// ListProductAttributeDefinitions calls StoreService.ListProductAttributeDefinitions.
+func (c *storeServiceClient) ListProductAttributeDefinitions(ctx context.Context, req *connect.Request[store.ListProductAttributeDefinitionsRequest]) (*connect.Response[store.ListProductAttributeDefinitionsResponse], error) {
+	return c.listProductAttributeDefinitions.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls StoreService.ListAuditEvents.
+func (c *storeServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[store.ListAuditEventsRequest]) (*connect.Response[store.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// WatchStore calls StoreService.WatchStore.
+func (c *storeServiceClient) WatchStore(ctx context.Context, req *connect.Request[store.WatchStoreRequest]) (*connect.ServerStreamForClient[store.StoreEvent], error) {
+	return c.watchStore.CallServerStream(ctx, req)
+}
+
+// WatchProducts calls StoreService.WatchProducts.
+func (c *storeServiceClient) WatchProducts(ctx context.Context, req *connect.Request[store.WatchProductsRequest]) (*connect.ServerStreamForClient[store.StoreEvent], error) {
+	return c.watchProducts.CallServerStream(ctx, req)
+}
+
+// CreateWebhookSubscription calls StoreService.CreateWebhookSubscription.
+func (c *storeServiceClient) CreateWebhookSubscription(ctx context.Context, req *connect.Request[store.CreateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error) {
+	return c.createWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookSubscriptions calls StoreService.ListWebhookSubscriptions.
+func (c *storeServiceClient) ListWebhookSubscriptions(ctx context.Context, req *connect.Request[store.ListWebhookSubscriptionsRequest]) (*connect.Response[store.ListWebhookSubscriptionsResponse], error) {
+	return c.listWebhookSubscriptions.CallUnary(ctx, req)
+}
+
+// UpdateWebhookSubscription calls StoreService.UpdateWebhookSubscription.
+func (c *storeServiceClient) UpdateWebhookSubscription(ctx context.Context, req *connect.Request[store.UpdateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error) {
+	return c.updateWebhookSubscription.CallUnary(ctx, req)
+}
+
+// DeleteWebhookSubscription calls StoreService.DeleteWebhookSubscription.
+func (c *storeServiceClient) DeleteWebhookSubscription(ctx context.Context, req *connect.Request[store.DeleteWebhookSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls StoreService.ListWebhookDeliveries.
+func (c *storeServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[store.ListWebhookDeliveriesRequest]) (*connect.Response[store.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ReplayWebhookDelivery calls StoreService.ReplayWebhookDelivery.
+func (c *storeServiceClient) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[store.ReplayWebhookDeliveryRequest]) (*connect.Response[store.WebhookDeliveryResponse], error) {
+	return c.replayWebhookDelivery.CallUnary(ctx, req)
+}
+
+// CreateProductDiscount calls StoreService.CreateProductDiscount.
+func (c *storeServiceClient) CreateProductDiscount(ctx context.Context, req *connect.Request[store.CreateProductDiscountRequest]) (*connect.Response[store.ProductDiscountResponse], error) {
+	return c.createProductDiscount.CallUnary(ctx, req)
+}
+
+// ListProductDiscounts calls StoreService.ListProductDiscounts.
+func (c *storeServiceClient) ListProductDiscounts(ctx context.Context, req *connect.Request[store.ListProductDiscountsRequest]) (*connect.Response[store.ListProductDiscountsResponse], error) {
+	return c.listProductDiscounts.CallUnary(ctx, req)
+}
+
+// DeleteProductDiscount calls StoreService.DeleteProductDiscount.
+func (c *storeServiceClient) DeleteProductDiscount(ctx context.Context, req *connect.Request[store.DeleteProductDiscountRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteProductDiscount.CallUnary(ctx, req)
+}
+
+// ListProductPriceHistory calls StoreService.ListProductPriceHistory.
+func (c *storeServiceClient) ListProductPriceHistory(ctx context.Context, req *connect.Request[store.ListProductPriceHistoryRequest]) (*connect.Response[store.ListProductPriceHistoryResponse], error) {
+	return c.listProductPriceHistory.CallUnary(ctx, req)
+}
+
+// CreateProductLot calls StoreService.CreateProductLot.
+func (c *storeServiceClient) CreateProductLot(ctx context.Context, req *connect.Request[store.CreateProductLotRequest]) (*connect.Response[store.ProductLotResponse], error) {
+	return c.createProductLot.CallUnary(ctx, req)
+}
+
+// ListProductLots calls StoreService.ListProductLots.
+func (c *storeServiceClient) ListProductLots(ctx context.Context, req *connect.Request[store.ListProductLotsRequest]) (*connect.Response[store.ListProductLotsResponse], error) {
+	return c.listProductLots.CallUnary(ctx, req)
+}
+
+// GetExpiringProducts calls StoreService.GetExpiringProducts.
+func (c *storeServiceClient) GetExpiringProducts(ctx context.Context, req *connect.Request[store.GetExpiringProductsRequest]) (*connect.Response[store.ListProductLotsResponse], error) {
+	return c.getExpiringProducts.CallUnary(ctx, req)
+}
+
+// UpsertTranslations calls StoreService.UpsertTranslations.
+func (c *storeServiceClient) UpsertTranslations(ctx context.Context, req *connect.Request[store.UpsertTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error) {
+	return c.upsertTranslations.CallUnary(ctx, req)
+}
+
+// GetTranslations calls StoreService.GetTranslations.
+func (c *storeServiceClient) GetTranslations(ctx context.Context, req *connect.Request[store.GetTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error) {
+	return c.getTranslations.CallUnary(ctx, req)
+}
+
 // StoreServiceHandler is an implementation of the StoreService service.
 type StoreServiceHandler interface {
 	// Create a new store
 	CreateStore(context.Context, *connect.Request[store.CreateStoreRequest]) (*connect.Response[store.CreateStoreResponse], error)
 	// Get a store by ID
 	GetStore(context.Context, *connect.Request[store.GetStoreRequest]) (*connect.Response[store.GetStoreResponse], error)
+	// Get many stores by ID, the results follow the order of the requested ids
+	BatchGetStores(context.Context, *connect.Request[store.BatchGetStoresRequest]) (*connect.Response[store.BatchGetStoresResponse], error)
 	// Update an existing store
 	UpdateStore(context.Context, *connect.Request[store.UpdateStoreRequest]) (*connect.Response[store.UpdateStoreResponse], error)
 	// Delete a store by ID
@@ -424,6 +887,9 @@ type StoreServiceHandler interface {
 	// Open close store
 	OpenCloseStore(context.Context, *connect.Request[store.OpenCloseStoreRequest]) (*connect.Response[store.OpenCloseStoreResponse], error)
 	GetProductById(context.Context, *connect.Request[store.GetProductByIdRequest]) (*connect.Response[store.GetProductByIdResponse], error)
+	// Get many products by ID, the results follow the order of the requested ids
+	GetProductByBarcode(context.Context, *connect.Request[store.GetProductByBarcodeRequest]) (*connect.Response[store.GetProductByIdResponse], error)
+	BatchGetProducts(context.Context, *connect.Request[store.BatchGetProductsRequest]) (*connect.Response[store.BatchGetProductsResponse], error)
 	GetProductList(context.Context, *connect.Request[store.GetProductListRequest]) (*connect.Response[store.GetProductListResponse], error)
 	InsertProducts(context.Context, *connect.Request[store.InsertProductsRequest]) (*connect.Response[store.GenericResponse], error)
 	UpdateProduct(context.Context, *connect.Request[store.UpdateProductRequest]) (*connect.Response[store.GenericResponse], error)
@@ -434,8 +900,49 @@ type StoreServiceHandler interface {
 	GetProductCategories(context.Context, *connect.Request[store.GetProductCategoriesRequest]) (*connect.Response[store.GetProductCategoriesResponse], error)
 	UpsertProductCategory(context.Context, *connect.Request[store.UpsertProductCategoryRequest]) (*connect.Response[store.UpsertProductCategoryResponse], error)
 	UpdateProductCategory(context.Context, *connect.Request[store.UpsertProductCategoryRequest]) (*connect.Response[store.UpsertProductCategoryResponse], error)
+	// Get the categories as a tree, below root_id or from the root categories when root_id is empty
+	GetCategoryTree(context.Context, *connect.Request[store.GetCategoryTreeRequest]) (*connect.Response[store.GetCategoryTreeResponse], error)
+	// Move a category with its subcategories below another parent, or to the root when parent_id is empty
+	MoveProductCategory(context.Context, *connect.Request[store.MoveProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error)
+	RenameProductCategory(context.Context, *connect.Request[store.RenameProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error)
 	GetProductTypes(context.Context, *connect.Request[store.GetProductTypesRequest]) (*connect.Response[store.GetProductTypesResponse], error)
 	UpsertProductType(context.Context, *connect.Request[store.UpsertProductTypeRequest]) (*connect.Response[store.UpsertProductTypeResponse], error)
+	// Define an attribute the products of a type can record, the attribute is updated when its id is set
+	UpsertProductAttributeDefinition(context.Context, *connect.Request[store.UpsertProductAttributeDefinitionRequest]) (*connect.Response[store.ProductAttributeDefinitionResponse], error)
+	ListProductAttributeDefinitions(context.Context, *connect.Request[store.ListProductAttributeDefinitionsRequest]) (*connect.Response[store.ListProductAttributeDefinitionsResponse], error)
+	// List the audit events of changes, only for admins
+	ListAuditEvents(context.Context, *connect.Request[store.ListAuditEventsRequest]) (*connect.Response[store.ListAuditEventsResponse], error)
+	// Stream the changes of a store status and profile, the stream is resumed after after_sequence
+	WatchStore(context.Context, *connect.Request[store.WatchStoreRequest], *connect.ServerStream[store.StoreEvent]) error
+	// Stream the product upserts, deletes and stock changes of a store, the stream is resumed after after_sequence
+	WatchProducts(context.Context, *connect.Request[store.WatchProductsRequest], *connect.ServerStream[store.StoreEvent]) error
+	// Register a webhook receiving the events of a store
+	CreateWebhookSubscription(context.Context, *connect.Request[store.CreateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error)
+	ListWebhookSubscriptions(context.Context, *connect.Request[store.ListWebhookSubscriptionsRequest]) (*connect.Response[store.ListWebhookSubscriptionsResponse], error)
+	// Update a webhook, setting is_active re-enables a disabled webhook
+	UpdateWebhookSubscription(context.Context, *connect.Request[store.UpdateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error)
+	DeleteWebhookSubscription(context.Context, *connect.Request[store.DeleteWebhookSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// List the deliveries of a webhook, the latest first
+	ListWebhookDeliveries(context.Context, *connect.Request[store.ListWebhookDeliveriesRequest]) (*connect.Response[store.ListWebhookDeliveriesResponse], error)
+	// Deliver an event to a webhook again
+	ReplayWebhookDelivery(context.Context, *connect.Request[store.ReplayWebhookDeliveryRequest]) (*connect.Response[store.WebhookDeliveryResponse], error)
+	// Schedule a discount of a product
+	CreateProductDiscount(context.Context, *connect.Request[store.CreateProductDiscountRequest]) (*connect.Response[store.ProductDiscountResponse], error)
+	// List the discounts of a product, the latest first
+	ListProductDiscounts(context.Context, *connect.Request[store.ListProductDiscountsRequest]) (*connect.Response[store.ListProductDiscountsResponse], error)
+	// Remove a discount of a product
+	DeleteProductDiscount(context.Context, *connect.Request[store.DeleteProductDiscountRequest]) (*connect.Response[emptypb.Empty], error)
+	// List the price changes of a product, the latest first
+	ListProductPriceHistory(context.Context, *connect.Request[store.ListProductPriceHistoryRequest]) (*connect.Response[store.ListProductPriceHistoryResponse], error)
+	// Record a lot of a product, its quantity is added to the stock of the product
+	CreateProductLot(context.Context, *connect.Request[store.CreateProductLotRequest]) (*connect.Response[store.ProductLotResponse], error)
+	// List the lots of a product, the first to expire first
+	ListProductLots(context.Context, *connect.Request[store.ListProductLotsRequest]) (*connect.Response[store.ListProductLotsResponse], error)
+	// List the lots of a store with stock left which expire within days, or have expired already
+	GetExpiringProducts(context.Context, *connect.Request[store.GetExpiringProductsRequest]) (*connect.Response[store.ListProductLotsResponse], error)
+	// Set the names of a category, product type or unit of measure in other locales
+	UpsertTranslations(context.Context, *connect.Request[store.UpsertTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error)
+	GetTranslations(context.Context, *connect.Request[store.GetTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error)
 }
 
 // NewStoreServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -456,6 +963,12 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(storeServiceGetStoreMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeServiceBatchGetStoresHandler := connect.NewUnaryHandler(
+		StoreServiceBatchGetStoresProcedure,
+		svc.BatchGetStores,
+		connect.WithSchema(storeServiceBatchGetStoresMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	storeServiceUpdateStoreHandler := connect.NewUnaryHandler(
 		StoreServiceUpdateStoreProcedure,
 		svc.UpdateStore,
@@ -492,6 +1005,18 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(storeServiceGetProductByIdMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeServiceGetProductByBarcodeHandler := connect.NewUnaryHandler(
+		StoreServiceGetProductByBarcodeProcedure,
+		svc.GetProductByBarcode,
+		connect.WithSchema(storeServiceGetProductByBarcodeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceBatchGetProductsHandler := connect.NewUnaryHandler(
+		StoreServiceBatchGetProductsProcedure,
+		svc.BatchGetProducts,
+		connect.WithSchema(storeServiceBatchGetProductsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	storeServiceGetProductListHandler := connect.NewUnaryHandler(
 		StoreServiceGetProductListProcedure,
 		svc.GetProductList,
@@ -552,6 +1077,24 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(storeServiceUpdateProductCategoryMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeServiceGetCategoryTreeHandler := connect.NewUnaryHandler(
+		StoreServiceGetCategoryTreeProcedure,
+		svc.GetCategoryTree,
+		connect.WithSchema(storeServiceGetCategoryTreeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceMoveProductCategoryHandler := connect.NewUnaryHandler(
+		StoreServiceMoveProductCategoryProcedure,
+		svc.MoveProductCategory,
+		connect.WithSchema(storeServiceMoveProductCategoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceRenameProductCategoryHandler := connect.NewUnaryHandler(
+		StoreServiceRenameProductCategoryProcedure,
+		svc.RenameProductCategory,
+		connect.WithSchema(storeServiceRenameProductCategoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	storeServiceGetProductTypesHandler := connect.NewUnaryHandler(
 		StoreServiceGetProductTypesProcedure,
 		svc.GetProductTypes,
@@ -564,12 +1107,134 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(storeServiceUpsertProductTypeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeServiceUpsertProductAttributeDefinitionHandler := connect.NewUnaryHandler(
+		StoreServiceUpsertProductAttributeDefinitionProcedure,
+		svc.UpsertProductAttributeDefinition,
+		connect.WithSchema(storeServiceUpsertProductAttributeDefinitionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListProductAttributeDefinitionsHandler := connect.NewUnaryHandler(
+		StoreServiceListProductAttributeDefinitionsProcedure,
+		svc.ListProductAttributeDefinitions,
+		connect.WithSchema(storeServiceListProductAttributeDefinitionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		StoreServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(storeServiceListAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceWatchStoreHandler := connect.NewServerStreamHandler(
+		StoreServiceWatchStoreProcedure,
+		svc.WatchStore,
+		connect.WithSchema(storeServiceWatchStoreMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceWatchProductsHandler := connect.NewServerStreamHandler(
+		StoreServiceWatchProductsProcedure,
+		svc.WatchProducts,
+		connect.WithSchema(storeServiceWatchProductsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceCreateWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		StoreServiceCreateWebhookSubscriptionProcedure,
+		svc.CreateWebhookSubscription,
+		connect.WithSchema(storeServiceCreateWebhookSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListWebhookSubscriptionsHandler := connect.NewUnaryHandler(
+		StoreServiceListWebhookSubscriptionsProcedure,
+		svc.ListWebhookSubscriptions,
+		connect.WithSchema(storeServiceListWebhookSubscriptionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceUpdateWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		StoreServiceUpdateWebhookSubscriptionProcedure,
+		svc.UpdateWebhookSubscription,
+		connect.WithSchema(storeServiceUpdateWebhookSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceDeleteWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		StoreServiceDeleteWebhookSubscriptionProcedure,
+		svc.DeleteWebhookSubscription,
+		connect.WithSchema(storeServiceDeleteWebhookSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		StoreServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(storeServiceListWebhookDeliveriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceReplayWebhookDeliveryHandler := connect.NewUnaryHandler(
+		StoreServiceReplayWebhookDeliveryProcedure,
+		svc.ReplayWebhookDelivery,
+		connect.WithSchema(storeServiceReplayWebhookDeliveryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceCreateProductDiscountHandler := connect.NewUnaryHandler(
+		StoreServiceCreateProductDiscountProcedure,
+		svc.CreateProductDiscount,
+		connect.WithSchema(storeServiceCreateProductDiscountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListProductDiscountsHandler := connect.NewUnaryHandler(
+		StoreServiceListProductDiscountsProcedure,
+		svc.ListProductDiscounts,
+		connect.WithSchema(storeServiceListProductDiscountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceDeleteProductDiscountHandler := connect.NewUnaryHandler(
+		StoreServiceDeleteProductDiscountProcedure,
+		svc.DeleteProductDiscount,
+		connect.WithSchema(storeServiceDeleteProductDiscountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListProductPriceHistoryHandler := connect.NewUnaryHandler(
+		StoreServiceListProductPriceHistoryProcedure,
+		svc.ListProductPriceHistory,
+		connect.WithSchema(storeServiceListProductPriceHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceCreateProductLotHandler := connect.NewUnaryHandler(
+		StoreServiceCreateProductLotProcedure,
+		svc.CreateProductLot,
+		connect.WithSchema(storeServiceCreateProductLotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceListProductLotsHandler := connect.NewUnaryHandler(
+		StoreServiceListProductLotsProcedure,
+		svc.ListProductLots,
+		connect.WithSchema(storeServiceListProductLotsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetExpiringProductsHandler := connect.NewUnaryHandler(
+		StoreServiceGetExpiringProductsProcedure,
+		svc.GetExpiringProducts,
+		connect.WithSchema(storeServiceGetExpiringProductsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceUpsertTranslationsHandler := connect.NewUnaryHandler(
+		StoreServiceUpsertTranslationsProcedure,
+		svc.UpsertTranslations,
+		connect.WithSchema(storeServiceUpsertTranslationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetTranslationsHandler := connect.NewUnaryHandler(
+		StoreServiceGetTranslationsProcedure,
+		svc.GetTranslations,
+		connect.WithSchema(storeServiceGetTranslationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/StoreService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StoreServiceCreateStoreProcedure:
 			storeServiceCreateStoreHandler.ServeHTTP(w, r)
 		case StoreServiceGetStoreProcedure:
 			storeServiceGetStoreHandler.ServeHTTP(w, r)
+		case StoreServiceBatchGetStoresProcedure:
+			storeServiceBatchGetStoresHandler.ServeHTTP(w, r)
 		case StoreServiceUpdateStoreProcedure:
 			storeServiceUpdateStoreHandler.ServeHTTP(w, r)
 		case StoreServiceDeleteStoreProcedure:
@@ -582,6 +1247,10 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 			storeServiceOpenCloseStoreHandler.ServeHTTP(w, r)
 		case StoreServiceGetProductByIdProcedure:
 			storeServiceGetProductByIdHandler.ServeHTTP(w, r)
+		case StoreServiceGetProductByBarcodeProcedure:
+			storeServiceGetProductByBarcodeHandler.ServeHTTP(w, r)
+		case StoreServiceBatchGetProductsProcedure:
+			storeServiceBatchGetProductsHandler.ServeHTTP(w, r)
 		case StoreServiceGetProductListProcedure:
 			storeServiceGetProductListHandler.ServeHTTP(w, r)
 		case StoreServiceInsertProductsProcedure:
@@ -602,10 +1271,56 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 			storeServiceUpsertProductCategoryHandler.ServeHTTP(w, r)
 		case StoreServiceUpdateProductCategoryProcedure:
 			storeServiceUpdateProductCategoryHandler.ServeHTTP(w, r)
+		case StoreServiceGetCategoryTreeProcedure:
+			storeServiceGetCategoryTreeHandler.ServeHTTP(w, r)
+		case StoreServiceMoveProductCategoryProcedure:
+			storeServiceMoveProductCategoryHandler.ServeHTTP(w, r)
+		case StoreServiceRenameProductCategoryProcedure:
+			storeServiceRenameProductCategoryHandler.ServeHTTP(w, r)
 		case StoreServiceGetProductTypesProcedure:
 			storeServiceGetProductTypesHandler.ServeHTTP(w, r)
 		case StoreServiceUpsertProductTypeProcedure:
 			storeServiceUpsertProductTypeHandler.ServeHTTP(w, r)
+		case StoreServiceUpsertProductAttributeDefinitionProcedure:
+			storeServiceUpsertProductAttributeDefinitionHandler.ServeHTTP(w, r)
+		case StoreServiceListProductAttributeDefinitionsProcedure:
+			storeServiceListProductAttributeDefinitionsHandler.ServeHTTP(w, r)
+		case StoreServiceListAuditEventsProcedure:
+			storeServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case StoreServiceWatchStoreProcedure:
+			storeServiceWatchStoreHandler.ServeHTTP(w, r)
+		case StoreServiceWatchProductsProcedure:
+			storeServiceWatchProductsHandler.ServeHTTP(w, r)
+		case StoreServiceCreateWebhookSubscriptionProcedure:
+			storeServiceCreateWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case StoreServiceListWebhookSubscriptionsProcedure:
+			storeServiceListWebhookSubscriptionsHandler.ServeHTTP(w, r)
+		case StoreServiceUpdateWebhookSubscriptionProcedure:
+			storeServiceUpdateWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case StoreServiceDeleteWebhookSubscriptionProcedure:
+			storeServiceDeleteWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case StoreServiceListWebhookDeliveriesProcedure:
+			storeServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case StoreServiceReplayWebhookDeliveryProcedure:
+			storeServiceReplayWebhookDeliveryHandler.ServeHTTP(w, r)
+		case StoreServiceCreateProductDiscountProcedure:
+			storeServiceCreateProductDiscountHandler.ServeHTTP(w, r)
+		case StoreServiceListProductDiscountsProcedure:
+			storeServiceListProductDiscountsHandler.ServeHTTP(w, r)
+		case StoreServiceDeleteProductDiscountProcedure:
+			storeServiceDeleteProductDiscountHandler.ServeHTTP(w, r)
+		case StoreServiceListProductPriceHistoryProcedure:
+			storeServiceListProductPriceHistoryHandler.ServeHTTP(w, r)
+		case StoreServiceCreateProductLotProcedure:
+			storeServiceCreateProductLotHandler.ServeHTTP(w, r)
+		case StoreServiceListProductLotsProcedure:
+			storeServiceListProductLotsHandler.ServeHTTP(w, r)
+		case StoreServiceGetExpiringProductsProcedure:
+			storeServiceGetExpiringProductsHandler.ServeHTTP(w, r)
+		case StoreServiceUpsertTranslationsProcedure:
+			storeServiceUpsertTranslationsHandler.ServeHTTP(w, r)
+		case StoreServiceGetTranslationsProcedure:
+			storeServiceGetTranslationsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -623,6 +1338,10 @@ func (UnimplementedStoreServiceHandler) GetStore(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetStore is not implemented"))
 }
 
+func (UnimplementedStoreServiceHandler) BatchGetStores(context.Context, *connect.Request[store.BatchGetStoresRequest]) (*connect.Response[store.BatchGetStoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.BatchGetStores is not implemented"))
+}
+
 func (UnimplementedStoreServiceHandler) UpdateStore(context.Context, *connect.Request[store.UpdateStoreRequest]) (*connect.Response[store.UpdateStoreResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpdateStore is not implemented"))
 }
@@ -647,6 +1366,14 @@ func (UnimplementedStoreServiceHandler) GetProductById(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetProductById is not implemented"))
 }
 
+func (UnimplementedStoreServiceHandler) GetProductByBarcode(context.Context, *connect.Request[store.GetProductByBarcodeRequest]) (*connect.Response[store.GetProductByIdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetProductByBarcode is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) BatchGetProducts(context.Context, *connect.Request[store.BatchGetProductsRequest]) (*connect.Response[store.BatchGetProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.BatchGetProducts is not implemented"))
+}
+
 func (UnimplementedStoreServiceHandler) GetProductList(context.Context, *connect.Request[store.GetProductListRequest]) (*connect.Response[store.GetProductListResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetProductList is not implemented"))
 }
@@ -687,6 +1414,18 @@ func (UnimplementedStoreServiceHandler) UpdateProductCategory(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpdateProductCategory is not implemented"))
 }
 
+func (UnimplementedStoreServiceHandler) GetCategoryTree(context.Context, *connect.Request[store.GetCategoryTreeRequest]) (*connect.Response[store.GetCategoryTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetCategoryTree is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) MoveProductCategory(context.Context, *connect.Request[store.MoveProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.MoveProductCategory is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) RenameProductCategory(context.Context, *connect.Request[store.RenameProductCategoryRequest]) (*connect.Response[store.ProductCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.RenameProductCategory is not implemented"))
+}
+
 func (UnimplementedStoreServiceHandler) GetProductTypes(context.Context, *connect.Request[store.GetProductTypesRequest]) (*connect.Response[store.GetProductTypesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetProductTypes is not implemented"))
 }
@@ -694,3 +1433,83 @@ func (UnimplementedStoreServiceHandler) GetProductTypes(context.Context, *connec
 func (UnimplementedStoreServiceHandler) UpsertProductType(context.Context, *connect.Request[store.UpsertProductTypeRequest]) (*connect.Response[store.UpsertProductTypeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpsertProductType is not implemented"))
 }
+
+func (UnimplementedStoreServiceHandler) UpsertProductAttributeDefinition(context.Context, *connect.Request[store.UpsertProductAttributeDefinitionRequest]) (*connect.Response[store.ProductAttributeDefinitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpsertProductAttributeDefinition is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListProductAttributeDefinitions(context.Context, *connect.Request[store.ListProductAttributeDefinitionsRequest]) (*connect.Response[store.ListProductAttributeDefinitionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListProductAttributeDefinitions is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListAuditEvents(context.Context, *connect.Request[store.ListAuditEventsRequest]) (*connect.Response[store.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) WatchStore(context.Context, *connect.Request[store.WatchStoreRequest], *connect.ServerStream[store.StoreEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.WatchStore is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) WatchProducts(context.Context, *connect.Request[store.WatchProductsRequest], *connect.ServerStream[store.StoreEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.WatchProducts is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) CreateWebhookSubscription(context.Context, *connect.Request[store.CreateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.CreateWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListWebhookSubscriptions(context.Context, *connect.Request[store.ListWebhookSubscriptionsRequest]) (*connect.Response[store.ListWebhookSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListWebhookSubscriptions is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) UpdateWebhookSubscription(context.Context, *connect.Request[store.UpdateWebhookSubscriptionRequest]) (*connect.Response[store.WebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpdateWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) DeleteWebhookSubscription(context.Context, *connect.Request[store.DeleteWebhookSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.DeleteWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[store.ListWebhookDeliveriesRequest]) (*connect.Response[store.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ReplayWebhookDelivery(context.Context, *connect.Request[store.ReplayWebhookDeliveryRequest]) (*connect.Response[store.WebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ReplayWebhookDelivery is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) CreateProductDiscount(context.Context, *connect.Request[store.CreateProductDiscountRequest]) (*connect.Response[store.ProductDiscountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.CreateProductDiscount is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListProductDiscounts(context.Context, *connect.Request[store.ListProductDiscountsRequest]) (*connect.Response[store.ListProductDiscountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListProductDiscounts is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) DeleteProductDiscount(context.Context, *connect.Request[store.DeleteProductDiscountRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.DeleteProductDiscount is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListProductPriceHistory(context.Context, *connect.Request[store.ListProductPriceHistoryRequest]) (*connect.Response[store.ListProductPriceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListProductPriceHistory is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) CreateProductLot(context.Context, *connect.Request[store.CreateProductLotRequest]) (*connect.Response[store.ProductLotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.CreateProductLot is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) ListProductLots(context.Context, *connect.Request[store.ListProductLotsRequest]) (*connect.Response[store.ListProductLotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.ListProductLots is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetExpiringProducts(context.Context, *connect.Request[store.GetExpiringProductsRequest]) (*connect.Response[store.ListProductLotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetExpiringProducts is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) UpsertTranslations(context.Context, *connect.Request[store.UpsertTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.UpsertTranslations is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetTranslations(context.Context, *connect.Request[store.GetTranslationsRequest]) (*connect.Response[store.TranslationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("StoreService.GetTranslations is not implemented"))
+}
@@ -5,10 +5,10 @@ import (
 
 	"github.com/Mitra-Apps/be-store-service/domain/base_model"
 	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // Store represents a store model.
@@ -28,6 +28,7 @@ type Store struct {
 	LocationLng      float64
 	Status           string
 	IsActive         bool
+	Version          int64                 `gorm:"type:bigint;not null;default:1"`
 	Tags             []*StoreTag           `gorm:"many2many:store_store_tags"`
 	Hours            []*StoreHour          `gorm:"foreignKey:StoreID"`
 	Images           []*StoreImage         `gorm:"foreignKey:StoreID"`
@@ -55,6 +56,14 @@ func (s *Store) ToProto() *pb.Store {
 		images = append(images, image.ToProto())
 	}
 
+	// sort images primary first, then by sort order ascending
+	sort.SliceStable(images, func(i, j int) bool {
+		if images[i].IsPrimary != images[j].IsPrimary {
+			return images[i].IsPrimary
+		}
+		return images[i].SortOrder < images[j].SortOrder
+	})
+
 	return &pb.Store{
 		Id:               s.ID.String(),
 		UserId:           s.UserID.String(),
@@ -74,6 +83,7 @@ func (s *Store) ToProto() *pb.Store {
 		Tags:             tags,
 		Hours:            hours,
 		Images:           images,
+		Version:          s.Version,
 	}
 }
 
@@ -81,7 +91,7 @@ func (s *Store) FromProto(store *pb.Store) error {
 	if store.Id != "" {
 		id, err := uuid.Parse(store.Id)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid store id")
+			return storeerror.InvalidID("store.id")
 		}
 		s.ID = id
 	}
@@ -91,7 +101,7 @@ func (s *Store) FromProto(store *pb.Store) error {
 	if store.UserId != "" {
 		id, err := uuid.Parse(store.UserId)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid user id")
+			return storeerror.InvalidID("store.user_id")
 		}
 		userID = id
 	}
@@ -117,6 +127,7 @@ func (s *Store) FromProto(store *pb.Store) error {
 	s.LocationLng = store.LocationLng
 	s.Status = store.Status
 	s.IsActive = store.IsActive
+	s.Version = store.Version
 
 	for _, tag := range store.Tags {
 		storeTag := &StoreTag{}
@@ -145,12 +156,96 @@ func (s *Store) FromProto(store *pb.Store) error {
 	return nil
 }
 
+// storeMaskFields copies a store field which can be listed in an update mask, keyed by its proto name.
+var storeMaskFields = map[string]func(dst, src *Store){
+	"user_id":           func(dst, src *Store) { dst.UserID = src.UserID },
+	"store_name":        func(dst, src *Store) { dst.StoreName = src.StoreName },
+	"store_description": func(dst, src *Store) { dst.StoreDescription = src.StoreDescription },
+	"address":           func(dst, src *Store) { dst.Address = src.Address },
+	"city":              func(dst, src *Store) { dst.City = src.City },
+	"state":             func(dst, src *Store) { dst.State = src.State },
+	"zip_code":          func(dst, src *Store) { dst.ZipCode = src.ZipCode },
+	"phone":             func(dst, src *Store) { dst.Phone = src.Phone },
+	"email":             func(dst, src *Store) { dst.Email = src.Email },
+	"website":           func(dst, src *Store) { dst.Website = src.Website },
+	"status":            func(dst, src *Store) { dst.Status = src.Status },
+	"is_active":         func(dst, src *Store) { dst.IsActive = src.IsActive },
+	"location_lat":      func(dst, src *Store) { dst.LocationLat = src.LocationLat },
+	"location_lng":      func(dst, src *Store) { dst.LocationLng = src.LocationLng },
+	"tags":              func(dst, src *Store) { dst.Tags = src.Tags },
+	"hours":             func(dst, src *Store) { dst.Hours = src.Hours },
+	"images":            func(dst, src *Store) { dst.Images = src.Images },
+}
+
+// ApplyFieldMask keeps the stored value of every field which is not listed in paths,
+// so the store can be saved as a partial update.
+func (s *Store) ApplyFieldMask(stored *Store, paths []string) error {
+	listed := make(map[string]bool)
+	for _, p := range paths {
+		if storeMaskFields[p] == nil {
+			return storeerror.Invalid("update_mask", errPb.StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED, p)
+		}
+		listed[p] = true
+	}
+	for name, copyField := range storeMaskFields {
+		if !listed[name] {
+			copyField(s, stored)
+		}
+	}
+	return nil
+}
+
+// NormalizeImages orders the store images by their sort order and makes sure exactly one image is primary.
+// When no sort order is given the images keep the order in which they were sent.
+func (s *Store) NormalizeImages() {
+	images := []*StoreImage{}
+	for _, i := range s.Images {
+		if i != nil {
+			images = append(images, i)
+		}
+	}
+	s.Images = images
+	if len(s.Images) == 0 {
+		return
+	}
+
+	hasSortOrder := false
+	for _, i := range s.Images {
+		if i.SortOrder != 0 {
+			hasSortOrder = true
+			break
+		}
+	}
+	if !hasSortOrder {
+		for idx, i := range s.Images {
+			i.SortOrder = int32(idx)
+		}
+	}
+
+	sort.SliceStable(s.Images, func(i, j int) bool {
+		return s.Images[i].SortOrder < s.Images[j].SortOrder
+	})
+
+	primaryIdx := 0
+	for idx, i := range s.Images {
+		if i.IsPrimary {
+			primaryIdx = idx
+			break
+		}
+	}
+	for idx, i := range s.Images {
+		i.IsPrimary = idx == primaryIdx
+	}
+}
+
 // StoreImage represents an image associated with a store.
 type StoreImage struct {
 	base_model.BaseModel
 	StoreID     uuid.UUID `gorm:"type:uuid;index;not null"`
 	ImageType   string    `gorm:"not null"`
 	ImageURL    string    `gorm:"not null"`
+	SortOrder   int32     `gorm:"type:int;not null;default:0"`
+	IsPrimary   bool      `gorm:"type:bool;not null;default:false"`
 	ImageBase64 string    `gorm:"-"`
 }
 
@@ -161,6 +256,8 @@ func (s *StoreImage) ToProto() *pb.StoreImage {
 		ImageType:   s.ImageType,
 		ImageUrl:    s.ImageURL,
 		ImageBase64: "",
+		SortOrder:   s.SortOrder,
+		IsPrimary:   s.IsPrimary,
 	}
 }
 
@@ -184,6 +281,8 @@ func (s *StoreImage) FromProto(storeImage *pb.StoreImage) error {
 	s.ImageType = storeImage.ImageType
 	s.ImageURL = storeImage.ImageUrl
 	s.ImageBase64 = storeImage.ImageBase64
+	s.SortOrder = storeImage.SortOrder
+	s.IsPrimary = storeImage.IsPrimary
 
 	return nil
 }
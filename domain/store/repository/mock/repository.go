@@ -100,6 +100,21 @@ func (mr *MockStoreServiceRepositoryMockRecorder) GetStoreByUserID(ctx, userID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreByUserID", reflect.TypeOf((*MockStoreServiceRepository)(nil).GetStoreByUserID), ctx, userID)
 }
 
+// GetStoresByIds mocks base method.
+func (m *MockStoreServiceRepository) GetStoresByIds(ctx context.Context, storeIDs []uuid.UUID) ([]*entity.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoresByIds", ctx, storeIDs)
+	ret0, _ := ret[0].([]*entity.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoresByIds indicates an expected call of GetStoresByIds.
+func (mr *MockStoreServiceRepositoryMockRecorder) GetStoresByIds(ctx, storeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoresByIds", reflect.TypeOf((*MockStoreServiceRepository)(nil).GetStoresByIds), ctx, storeIDs)
+}
+
 // ListStores mocks base method.
 func (m *MockStoreServiceRepository) ListStores(ctx context.Context, page, pageSize int) ([]*entity.Store, error) {
 	m.ctrl.T.Helper()
@@ -130,18 +145,18 @@ func (mr *MockStoreServiceRepositoryMockRecorder) OpenCloseStore(ctx, storeID, i
 }
 
 // UpdateStore mocks base method.
-func (m *MockStoreServiceRepository) UpdateStore(ctx context.Context, update *entity.Store) (*entity.Store, error) {
+func (m *MockStoreServiceRepository) UpdateStore(ctx context.Context, update *entity.Store, paths []string) (*entity.Store, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStore", ctx, update)
+	ret := m.ctrl.Call(m, "UpdateStore", ctx, update, paths)
 	ret0, _ := ret[0].(*entity.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStore indicates an expected call of UpdateStore.
-func (mr *MockStoreServiceRepositoryMockRecorder) UpdateStore(ctx, update any) *gomock.Call {
+func (mr *MockStoreServiceRepositoryMockRecorder) UpdateStore(ctx, update, paths any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStore", reflect.TypeOf((*MockStoreServiceRepository)(nil).UpdateStore), ctx, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStore", reflect.TypeOf((*MockStoreServiceRepository)(nil).UpdateStore), ctx, update, paths)
 }
 
 // MockStorage is a mock of Storage interface.
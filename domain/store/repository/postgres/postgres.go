@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
 	"github.com/Mitra-Apps/be-store-service/domain/store/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/Mitra-Apps/be-store-service/lib"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"gorm.io/gorm"
 )
@@ -22,14 +25,10 @@ func NewPostgres(db *gorm.DB) repository.StoreServiceRepository {
 }
 
 func (p *postgres) CreateStore(ctx context.Context, store *entity.Store) (*entity.Store, error) {
-	tx := p.db.WithContext(ctx).Begin()
-
-	if err := tx.Save(store).Error; err != nil {
-		tx.Rollback()
-		return nil, err
-	}
-
-	if err := tx.Commit().Error; err != nil {
+	err := transaction.DB(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		return tx.Save(store).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -38,7 +37,7 @@ func (p *postgres) CreateStore(ctx context.Context, store *entity.Store) (*entit
 
 func (p *postgres) GetStore(ctx context.Context, storeID string) (*entity.Store, error) {
 	var store entity.Store
-	err := p.db.WithContext(ctx).
+	err := transaction.DB(ctx, p.db).
 		Model(&store).
 		Preload("Hours").
 		Preload("Images").
@@ -48,53 +47,85 @@ func (p *postgres) GetStore(ctx context.Context, storeID string) (*entity.Store,
 		Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "Store is not found")
+			return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)
 		}
-		return nil, status.Errorf(codes.Internal, "Error when getting store :"+err.Error())
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_STORE, err)
 	}
 	return &store, nil
 }
 
-func (p *postgres) UpdateStore(ctx context.Context, update *entity.Store) (*entity.Store, error) {
+func (p *postgres) GetStoresByIds(ctx context.Context, storeIDs []uuid.UUID) ([]*entity.Store, error) {
+	var stores []*entity.Store
+	if err := transaction.DB(ctx, p.db).
+		Preload("Hours").
+		Preload("Images").
+		Preload("Tags").
+		Where("id IN ?", storeIDs).
+		Find(&stores).Error; err != nil {
+		return nil, err
+	}
+	return stores, nil
+}
+
+func (p *postgres) UpdateStore(ctx context.Context, update *entity.Store, paths []string) (*entity.Store, error) {
 	if update.ID == uuid.Nil {
-		return nil, status.Errorf(codes.InvalidArgument, "store id is required")
-	}
-
-	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&entity.Store{}).Where("id = ?", update.ID.String()).Updates(map[string]interface{}{
-			"user_id":           update.UserID,
-			"store_name":        update.StoreName,
-			"store_description": update.StoreDescription,
-			"address":           update.Address,
-			"city":              update.City,
-			"state":             update.State,
-			"zip_code":          update.ZipCode,
-			"phone":             update.Phone,
-			"email":             update.Email,
-			"website":           update.Website,
-			"location_lat":      update.LocationLat,
-			"location_lng":      update.LocationLng,
-			"status":            update.Status,
-			"is_active":         update.IsActive,
-		}).Error
-		if err != nil {
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED)
+	}
+
+	// columns are named like the fields of the update mask
+	columns := map[string]interface{}{
+		"user_id":           update.UserID,
+		"store_name":        update.StoreName,
+		"store_description": update.StoreDescription,
+		"address":           update.Address,
+		"city":              update.City,
+		"state":             update.State,
+		"zip_code":          update.ZipCode,
+		"phone":             update.Phone,
+		"email":             update.Email,
+		"website":           update.Website,
+		"location_lat":      update.LocationLat,
+		"location_lng":      update.LocationLng,
+		"status":            update.Status,
+		"is_active":         update.IsActive,
+	}
+	for column := range columns {
+		if !lib.InFieldMask(paths, column) {
+			delete(columns, column)
+		}
+	}
+	columns["version"] = gorm.Expr("version + 1")
+
+	err := transaction.DB(ctx, p.db).Transaction(func(tx *gorm.DB) error {
+		// the store is only updated when nobody changed it since update.Version was read
+		res := tx.Model(&entity.Store{}).Where("id = ? AND version = ?", update.ID.String(), update.Version).Updates(columns)
+		if err := res.Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
-				return status.Errorf(codes.NotFound, "store with ID %s not found", update.ID.String())
+				return storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)
 			}
 
-			return status.Errorf(codes.Internal, "failed to update store: %v", err)
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_STORE, err)
+		}
+		if res.RowsAffected == 0 {
+			return repository.ErrVersionConflict
 		}
 
-		if err := p.updateStoreTags(ctx, tx, update.ID, update.Tags); err != nil {
-			return err
+		if lib.InFieldMask(paths, "tags") {
+			if err := p.updateStoreTags(ctx, tx, update.ID, update.Tags); err != nil {
+				return err
+			}
 		}
 
-		if err := p.updateStoreHours(ctx, tx, update.ID, update.Hours); err != nil {
-			return err
+		if lib.InFieldMask(paths, "hours") {
+			if err := p.updateStoreHours(ctx, tx, update.ID, update.Hours); err != nil {
+				return err
+			}
 		}
 
-		if err := p.updateStoreImages(ctx, tx, update.ID, update.Images); err != nil {
-			return err
+		if lib.InFieldMask(paths, "images") {
+			if err := p.updateStoreImages(ctx, tx, update.ID, update.Images); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -163,7 +194,7 @@ func (p *postgres) updateStoreImages(ctx context.Context, tx *gorm.DB, storeID u
 }
 
 func (p *postgres) DeleteStores(ctx context.Context, storeIds []string) error {
-	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return transaction.DB(ctx, p.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Exec("DELETE FROM store_store_tags WHERE store_id IN (?)", storeIds).Error; err != nil {
 			return err
 		}
@@ -187,14 +218,14 @@ func (p *postgres) DeleteStores(ctx context.Context, storeIds []string) error {
 func (p *postgres) ListStores(ctx context.Context, page, pageSize int) ([]*entity.Store, error) {
 	var stores []*entity.Store
 	offset := (page - 1) * pageSize
-	if err := p.db.WithContext(ctx).Preload("Hours").Preload("Images").Preload("Tags").Offset(offset).Limit(pageSize).Find(&stores).Error; err != nil {
+	if err := transaction.DB(ctx, p.db).Preload("Hours").Preload("Images").Preload("Tags").Offset(offset).Limit(pageSize).Find(&stores).Error; err != nil {
 		return nil, err
 	}
 	return stores, nil
 }
 
 func (p *postgres) OpenCloseStore(ctx context.Context, storeId uuid.UUID, isActive bool) error {
-	tx := p.db.WithContext(ctx).Model(entity.Store{}).Where("id = ?", storeId).
+	tx := transaction.DB(ctx, p.db).Model(entity.Store{}).Where("id = ?", storeId).
 		UpdateColumn("is_active", isActive)
 	if tx.Error != nil {
 		return tx.Error
@@ -205,7 +236,7 @@ func (p *postgres) OpenCloseStore(ctx context.Context, storeId uuid.UUID, isActi
 // GetStoreByUserID retrieves a store by its user ID.
 func (p *postgres) GetStoreByUserID(ctx context.Context, userID uuid.UUID) (*entity.Store, error) {
 	var store entity.Store
-	if err := p.db.WithContext(ctx).
+	if err := transaction.DB(ctx, p.db).
 		Preload("Hours").
 		Preload("Images").
 		Preload("Tags").
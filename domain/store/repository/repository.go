@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
 	"github.com/google/uuid"
 )
 
+// ErrVersionConflict is returned when a store was changed after the version it is updated from was read.
+var ErrVersionConflict = errors.New("store version conflict")
+
 // StoreServiceRepository defines the interface for store-related operations.
 type StoreServiceRepository interface {
 	// CreateStore creates a new store.
@@ -15,8 +19,11 @@ type StoreServiceRepository interface {
 	// GetStore retrieves a store by its ID.
 	GetStore(ctx context.Context, storeID string) (*entity.Store, error)
 
-	// UpdateStore updates an existing store.
-	UpdateStore(ctx context.Context, update *entity.Store) (*entity.Store, error)
+	// GetStoresByIds retrieves the stores with the given IDs, missing stores are left out.
+	GetStoresByIds(ctx context.Context, storeIDs []uuid.UUID) ([]*entity.Store, error)
+
+	// UpdateStore updates the fields of an existing store listed in paths, every field when paths is empty.
+	UpdateStore(ctx context.Context, update *entity.Store, paths []string) (*entity.Store, error)
 
 	// DeleteStore deletes a store by its ID.
 	DeleteStores(ctx context.Context, storeIDs []string) error
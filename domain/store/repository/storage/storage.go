@@ -3,23 +3,21 @@ package storage
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
+	_ "image/jpeg"
+	_ "image/png"
 	"mime"
 	"net/http"
 	"os"
-	"strings"
-
-	_ "image/jpeg"
-	_ "image/png"
 
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	"github.com/Mitra-Apps/be-store-service/domain/store/repository"
-	"github.com/google/uuid"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/lib"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type storage struct {
@@ -49,11 +47,12 @@ func New() repository.Storage {
 	}
 }
 
+// UploadImage stores the image under a name derived from the SHA-256 hash of its content,
+// so uploading the same image again reuses the existing object instead of creating a new one.
 func (s *storage) UploadImage(ctx context.Context, image, userID string) (string, error) {
-	b64data := image[strings.IndexByte(image, ',')+1:]
-	decodedImage, err := base64.StdEncoding.DecodeString(b64data)
+	decodedImage, err := lib.DecodeBase64Image(image)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode image: %w", err)
+		return "", storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT)
 	}
 
 	fileType := http.DetectContentType(decodedImage)
@@ -68,20 +67,30 @@ func (s *storage) UploadImage(ctx context.Context, image, userID string) (string
 	}
 
 	if fileExtension == "" {
-		return "", status.Errorf(codes.InvalidArgument, "invalid file type")
+		return "", storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_TYPE_IS_NOT_SUPPORTED)
 	}
 
 	if len(decodedImage) > 2*1024*1024 {
-		return "", status.Errorf(codes.InvalidArgument, "image is too large (2MB max)")
+		return "", storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_IS_TOO_LARGE)
 	}
 
-	filename := uuid.New().String() + fileExtension
-	objectName := fmt.Sprintf("%s/stores/%s", userID, filename)
+	// objects are shared by every store uploading the same content
+	objectName := fmt.Sprintf("stores/%s%s", lib.ContentHash(decodedImage), fileExtension)
+	objectURL := fmt.Sprintf("%s/%s/%s", os.Getenv("STORAGE_PUBLIC_URL"), s.bucket, objectName)
+
+	if _, err := s.client.StatObject(ctx, s.bucket, objectName, minio.StatObjectOptions{}); err == nil {
+		return objectURL, nil
+	} else if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return "", storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_UPLOADING_IMAGE, err)
+	}
 
-	info, err := s.client.PutObject(context.Background(), s.bucket, objectName, bytes.NewReader(decodedImage), int64(len(decodedImage)), minio.PutObjectOptions{})
+	_, err = s.client.PutObject(ctx, s.bucket, objectName, bytes.NewReader(decodedImage), int64(len(decodedImage)), minio.PutObjectOptions{
+		ContentType:  fileType,
+		UserMetadata: map[string]string{"uploaded-by": userID},
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to upload image: %w", err)
+		return "", storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_UPLOADING_IMAGE, err)
 	}
 
-	return fmt.Sprintf("%s/%s/%s", os.Getenv("STORAGE_PUBLIC_URL"), info.Bucket, info.Key), nil
+	return objectURL, nil
 }
@@ -0,0 +1,447 @@
+package storeerror
+
+import errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+
+// DefaultLocale is the locale of the messages of errors rendered outside of a request.
+const DefaultLocale = "id"
+
+// catalogue holds the message templates of every reason by locale, the arguments of an error fill
+// the verbs of the template.
+var catalogue = map[errPb.StoreErrorCode]map[string]string{
+	errPb.StoreErrorCode_NO_PRODUCT_INSERTED: {
+		"id": "Tidak ada produk yang disimpan",
+		"en": "No product was saved",
+	},
+	errPb.StoreErrorCode_STORE_ID_IS_REQUIRED: {
+		"id": "Id toko diperlukan",
+		"en": "The store id is required",
+	},
+	errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE: {
+		"id": "Anda tidak memiliki izin untuk mengubah toko ini",
+		"en": "You don't have permission to change this store",
+	},
+	errPb.StoreErrorCode_PRODUCT_IS_REQUIRED: {
+		"id": "Id produk diperlukan",
+		"en": "The product id is required",
+	},
+	errPb.StoreErrorCode_PRODUCT_ID_SHOULD_BE_EMPTY: {
+		"id": "Id produk harus kosong",
+		"en": "The product id should be empty",
+	},
+	errPb.StoreErrorCode_UOM_IS_REQUIRED: {
+		"id": "Satuan unit diperlukan",
+		"en": "The unit of measure is required",
+	},
+	errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED: {
+		"id": "Tipe produk diperlukan",
+		"en": "The product type is required",
+	},
+	errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE: {
+		"id": "Stok harus positif",
+		"en": "The stock should be positive",
+	},
+	errPb.StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED: {
+		"id": "Produk sudah terdaftar : %s",
+		"en": "The products are already registered : %s",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE: {
+		"id": "Error saat mencari data tipe produk : %v",
+		"en": "Error when getting the product types : %v",
+	},
+	errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND: {
+		"id": "Tipe produk tidak ditemukan",
+		"en": "The product type is not found",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT: {
+		"id": "Error saat membuat / memperbarui data produk : %v",
+		"en": "Error when creating / updating the products : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE: {
+		"id": "Error saat melakukan pencarian gambar produk : %v",
+		"en": "Error when getting the product images : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE: {
+		"id": "Error saat menghapus gambar dari penyimpanan : %v",
+		"en": "Error when removing the image from the storage : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE: {
+		"id": "Error saat menghapus gambar produk : %v",
+		"en": "Error when deleting the product images : %v",
+	},
+	errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT: {
+		"id": "Gambar produk harus dalam format base 64",
+		"en": "The product image should be in base 64 format",
+	},
+	errPb.StoreErrorCode_NAME_IS_REQUIRED: {
+		"id": "Nama tidak boleh kosong",
+		"en": "The name is required",
+	},
+	errPb.StoreErrorCode_PRICE_IS_REQUIRED: {
+		"id": "Harga tidak boleh kosong",
+		"en": "The price is required",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN: {
+		"id": "Error saat mendapatkan claims dari jwt token",
+		"en": "Error when getting the claims from the jwt token",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_IMAGE_REFERENCE: {
+		"id": "Error saat menyimpan referensi gambar : %v",
+		"en": "Error when saving the image reference : %v",
+	},
+	errPb.StoreErrorCode_VERSION_IS_REQUIRED: {
+		"id": "Versi data diperlukan",
+		"en": "The version is required",
+	},
+	errPb.StoreErrorCode_VERSION_CONFLICT: {
+		"id": "Data telah diubah oleh pengguna lain, muat ulang data lalu coba lagi",
+		"en": "The data was changed by another user, reload it and try again",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_RECORDING_AUDIT_EVENT: {
+		"id": "Error saat mencatat audit perubahan : %v",
+		"en": "Error when recording the audit event : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_WRITING_DOMAIN_EVENT: {
+		"id": "Error saat menyimpan event perubahan : %v",
+		"en": "Error when writing the domain event : %v",
+	},
+	errPb.StoreErrorCode_INVALID_WEBHOOK_URL: {
+		"id": "URL webhook harus berupa URL http atau https yang valid",
+		"en": "The webhook url should be a valid http or https url",
+	},
+	errPb.StoreErrorCode_INVALID_WEBHOOK_EVENT_TYPE: {
+		"id": "Tipe event webhook tidak dikenal : %s",
+		"en": "Unknown webhook event type : %s",
+	},
+	errPb.StoreErrorCode_WEBHOOK_SUBSCRIPTION_NOT_FOUND: {
+		"id": "Webhook tidak ditemukan",
+		"en": "The webhook is not found",
+	},
+	errPb.StoreErrorCode_WEBHOOK_DELIVERY_NOT_FOUND: {
+		"id": "Pengiriman webhook tidak ditemukan",
+		"en": "The webhook delivery is not found",
+	},
+	errPb.StoreErrorCode_WEBHOOK_SUBSCRIPTION_IS_DISABLED: {
+		"id": "Webhook tidak aktif, aktifkan webhook sebelum mengirim ulang",
+		"en": "The webhook is disabled, enable it before replaying a delivery",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION: {
+		"id": "Error saat memproses webhook : %v",
+		"en": "Error when processing the webhook : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_WATCHING_STORE: {
+		"id": "Error saat membaca perubahan toko : %v",
+		"en": "Error when reading the store changes : %v",
+	},
+	errPb.StoreErrorCode_WATCH_RESUME_SEQUENCE_EXPIRED: {
+		"id": "Perubahan sejak sequence terakhir sudah tidak tersedia, muat ulang data toko",
+		"en": "The changes since the last sequence are no longer available, reload the store",
+	},
+	errPb.StoreErrorCode_INVALID_PRODUCT_DISCOUNT: {
+		"id": "Tipe diskon harus percentage atau fixed",
+		"en": "The discount type should be percentage or fixed",
+	},
+	errPb.StoreErrorCode_PRODUCT_DISCOUNT_NOT_FOUND: {
+		"id": "Diskon produk tidak ditemukan",
+		"en": "The product discount is not found",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE: {
+		"id": "Error saat memproses harga produk : %v",
+		"en": "Error when processing the product price : %v",
+	},
+	errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND: {
+		"id": "Satuan unit tidak ditemukan : %v",
+		"en": "The unit of measure is not found : %v",
+	},
+	errPb.StoreErrorCode_INVALID_UOM_CONVERSION: {
+		"id": "Faktor konversi satuan dasar harus 1",
+		"en": "The conversion factor of a base unit should be 1",
+	},
+	errPb.StoreErrorCode_INTERNAL_ERROR: {
+		"id": "Terjadi kesalahan pada server : %v",
+		"en": "Internal server error : %v",
+	},
+	errPb.StoreErrorCode_INVALID_ARGUMENT: {
+		"id": "Permintaan tidak valid",
+		"en": "The request is invalid",
+	},
+	errPb.StoreErrorCode_FIELD_IS_REQUIRED: {
+		"id": "%s diperlukan",
+		"en": "%s is required",
+	},
+	errPb.StoreErrorCode_INVALID_ID: {
+		"id": "%s harus berupa uuid",
+		"en": "%s should be a uuid",
+	},
+	errPb.StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED: {
+		"id": "Field %s tidak dapat diubah",
+		"en": "Field %s can not be updated",
+	},
+	errPb.StoreErrorCode_TOKEN_IS_REQUIRED: {
+		"id": "Token diperlukan",
+		"en": "A token is required",
+	},
+	errPb.StoreErrorCode_INVALID_TOKEN: {
+		"id": "Token tidak valid",
+		"en": "The token is invalid",
+	},
+	errPb.StoreErrorCode_STORE_NOT_FOUND: {
+		"id": "Toko tidak ditemukan",
+		"en": "The store is not found",
+	},
+	errPb.StoreErrorCode_USER_ALREADY_HAS_STORE: {
+		"id": "Pengguna sudah memiliki toko",
+		"en": "The user already has a store",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_STORE: {
+		"id": "Error saat mencari data toko : %v",
+		"en": "Error when getting the store : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_STORE: {
+		"id": "Error saat menyimpan data toko : %v",
+		"en": "Error when saving the store : %v",
+	},
+	errPb.StoreErrorCode_STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED: {
+		"id": "Toko yang buka 24 jam tidak dapat tutup pada hari %s",
+		"en": "A store open 24 hours can not be closed on %s",
+	},
+	errPb.StoreErrorCode_PRODUCT_NOT_FOUND: {
+		"id": "Produk tidak ditemukan",
+		"en": "The product is not found",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT: {
+		"id": "Error saat mencari data produk : %v",
+		"en": "Error when getting the products : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT: {
+		"id": "Error saat menghapus produk : %v",
+		"en": "Error when deleting the product : %v",
+	},
+	errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND: {
+		"id": "Kategori produk tidak ditemukan",
+		"en": "The product category is not found",
+	},
+	errPb.StoreErrorCode_PARENT_PRODUCT_CATEGORY_NOT_FOUND: {
+		"id": "Kategori induk tidak ditemukan",
+		"en": "The parent product category is not found",
+	},
+	errPb.StoreErrorCode_PRODUCT_CATEGORY_NAME_IS_ALREADY_USED: {
+		"id": "Nama sudah digunakan oleh kategori produk lain",
+		"en": "The name is already used by another product category",
+	},
+	errPb.StoreErrorCode_PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF: {
+		"id": "Kategori produk tidak dapat dipindahkan ke bawah dirinya sendiri",
+		"en": "A product category can not be moved below itself",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY: {
+		"id": "Error saat mencari data kategori produk : %v",
+		"en": "Error when getting the product categories : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY: {
+		"id": "Error saat menyimpan kategori produk : %v",
+		"en": "Error when saving the product category : %v",
+	},
+	errPb.StoreErrorCode_PRODUCT_TYPE_NAME_IS_ALREADY_USED: {
+		"id": "Tipe produk sudah ada untuk kategori produk ini",
+		"en": "The product type already exists for this product category",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_TYPE: {
+		"id": "Error saat menyimpan tipe produk : %v",
+		"en": "Error when saving the product type : %v",
+	},
+	errPb.StoreErrorCode_UOM_NAME_IS_ALREADY_USED: {
+		"id": "Nama sudah digunakan oleh satuan unit lain",
+		"en": "The name is already used by another unit of measure",
+	},
+	errPb.StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED: {
+		"id": "Simbol sudah digunakan oleh satuan unit lain",
+		"en": "The symbol is already used by another unit of measure",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM: {
+		"id": "Error saat mencari data satuan unit : %v",
+		"en": "Error when getting the units of measure : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_UOM: {
+		"id": "Error saat menyimpan satuan unit : %v",
+		"en": "Error when saving the unit of measure : %v",
+	},
+	errPb.StoreErrorCode_BASE_UOM_IS_NOT_FOUND: {
+		"id": "Satuan dasar tidak ditemukan",
+		"en": "The base unit is not found",
+	},
+	errPb.StoreErrorCode_UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE: {
+		"id": "Faktor konversi harus lebih dari 0",
+		"en": "The conversion factor should be more than 0",
+	},
+	errPb.StoreErrorCode_UOM_CAN_NOT_BE_ITS_OWN_BASE: {
+		"id": "Satuan dasar tidak boleh satuan itu sendiri",
+		"en": "A unit can not be its own base unit",
+	},
+	errPb.StoreErrorCode_BASE_UOM_CAN_NOT_HAVE_BASE_UOM: {
+		"id": "Satuan dasar tidak boleh memiliki satuan dasar lain",
+		"en": "A base unit can not have a base unit itself",
+	},
+	errPb.StoreErrorCode_UOM_IS_USED_AS_BASE_UOM: {
+		"id": "Satuan ini digunakan sebagai satuan dasar %s",
+		"en": "This unit is the base unit of %s",
+	},
+	errPb.StoreErrorCode_INVALID_PERCENTAGE_DISCOUNT: {
+		"id": "Diskon persentase harus lebih dari 0 dan paling besar 100",
+		"en": "A percentage discount should be more than 0 and at most 100",
+	},
+	errPb.StoreErrorCode_INVALID_FIXED_DISCOUNT: {
+		"id": "Potongan harga harus lebih dari 0",
+		"en": "A fixed discount should be more than 0",
+	},
+	errPb.StoreErrorCode_INVALID_DISCOUNT_PERIOD: {
+		"id": "Waktu berakhir diskon harus setelah waktu mulai",
+		"en": "A discount should end after it starts",
+	},
+	errPb.StoreErrorCode_MONEY_IS_TOO_PRECISE: {
+		"id": "Jumlah uang tidak boleh lebih dari 2 desimal",
+		"en": "Money can not be more precise than 2 decimals",
+	},
+	errPb.StoreErrorCode_MONEY_SIGNS_DIFFER: {
+		"id": "Units dan nanos uang harus bertanda sama",
+		"en": "Money units and nanos should have the same sign",
+	},
+	errPb.StoreErrorCode_INVALID_CURRENCY_CODE: {
+		"id": "Kode mata uang %q harus berupa kode ISO 4217 3 huruf",
+		"en": "Currency code %q should be a 3 letter ISO 4217 code",
+	},
+	errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_READ_AUDIT_EVENTS: {
+		"id": "Anda tidak memiliki izin untuk membaca audit perubahan",
+		"en": "You don't have permission to read the audit events",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_AUDIT_EVENTS: {
+		"id": "Error saat mencari audit perubahan : %v",
+		"en": "Error when getting the audit events : %v",
+	},
+	errPb.StoreErrorCode_TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED: {
+		"id": "Tipe data %s tidak memiliki terjemahan",
+		"en": "Entity type %s has no translations",
+	},
+	errPb.StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND: {
+		"id": "%s dengan id %d tidak ditemukan",
+		"en": "%s id %d is not found",
+	},
+	errPb.StoreErrorCode_LOCALE_IS_NOT_SUPPORTED: {
+		"id": "Bahasa %s tidak didukung",
+		"en": "Locale %s is not supported",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION: {
+		"id": "Error saat memproses terjemahan : %v",
+		"en": "Error when processing the translations : %v",
+	},
+	errPb.StoreErrorCode_INVALID_ETAG: {
+		"id": "Header If-Match harus berupa etag dari layanan ini",
+		"en": "The If-Match header should be an etag returned by the service",
+	},
+	errPb.StoreErrorCode_ETAG_DOES_NOT_MATCH_VERSION: {
+		"id": "Header If-Match tidak sesuai dengan versi pada permintaan",
+		"en": "The If-Match header does not match the version of the request",
+	},
+	errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_TOO_LONG: {
+		"id": "Idempotency key paling panjang 255 karakter",
+		"en": "The idempotency key should be at most 255 characters",
+	},
+	errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_IN_PROGRESS: {
+		"id": "Permintaan dengan idempotency key ini sedang diproses, coba lagi nanti",
+		"en": "A request with this idempotency key is in progress, retry later",
+	},
+	errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_ALREADY_USED: {
+		"id": "Idempotency key sudah digunakan untuk permintaan lain",
+		"en": "The idempotency key has already been used for another request",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY: {
+		"id": "Error saat memproses idempotency key : %v",
+		"en": "Error when processing the idempotency key : %v",
+	},
+	errPb.StoreErrorCode_IMAGE_TYPE_IS_NOT_SUPPORTED: {
+		"id": "Gambar harus berformat png atau jpeg",
+		"en": "The image should be a png or jpeg image",
+	},
+	errPb.StoreErrorCode_IMAGE_IS_TOO_LARGE: {
+		"id": "Ukuran gambar paling besar 2MB",
+		"en": "The image should be at most 2MB",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_UPLOADING_IMAGE: {
+		"id": "Error saat mengunggah gambar : %v",
+		"en": "Error when uploading the image : %v",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NOT_FOUND: {
+		"id": "Atribut produk tidak ditemukan",
+		"en": "The product attribute is not found",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED: {
+		"id": "Atribut sudah ada untuk tipe produk ini",
+		"en": "The attribute already exists for this product type",
+	},
+	errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE: {
+		"id": "Nilai %q bukan nilai %s",
+		"en": "The value %q is not a %s value",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE: {
+		"id": "Error saat mengambil atribut produk : %v",
+		"en": "Error when getting the product attributes : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE: {
+		"id": "Error saat menyimpan atribut produk : %v",
+		"en": "Error when saving the product attributes : %v",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTES_ARE_NOT_VALID: {
+		"id": "Atribut produk tidak valid",
+		"en": "The product attributes are not valid",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE: {
+		"id": "Atribut %v tidak tersedia untuk tipe produk ini",
+		"en": "The attribute %v is not defined for the product type",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_REQUIRED: {
+		"id": "Atribut %s wajib diisi",
+		"en": "The attribute %s is required",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED: {
+		"id": "Nilai atribut %s harus salah satu dari %s",
+		"en": "The value of the attribute %s should be one of %s",
+	},
+	errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_DUPLICATED: {
+		"id": "Atribut %v diisi lebih dari sekali",
+		"en": "The attribute %v is set more than once",
+	},
+	errPb.StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT: {
+		"id": "Digit pemeriksa barcode %s tidak valid",
+		"en": "The check digit of the barcode %s is not valid",
+	},
+	errPb.StoreErrorCode_PRODUCT_SKUS_ARE_ALREADY_REGISTERED: {
+		"id": "SKU sudah terdaftar : %s",
+		"en": "The SKUs are already registered : %s",
+	},
+	errPb.StoreErrorCode_PRODUCT_BARCODES_ARE_ALREADY_REGISTERED: {
+		"id": "Barcode sudah terdaftar : %s",
+		"en": "The barcodes are already registered : %s",
+	},
+	errPb.StoreErrorCode_PRODUCT_LOT_NUMBER_IS_ALREADY_USED: {
+		"id": "Nomor lot %s sudah digunakan untuk produk ini",
+		"en": "The lot number %s is already used for the product",
+	},
+	errPb.StoreErrorCode_INVALID_DATE: {
+		"id": "Tanggal %s tidak valid",
+		"en": "The date %s is not valid",
+	},
+	errPb.StoreErrorCode_LOT_EXPIRES_BEFORE_IT_IS_RECEIVED: {
+		"id": "Tanggal kedaluwarsa lot sebelum tanggal diterima",
+		"en": "The lot expires before it is received",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT: {
+		"id": "Error saat mengambil lot produk : %v",
+		"en": "Error when getting the product lots : %v",
+	},
+	errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_LOT: {
+		"id": "Error saat menyimpan lot produk : %v",
+		"en": "Error when saving the product lot : %v",
+	},
+	errPb.StoreErrorCode_DISCOUNT_CURRENCY_MISMATCH: {
+		"id": "Mata uang diskon %s harus sama dengan mata uang harga produk %s",
+		"en": "The discount currency %s should be the currency of the product price %s",
+	},
+}
@@ -0,0 +1,211 @@
+package storeerror
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the domain of the ErrorInfo details, the reason of an error is unique within it.
+const Domain = "store.mitra-apps"
+
+// Error is an error of the catalogue. It is returned in the default locale, the Errors interceptor
+// renders it in the locale of the caller.
+type Error struct {
+	Code       codes.Code
+	Reason     errPb.StoreErrorCode
+	Args       []interface{}
+	Violations []*Violation
+}
+
+// Violation is a field of the request which is not valid. Its description is the message of Reason,
+// or Description for the rules of protoc-gen-validate, which are only described in English.
+type Violation struct {
+	Field       string
+	Reason      errPb.StoreErrorCode
+	Args        []interface{}
+	Description string
+}
+
+// New returns the error of reason, args fill the message template of reason.
+func New(code codes.Code, reason errPb.StoreErrorCode, args ...interface{}) *Error {
+	return &Error{Code: code, Reason: reason, Args: args}
+}
+
+// BadRequest returns an InvalidArgument error with a BadRequest detail listing the violations.
+func BadRequest(reason errPb.StoreErrorCode, violations ...*Violation) *Error {
+	return New(codes.InvalidArgument, reason).WithViolations(violations...)
+}
+
+// WithViolations adds violations to the BadRequest detail of the error.
+func (e *Error) WithViolations(violations ...*Violation) *Error {
+	e.Violations = append(e.Violations, violations...)
+	return e
+}
+
+// Field returns the violation of field, args fill the message template of reason.
+func Field(field string, reason errPb.StoreErrorCode, args ...interface{}) *Violation {
+	return &Violation{Field: field, Reason: reason, Args: args}
+}
+
+// Invalid returns an InvalidArgument error of reason for a single field, args fill the message
+// template of reason.
+func Invalid(field string, reason errPb.StoreErrorCode, args ...interface{}) *Error {
+	return New(codes.InvalidArgument, reason, args...).WithViolations(Field(field, reason, args...))
+}
+
+// InvalidID returns the error of a field which is not a valid uuid.
+func InvalidID(field string) *Error {
+	return Invalid(field, errPb.StoreErrorCode_INVALID_ID, field)
+}
+
+// Required returns the error of a required field which is empty.
+func Required(field string) *Error {
+	return Invalid(field, errPb.StoreErrorCode_FIELD_IS_REQUIRED, field)
+}
+
+// Internal returns the INTERNAL_ERROR of an unexpected err, e.g. of a repository.
+func Internal(err error) *Error {
+	return New(codes.Internal, errPb.StoreErrorCode_INTERNAL_ERROR, err)
+}
+
+// FromValidation converts the errors of the Validate and ValidateAll methods generated by
+// protoc-gen-validate, or of several of them joined, to an INVALID_ARGUMENT error with a violation
+// per broken rule. Fields are named by their proto path, e.g. store.store_name.
+func FromValidation(err error) *Error {
+	return BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT, validationViolations("", err)...)
+}
+
+// FromMessageValidation is FromValidation for the errors of the embedded message field, e.g. the store
+// of an update, its violations are named below field.
+func FromMessageValidation(field string, err error) *Error {
+	return BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT, validationViolations(field+".", err)...)
+}
+
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+func validationViolations(prefix string, err error) []*Violation {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case interface{ AllErrors() []error }:
+		return joinViolations(prefix, e.AllErrors())
+	case interface{ Unwrap() []error }:
+		return joinViolations(prefix, e.Unwrap())
+	case validationError:
+		field := prefix + protoFieldName(e.Field())
+		// the cause of an embedded message lists the violations of its own fields
+		if e.Cause() != nil {
+			if nested := validationViolations(field+".", e.Cause()); len(nested) > 0 {
+				return nested
+			}
+		}
+		return []*Violation{{Field: field, Description: e.Reason()}}
+	default:
+		return []*Violation{{Field: strings.TrimSuffix(prefix, "."), Description: err.Error()}}
+	}
+}
+
+func joinViolations(prefix string, errs []error) []*Violation {
+	violations := []*Violation{}
+	for _, err := range errs {
+		violations = append(violations, validationViolations(prefix, err)...)
+	}
+	return violations
+}
+
+// protoFieldName converts the go field name of a validation error to its proto name, e.g. StoreName
+// to store_name and Images[0] to images[0].
+func protoFieldName(field string) string {
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) {
+			if i > 0 && field[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (e *Error) Error() string {
+	return e.Message(DefaultLocale)
+}
+
+// Message returns the message of the error in locale, or in the default locale when it has no
+// translation to locale.
+func (e *Error) Message(locale string) string {
+	return message(locale, e.Reason, e.Args)
+}
+
+// Status returns the gRPC status of the error with its message in locale, an ErrorInfo detail holding
+// the reason and a BadRequest detail when fields are not valid.
+func (e *Error) Status(locale string) *status.Status {
+	st := status.New(e.Code, e.Message(locale))
+	info := &errdetails.ErrorInfo{
+		Reason:   e.Reason.String(),
+		Domain:   Domain,
+		Metadata: map[string]string{"locale": locale},
+	}
+	if len(e.Violations) == 0 {
+		withDetails, err := st.WithDetails(info)
+		if err != nil {
+			return st
+		}
+		return withDetails
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		description := v.Description
+		if v.Reason != 0 {
+			description = message(locale, v.Reason, v.Args)
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: description,
+		})
+	}
+	withDetails, err := st.WithDetails(info, badRequest)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// GRPCStatus makes the error usable with status.Code and status.FromError, in the default locale.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.Status(DefaultLocale)
+}
+
+// Is reports whether target is an error of the catalogue with the same reason.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == e.Reason
+}
+
+func message(locale string, reason errPb.StoreErrorCode, args []interface{}) string {
+	templates, ok := catalogue[reason]
+	if !ok {
+		return reason.String()
+	}
+	template, ok := templates[locale]
+	if !ok {
+		template = templates[DefaultLocale]
+	}
+	if len(args) == 0 {
+		return template
+	}
+	return fmt.Sprintf(template, args...)
+}
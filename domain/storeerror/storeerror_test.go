@@ -0,0 +1,88 @@
+package storeerror
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var verbPattern = regexp.MustCompile(`%[vsdq]`)
+
+func TestCatalogue(t *testing.T) {
+	for value, name := range errPb.StoreErrorCode_name {
+		t.Run(name, func(t *testing.T) {
+			templates, ok := catalogue[errPb.StoreErrorCode(value)]
+			require.True(t, ok, "the reason has no message")
+			for _, locale := range []string{"id", "en"} {
+				assert.NotEmpty(t, templates[locale], "the reason has no %s message", locale)
+			}
+			// the arguments of an error fill the templates of every locale
+			assert.Equal(t, len(verbPattern.FindAllString(templates["id"], -1)), len(verbPattern.FindAllString(templates["en"], -1)))
+		})
+	}
+}
+
+func TestError_Message(t *testing.T) {
+	err := New(codes.NotFound, errPb.StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND, "product_type", int64(3))
+
+	assert.Equal(t, "product_type dengan id 3 tidak ditemukan", err.Error())
+	assert.Equal(t, "product_type id 3 is not found", err.Message("en"))
+	assert.Equal(t, "product_type dengan id 3 tidak ditemukan", err.Message("fr"))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), New(codes.Internal, errPb.StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND)))
+	assert.False(t, errors.Is(err, New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)))
+}
+
+func TestError_Status(t *testing.T) {
+	err := Invalid("uom.base_unit_id", errPb.StoreErrorCode_UOM_IS_USED_AS_BASE_UOM, "kilogram")
+
+	st := err.Status("en")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "This unit is the base unit of kilogram", st.Message())
+	require.Len(t, st.Details(), 2)
+	info := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.Equal(t, "UOM_IS_USED_AS_BASE_UOM", info.Reason)
+	assert.Equal(t, Domain, info.Domain)
+	assert.Equal(t, map[string]string{"locale": "en"}, info.Metadata)
+	badRequest := st.Details()[1].(*errdetails.BadRequest)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "uom.base_unit_id", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "This unit is the base unit of kilogram", badRequest.FieldViolations[0].Description)
+
+	st = New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND).Status("id")
+	assert.Equal(t, "Toko tidak ditemukan", st.Message())
+	assert.Len(t, st.Details(), 1)
+}
+
+func TestFromValidation(t *testing.T) {
+	req := &pb.UpsertTranslationsRequest{
+		EntityType:   "product_type",
+		EntityId:     3,
+		Translations: []*pb.Translation{{Locale: "fr", Name: "Kopi"}, {Locale: "en"}},
+	}
+	err := FromValidation(req.ValidateAll())
+
+	assert.Equal(t, codes.InvalidArgument, err.Code)
+	assert.Equal(t, errPb.StoreErrorCode_INVALID_ARGUMENT, err.Reason)
+	fields := []string{}
+	for _, v := range err.Violations {
+		fields = append(fields, v.Field)
+		assert.NotEmpty(t, v.Description)
+	}
+	assert.Equal(t, []string{"translations[0].locale", "translations[1].name"}, fields)
+}
+
+func TestProtoFieldName(t *testing.T) {
+	assert.Equal(t, "store_name", protoFieldName("StoreName"))
+	assert.Equal(t, "images[0]", protoFieldName("Images[0]"))
+	assert.Equal(t, "id", protoFieldName("Id"))
+}
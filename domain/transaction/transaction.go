@@ -0,0 +1,44 @@
+package transaction
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ctxKey struct{}
+
+// Manager runs a unit of work in a database transaction carried by the context.
+type Manager interface {
+	// Do runs fn in a transaction which is committed when fn returns nil and rolled back otherwise.
+	// Repositories called with the context passed to fn take part in the transaction.
+	// When ctx already carries a transaction fn joins it instead of starting a new one.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type manager struct {
+	db *gorm.DB
+}
+
+func NewManager(db *gorm.DB) Manager {
+	return &manager{db}
+}
+
+func (m *manager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(ctxKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, ctxKey{}, tx))
+	})
+}
+
+// DB returns the transaction carried by ctx, or db when ctx has none, bound to ctx.
+// Repositories use it for every query so they take part in the unit of work of the caller.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(ctxKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
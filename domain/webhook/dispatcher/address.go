@@ -0,0 +1,126 @@
+package dispatcher
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned for a webhook pointing to an address which is not reachable from the
+// internet, e.g. the host itself, a private network or the metadata service of the cloud.
+var ErrBlockedAddress = errors.New("webhook address is not public")
+
+// blockedNetworks are the reserved ranges net.IP has no method for, e.g. the shared address space which
+// holds the metadata service of some clouds, or NAT64 which maps to any IPv4 address.
+var blockedNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+)
+
+// blockedHosts are the names of the host itself and of the metadata services.
+var blockedHosts = []string{"localhost", "metadata", "metadata.google.internal"}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
+
+// IsPublicIP reports whether a webhook may be delivered to ip. Loopback, private, link-local, which holds
+// the metadata service at 169.254.169.254, multicast and the other reserved addresses are refused.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateURL checks that rawURL can be the endpoint of a webhook, an http or https url of a host which
+// is not internal. Host names are checked again once they are resolved, when the webhook is delivered
+// with the client of NewHTTPClient.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return errors.New("missing host")
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+		}
+		return nil
+	}
+	for _, blocked := range blockedHosts {
+		if host == blocked || strings.HasSuffix(host, "."+blocked) {
+			return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+		}
+	}
+	return nil
+}
+
+// NewHTTPClient returns the client delivering the webhooks. The address of every connection is checked
+// once the host name is resolved, so a name resolving to an internal address is refused as well.
+// Redirects are not followed, the redirect response is a failed delivery.
+func NewHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkDialAddress,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			// no proxy from the environment, it would connect to the webhook without the check
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkDialAddress refuses to connect to an address which is not public, it runs after the host name
+// has been resolved.
+func checkDialAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	return nil
+}
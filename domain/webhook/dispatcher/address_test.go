@@ -0,0 +1,78 @@
+package dispatcher
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "93.184.216.34", want: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "10.1.2.3"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "169.254.169.254"},
+		{ip: "100.100.100.200"},
+		{ip: "0.0.0.0"},
+		{ip: "::"},
+		{ip: "fd00:ec2::254"},
+		{ip: "fe80::1"},
+		{ip: "::ffff:127.0.0.1"},
+		{ip: "64:ff9b::a9fe:a9fe"},
+		{ip: "224.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicIP(net.ParseIP(tt.ip)))
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	assert.NoError(t, ValidateURL("https://example.com/hooks"))
+	assert.NoError(t, ValidateURL("http://93.184.216.34:8080/hooks"))
+
+	for _, rawURL := range []string{
+		"ftp://example.com",
+		"https://",
+		"http://localhost:8080/hooks",
+		"http://api.localhost/hooks",
+		"http://metadata.google.internal/computeMetadata/v1/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://127.0.0.1/hooks",
+		"http://[::1]/hooks",
+		"http://10.0.0.5/hooks",
+	} {
+		assert.Error(t, ValidateURL(rawURL), rawURL)
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	}))
+	defer server.Close()
+
+	// the test server listens on the loopback address, which webhooks can not be delivered to
+	_, err := NewHTTPClient().Post(server.URL, "application/json", nil)
+	assert.True(t, errors.Is(err, ErrBlockedAddress))
+
+	// redirects are returned as the response instead of being followed
+	client := NewHTTPClient()
+	client.Transport = http.DefaultTransport
+	res, err := client.Post(server.URL, "application/json", nil)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusFound, res.StatusCode)
+}
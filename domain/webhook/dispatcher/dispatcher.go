@@ -0,0 +1,248 @@
+package dispatcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/repository"
+	"github.com/google/uuid"
+)
+
+// Config controls how the dispatcher delivers the webhooks.
+type Config struct {
+	// Interval is the wait between polls once no delivery is due.
+	Interval time.Duration
+	// BatchSize is the number of deliveries claimed by a poll.
+	BatchSize int
+	// Timeout is the deadline of a single delivery attempt.
+	Timeout time.Duration
+	// Lease is how long the claimed deliveries are kept from the other dispatchers, it should outlast
+	// sending a whole batch.
+	Lease time.Duration
+	// MaxAttempts is the number of attempts of a delivery before it is marked as failed.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry of a delivery, it doubles on every following retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration
+	// MaxConsecutiveFailures is the number of failed attempts in a row after which a webhook is disabled.
+	MaxConsecutiveFailures int
+}
+
+// DefaultConfig returns the configuration used when nothing is set in the environment.
+func DefaultConfig() Config {
+	return Config{
+		Interval:               time.Second,
+		BatchSize:              50,
+		Timeout:                10 * time.Second,
+		Lease:                  10 * time.Minute,
+		MaxAttempts:            8,
+		BaseBackoff:            10 * time.Second,
+		MaxBackoff:             time.Hour,
+		MaxConsecutiveFailures: 20,
+	}
+}
+
+// ConfigFromEnv returns DefaultConfig overridden by the WEBHOOK_* environment variables.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_INTERVAL")); err == nil {
+		cfg.Interval = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("WEBHOOK_BATCH_SIZE")); err == nil {
+		cfg.BatchSize = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_TIMEOUT")); err == nil {
+		cfg.Timeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_LEASE")); err == nil {
+		cfg.Lease = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS")); err == nil {
+		cfg.MaxAttempts = n
+	}
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_BASE_BACKOFF")); err == nil {
+		cfg.BaseBackoff = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("WEBHOOK_MAX_BACKOFF")); err == nil {
+		cfg.MaxBackoff = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_CONSECUTIVE_FAILURES")); err == nil {
+		cfg.MaxConsecutiveFailures = n
+	}
+	return cfg
+}
+
+// Dispatcher sends the queued deliveries to the webhooks. Every replica may run one, a delivery is
+// leased by a single dispatcher at a time and sent outside of any transaction, so a delivery is sent
+// again when the dispatcher stops before recording the result or the lease runs out.
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	trx    transaction.Manager
+	client *http.Client
+	cfg    Config
+	now    func() time.Time
+}
+
+func New(repo repository.WebhookRepository, trx transaction.Manager, client *http.Client, cfg Config) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		trx:    trx,
+		client: client,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Run delivers the webhooks until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		claimed, err := d.DispatchBatch(ctx)
+		if err != nil {
+			log.Printf("Failed to dispatch webhook deliveries : %v \n", err)
+		}
+
+		// a full batch means more deliveries are due
+		if err == nil && claimed == d.cfg.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.Interval):
+		}
+	}
+}
+
+// DispatchBatch sends a batch of due deliveries and returns how many were claimed.
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	var deliveries []*entity.WebhookDelivery
+	err := d.trx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deliveries, err = d.repo.ClaimWebhookDeliveries(ctx, d.now(), d.now().Add(d.cfg.Lease), d.cfg.BatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// webhooks disabled by this batch, their remaining deliveries wait until they are enabled again
+	disabled := map[uuid.UUID]bool{}
+	var held []uuid.UUID
+	for _, delivery := range deliveries {
+		if disabled[delivery.SubscriptionID] {
+			held = append(held, delivery.ID)
+			continue
+		}
+		if err := d.dispatch(ctx, delivery, disabled); err != nil {
+			return len(deliveries), err
+		}
+	}
+	if len(held) > 0 {
+		if err := d.repo.ReleaseWebhookDeliveries(ctx, held); err != nil {
+			return len(deliveries), err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// dispatch sends the delivery and records the result in its own transaction.
+func (d *Dispatcher) dispatch(ctx context.Context, delivery *entity.WebhookDelivery, disabled map[uuid.UUID]bool) error {
+	responseStatus, err := d.send(ctx, delivery)
+	delivery.Attempts++
+	delivery.ResponseStatus = responseStatus
+	delivery.LockedUntil = nil
+
+	if err == nil {
+		deliveredAt := d.now()
+		delivery.Status = entity.DeliveryDelivered
+		delivery.DeliveredAt = &deliveredAt
+		delivery.LastError = ""
+		return d.trx.Do(ctx, func(ctx context.Context) error {
+			if err := d.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+				return err
+			}
+			return d.repo.ResetWebhookSubscriptionFailures(ctx, delivery.SubscriptionID)
+		})
+	}
+
+	log.Printf("Failed to deliver webhook %s, attempt %d : %v \n", delivery.ID, delivery.Attempts, err)
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.Status = entity.DeliveryFailed
+	} else {
+		delivery.NextAttemptAt = d.now().Add(d.backoff(delivery.Attempts))
+	}
+	return d.trx.Do(ctx, func(ctx context.Context) error {
+		if err := d.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+			return err
+		}
+
+		failures, err := d.repo.RecordWebhookSubscriptionFailure(ctx, delivery.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if d.cfg.MaxConsecutiveFailures > 0 && failures >= d.cfg.MaxConsecutiveFailures {
+			reason := fmt.Sprintf("disabled after %d consecutive failed deliveries, last error: %s", failures, delivery.LastError)
+			if err := d.repo.DisableWebhookSubscription(ctx, delivery.SubscriptionID, d.now(), reason); err != nil {
+				return err
+			}
+			disabled[delivery.SubscriptionID] = true
+		}
+		return nil
+	})
+}
+
+// send posts the delivery and returns the response status, any response other than 2xx is a failure.
+func (d *Dispatcher) send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	if delivery.Subscription == nil {
+		return 0, fmt.Errorf("subscription %s not loaded", delivery.SubscriptionID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", delivery.ID.String())
+	req.Header.Set("X-Event-Id", delivery.EventID.String())
+	req.Header.Set("X-Event-Type", delivery.EventType)
+	req.Header.Set(SignatureHeader, Sign(delivery.Subscription.Secret, d.now(), body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<20))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	b := d.cfg.BaseBackoff << (attempts - 1)
+	if d.cfg.MaxBackoff > 0 && (b > d.cfg.MaxBackoff || b <= 0) {
+		b = d.cfg.MaxBackoff
+	}
+	return b
+}
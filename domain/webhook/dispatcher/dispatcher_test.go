@@ -0,0 +1,187 @@
+package dispatcher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	trxMock "github.com/Mitra-Apps/be-store-service/domain/transaction/mock"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	webhookRepoMock "github.com/Mitra-Apps/be-store-service/domain/webhook/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const secret = "whsec_test"
+
+func newDelivery(url string, attempts int) *entity.WebhookDelivery {
+	sub := &entity.WebhookSubscription{URL: url, Secret: secret, IsActive: true}
+	sub.ID = uuid.New()
+	return &entity.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: sub.ID,
+		EventID:        uuid.New(),
+		EventType:      "StoreClosed",
+		Payload:        `{"type":"StoreClosed"}`,
+		Status:         entity.DeliveryPending,
+		Attempts:       attempts,
+		Subscription:   sub,
+	}
+}
+
+func TestDispatcher_DispatchBatch(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		status     int
+		attempts   int
+		failures   int
+		wantStatus string
+		wantNext   time.Time
+		mock       func(repo *webhookRepoMock.MockWebhookRepository, d *entity.WebhookDelivery)
+	}{
+		{
+			name:       "delivered",
+			status:     http.StatusOK,
+			wantStatus: entity.DeliveryDelivered,
+			mock: func(repo *webhookRepoMock.MockWebhookRepository, d *entity.WebhookDelivery) {
+				repo.EXPECT().ResetWebhookSubscriptionFailures(gomock.Any(), d.SubscriptionID).Return(nil)
+			},
+		},
+		{
+			name:       "retried with backoff",
+			status:     http.StatusInternalServerError,
+			attempts:   2,
+			wantStatus: entity.DeliveryPending,
+			wantNext:   now.Add(40 * time.Second),
+			mock: func(repo *webhookRepoMock.MockWebhookRepository, d *entity.WebhookDelivery) {
+				repo.EXPECT().RecordWebhookSubscriptionFailure(gomock.Any(), d.SubscriptionID).Return(3, nil)
+			},
+		},
+		{
+			name:       "failed after the last attempt",
+			status:     http.StatusBadRequest,
+			attempts:   7,
+			wantStatus: entity.DeliveryFailed,
+			mock: func(repo *webhookRepoMock.MockWebhookRepository, d *entity.WebhookDelivery) {
+				repo.EXPECT().RecordWebhookSubscriptionFailure(gomock.Any(), d.SubscriptionID).Return(8, nil)
+			},
+		},
+		{
+			name:       "webhook disabled after consecutive failures",
+			status:     http.StatusGone,
+			wantStatus: entity.DeliveryPending,
+			wantNext:   now.Add(10 * time.Second),
+			mock: func(repo *webhookRepoMock.MockWebhookRepository, d *entity.WebhookDelivery) {
+				repo.EXPECT().RecordWebhookSubscriptionFailure(gomock.Any(), d.SubscriptionID).Return(20, nil)
+				repo.EXPECT().DisableWebhookSubscription(gomock.Any(), d.SubscriptionID, now, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ time.Time, reason string) error {
+						assert.Contains(t, reason, "20 consecutive failed deliveries")
+						return nil
+					})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+			inTransaction := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// the delivery is sent outside of any transaction
+				assert.False(t, inTransaction)
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			repo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+			trx := trxMock.NewMockManager(ctrl)
+			// the claim and the result are recorded in their own transactions
+			trx.EXPECT().Do(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				inTransaction = true
+				defer func() { inTransaction = false }()
+				return fn(ctx)
+			})
+
+			delivery := newDelivery(server.URL, tt.attempts)
+			lockedUntil := now.Add(10 * time.Minute)
+			delivery.LockedUntil = &lockedUntil
+			repo.EXPECT().ClaimWebhookDeliveries(gomock.Any(), now, lockedUntil, 50).Return([]*entity.WebhookDelivery{delivery}, nil)
+			repo.EXPECT().UpdateWebhookDelivery(gomock.Any(), delivery).Return(nil)
+			tt.mock(repo, delivery)
+
+			d := New(repo, trx, server.Client(), DefaultConfig())
+			d.now = func() time.Time { return now }
+			claimed, err := d.DispatchBatch(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, claimed)
+
+			assert.Equal(t, tt.wantStatus, delivery.Status)
+			assert.Equal(t, tt.attempts+1, delivery.Attempts)
+			assert.Equal(t, tt.status, delivery.ResponseStatus)
+			assert.Nil(t, delivery.LockedUntil)
+			if !tt.wantNext.IsZero() {
+				assert.Equal(t, tt.wantNext, delivery.NextAttemptAt)
+			}
+
+			assert.Equal(t, delivery.ID.String(), received.Header.Get("X-Webhook-Id"))
+			assert.Equal(t, delivery.EventID.String(), received.Header.Get("X-Event-Id"))
+			assert.Equal(t, "StoreClosed", received.Header.Get("X-Event-Type"))
+			assert.Equal(t, delivery.Payload, string(body))
+			assert.NoError(t, Verify(secret, received.Header.Get(SignatureHeader), body, now, time.Minute))
+		})
+	}
+}
+
+func TestDispatcher_DispatchBatch_SkipsDisabledWebhook(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	repo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+	trx := trxMock.NewMockManager(ctrl)
+	trx.EXPECT().Do(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	first := newDelivery(server.URL, 0)
+	second := newDelivery(server.URL, 0)
+	second.SubscriptionID = first.SubscriptionID
+	second.Subscription = first.Subscription
+
+	repo.EXPECT().ClaimWebhookDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), 50).Return([]*entity.WebhookDelivery{first, second}, nil)
+	repo.EXPECT().UpdateWebhookDelivery(gomock.Any(), first).Return(nil)
+	repo.EXPECT().RecordWebhookSubscriptionFailure(gomock.Any(), first.SubscriptionID).Return(20, nil)
+	repo.EXPECT().DisableWebhookSubscription(gomock.Any(), first.SubscriptionID, gomock.Any(), gomock.Any()).Return(nil)
+	// the lease of the delivery which is not sent is released
+	repo.EXPECT().ReleaseWebhookDeliveries(gomock.Any(), []uuid.UUID{second.ID}).Return(nil)
+
+	claimed, err := New(repo, trx, server.Client(), DefaultConfig()).DispatchBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, claimed)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 0, second.Attempts)
+}
+
+func TestDispatcher_send_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	status, err := New(nil, nil, http.DefaultClient, DefaultConfig()).send(context.Background(), newDelivery(url, 0))
+	assert.Equal(t, 0, status)
+	assert.True(t, err != nil && strings.Contains(err.Error(), "connect"))
+}
@@ -0,0 +1,56 @@
+package dispatcher
+
+import (
+	"context"
+
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/publisher"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/repository"
+	"github.com/google/uuid"
+)
+
+type fanout struct {
+	repo repository.WebhookRepository
+}
+
+// NewFanout returns an outbox publisher which queues a delivery of every event for each webhook of
+// its store subscribed to it. The relay publishes outside of any transaction and publishes an event
+// again when it could not mark it as published, so the deliveries are not queued atomically with the
+// event. Queuing them again is harmless, a delivery is unique per subscription and event and the
+// duplicates are skipped.
+func NewFanout(repo repository.WebhookRepository) publisher.Publisher {
+	return &fanout{repo: repo}
+}
+
+func (f *fanout) Publish(ctx context.Context, event *outboxEntity.OutboxEvent) error {
+	if event.StoreID == uuid.Nil {
+		return nil
+	}
+
+	subs, err := f.repo.GetActiveWebhookSubscriptions(ctx, event.StoreID)
+	if err != nil {
+		return err
+	}
+
+	var envelope []byte
+	deliveries := []*entity.WebhookDelivery{}
+	for _, sub := range subs {
+		if !sub.Subscribes(event.EventType) {
+			continue
+		}
+		if envelope == nil {
+			if envelope, err = event.Envelope(); err != nil {
+				return err
+			}
+		}
+		deliveries = append(deliveries, &entity.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.EventType,
+			Payload:        string(envelope),
+			Status:         entity.DeliveryPending,
+		})
+	}
+	return f.repo.CreateWebhookDeliveries(ctx, deliveries)
+}
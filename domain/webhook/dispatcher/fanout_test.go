@@ -0,0 +1,56 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	webhookRepoMock "github.com/Mitra-Apps/be-store-service/domain/webhook/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFanout_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+	storeID := uuid.New()
+	event := &outboxEntity.OutboxEvent{
+		ID:            uuid.New(),
+		StoreID:       storeID,
+		EventType:     outboxEntity.StockChanged,
+		AggregateType: outboxEntity.AggregateProduct,
+		AggregateID:   uuid.NewString(),
+		Payload:       `{"stock":3}`,
+	}
+
+	all := &entity.WebhookSubscription{}
+	all.ID = uuid.New()
+	stock := &entity.WebhookSubscription{EventTypes: []string{outboxEntity.StockChanged}}
+	stock.ID = uuid.New()
+	store := &entity.WebhookSubscription{EventTypes: []string{outboxEntity.StoreClosed}}
+	store.ID = uuid.New()
+
+	repo.EXPECT().GetActiveWebhookSubscriptions(gomock.Any(), storeID).Return([]*entity.WebhookSubscription{all, stock, store}, nil)
+	repo.EXPECT().CreateWebhookDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, deliveries []*entity.WebhookDelivery) error {
+		assert.Len(t, deliveries, 2)
+		assert.Equal(t, all.ID, deliveries[0].SubscriptionID)
+		assert.Equal(t, stock.ID, deliveries[1].SubscriptionID)
+		for _, d := range deliveries {
+			assert.Equal(t, event.ID, d.EventID)
+			assert.Equal(t, entity.DeliveryPending, d.Status)
+			assert.Contains(t, d.Payload, `"payload":{"stock":3}`)
+		}
+		return nil
+	})
+
+	assert.NoError(t, NewFanout(repo).Publish(context.Background(), event))
+}
+
+func TestFanout_Publish_WithoutStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+
+	assert.NoError(t, NewFanout(repo).Publish(context.Background(), &outboxEntity.OutboxEvent{ID: uuid.New()}))
+}
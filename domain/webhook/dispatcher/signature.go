@@ -0,0 +1,60 @@
+package dispatcher
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries the signature of a delivery as t=<unix timestamp>,v1=<hex HMAC-SHA256>.
+// The HMAC is computed with the secret of the subscription over "<timestamp>.<body>", the timestamp
+// lets receivers reject replayed requests.
+const SignatureHeader = "X-Webhook-Signature"
+
+// Sign returns the value of SignatureHeader for a body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, computeSignature(secret, t, body))
+}
+
+// Verify checks a SignatureHeader value against the body, signatures older than tolerance are
+// rejected when tolerance is positive.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	if t == "" || v1 == "" {
+		return fmt.Errorf("malformed signature")
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed signature timestamp")
+	}
+	if tolerance > 0 && now.Sub(time.Unix(unix, 0)) > tolerance {
+		return fmt.Errorf("signature expired")
+	}
+	if !hmac.Equal([]byte(v1), []byte(computeSignature(secret, t, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+func computeSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
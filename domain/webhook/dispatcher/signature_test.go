@@ -0,0 +1,39 @@
+package dispatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	sentAt := time.Unix(1700000000, 0)
+	body := []byte(`{"type":"StoreUpdated"}`)
+	header := Sign("secret", sentAt, body)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr string
+	}{
+		{name: "valid", secret: "secret", header: header, body: body, now: sentAt.Add(time.Minute)},
+		{name: "other secret", secret: "other", header: header, body: body, now: sentAt, wantErr: "signature mismatch"},
+		{name: "tampered body", secret: "secret", header: header, body: []byte(`{"type":"StoreDeleted"}`), now: sentAt, wantErr: "signature mismatch"},
+		{name: "expired", secret: "secret", header: header, body: body, now: sentAt.Add(10 * time.Minute), wantErr: "signature expired"},
+		{name: "malformed", secret: "secret", header: "v1=abc", body: body, now: sentAt, wantErr: "malformed signature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
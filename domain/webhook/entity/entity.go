@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Status of a webhook delivery.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookSubscription is a webhook registered by a merchant for the events of their store.
+type WebhookSubscription struct {
+	base_model.BaseModel
+	StoreID uuid.UUID `gorm:"type:uuid;not null;index"`
+	URL     string    `gorm:"type:varchar(2048);not null"`
+	// Secret signs the deliveries, the merchant uses it to verify that a delivery comes from us
+	Secret string `gorm:"type:varchar(255);not null"`
+	// EventTypes are the events delivered to the webhook, every event is delivered when it is empty
+	EventTypes []string `gorm:"type:jsonb;not null;serializer:json"`
+	IsActive   bool     `gorm:"type:boolean;not null;default:true"`
+	// ConsecutiveFailures counts the failed attempts since the last successful delivery
+	ConsecutiveFailures int        `gorm:"type:int;not null;default:0"`
+	DisabledAt          *time.Time `gorm:"type:timestamptz"`
+	DisabledReason      string     `gorm:"type:text"`
+}
+
+// Subscribes reports whether the event type is delivered to the webhook.
+func (w *WebhookSubscription) Subscribes(eventType string) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ToProto returns the subscription without its secret, the secret is only handed out on creation.
+func (w *WebhookSubscription) ToProto() *pb.WebhookSubscription {
+	sub := &pb.WebhookSubscription{
+		Id:                  w.ID.String(),
+		StoreId:             w.StoreID.String(),
+		Url:                 w.URL,
+		EventTypes:          w.EventTypes,
+		IsActive:            w.IsActive,
+		ConsecutiveFailures: int32(w.ConsecutiveFailures),
+		DisabledReason:      w.DisabledReason,
+		CreatedAt:           timestamppb.New(w.CreatedAt),
+	}
+	if w.DisabledAt != nil {
+		sub.DisabledAt = timestamppb.New(*w.DisabledAt)
+	}
+	if !w.UpdatedAt.IsZero() {
+		sub.UpdatedAt = timestamppb.New(w.UpdatedAt)
+	}
+	return sub
+}
+
+// WebhookDelivery is the delivery of an event to a webhook. It is kept after the event is delivered
+// as the delivery log of the webhook, and can be replayed.
+type WebhookDelivery struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	SubscriptionID uuid.UUID `gorm:"type:uuid;not null"`
+	EventID        uuid.UUID `gorm:"type:uuid;not null"`
+	EventType      string    `gorm:"type:varchar(100);not null"`
+	// Payload is the envelope of the event, it is sent as the request body
+	Payload       string    `gorm:"type:jsonb;not null"`
+	Status        string    `gorm:"type:varchar(20);not null;default:pending"`
+	Attempts      int       `gorm:"type:int;not null;default:0"`
+	NextAttemptAt time.Time `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+	// LockedUntil is when the lease of the dispatcher sending the delivery ends
+	LockedUntil    *time.Time `gorm:"type:timestamptz"`
+	ResponseStatus int        `gorm:"type:int;not null;default:0"`
+	LastError      string     `gorm:"type:text"`
+	DeliveredAt    *time.Time `gorm:"type:timestamptz"`
+	CreatedAt      time.Time  `gorm:"type:timestamptz;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time  `gorm:"type:timestamptz"`
+
+	Subscription *WebhookSubscription `gorm:"foreignKey:SubscriptionID"`
+}
+
+func (d *WebhookDelivery) ToProto() *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{
+		Id:             d.ID.String(),
+		SubscriptionId: d.SubscriptionID.String(),
+		EventId:        d.EventID.String(),
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		ResponseStatus: int32(d.ResponseStatus),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == DeliveryPending {
+		delivery.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return delivery
+}
@@ -0,0 +1,260 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/webhook/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=domain/webhook/repository/repository.go -destination=domain/webhook/repository/mock/repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) ClaimWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, now, lockedUntil, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimWebhookDeliveries(ctx, now, lockedUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimWebhookDeliveries), ctx, now, lockedUntil, limit)
+}
+
+// CreateWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDeliveries indicates an expected call of CreateWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookDeliveries(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookDeliveries), ctx, deliveries)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) CreateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookSubscription(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookSubscription), ctx, sub)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteWebhookSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteWebhookSubscription), ctx, id)
+}
+
+// DisableWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) DisableWebhookSubscription(ctx context.Context, id uuid.UUID, disabledAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableWebhookSubscription", ctx, id, disabledAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableWebhookSubscription indicates an expected call of DisableWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DisableWebhookSubscription(ctx, id, disabledAt, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DisableWebhookSubscription), ctx, id, disabledAt, reason)
+}
+
+// GetActiveWebhookSubscriptions mocks base method.
+func (m *MockWebhookRepository) GetActiveWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveWebhookSubscriptions", ctx, storeID)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveWebhookSubscriptions indicates an expected call of GetActiveWebhookSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) GetActiveWebhookSubscriptions(ctx, storeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveWebhookSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).GetActiveWebhookSubscriptions), ctx, storeID)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookDelivery), ctx, id)
+}
+
+// GetWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) GetWebhookSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscription", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscription indicates an expected call of GetWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookSubscription), ctx, id)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, subscriptionID, page, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ListWebhookDeliveries(ctx, subscriptionID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListWebhookDeliveries), ctx, subscriptionID, page, limit)
+}
+
+// ListWebhookSubscriptions mocks base method.
+func (m *MockWebhookRepository) ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptions", ctx, storeID)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptions indicates an expected call of ListWebhookSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) ListWebhookSubscriptions(ctx, storeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).ListWebhookSubscriptions), ctx, storeID)
+}
+
+// RecordWebhookSubscriptionFailure mocks base method.
+func (m *MockWebhookRepository) RecordWebhookSubscriptionFailure(ctx context.Context, id uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookSubscriptionFailure", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWebhookSubscriptionFailure indicates an expected call of RecordWebhookSubscriptionFailure.
+func (mr *MockWebhookRepositoryMockRecorder) RecordWebhookSubscriptionFailure(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookSubscriptionFailure", reflect.TypeOf((*MockWebhookRepository)(nil).RecordWebhookSubscriptionFailure), ctx, id)
+}
+
+// ReleaseWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) ReleaseWebhookDeliveries(ctx context.Context, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWebhookDeliveries", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWebhookDeliveries indicates an expected call of ReleaseWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ReleaseWebhookDeliveries(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ReleaseWebhookDeliveries), ctx, ids)
+}
+
+// ResetWebhookSubscriptionFailures mocks base method.
+func (m *MockWebhookRepository) ResetWebhookSubscriptionFailures(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWebhookSubscriptionFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWebhookSubscriptionFailures indicates an expected call of ResetWebhookSubscriptionFailures.
+func (mr *MockWebhookRepositoryMockRecorder) ResetWebhookSubscriptionFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookSubscriptionFailures", reflect.TypeOf((*MockWebhookRepository)(nil).ResetWebhookSubscriptionFailures), ctx, id)
+}
+
+// UpdateWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateWebhookDelivery), ctx, delivery)
+}
+
+// UpdateWebhookSubscription mocks base method.
+func (m *MockWebhookRepository) UpdateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookSubscription", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookSubscription indicates an expected call of UpdateWebhookSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateWebhookSubscription(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateWebhookSubscription), ctx, sub)
+}
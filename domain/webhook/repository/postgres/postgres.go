@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgres struct {
+	db *gorm.DB
+}
+
+func NewPostgres(db *gorm.DB) repository.WebhookRepository {
+	return &postgres{db}
+}
+
+func (p *postgres) CreateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error {
+	return transaction.DB(ctx, p.db).Create(sub).Error
+}
+
+func (p *postgres) GetWebhookSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	sub := &entity.WebhookSubscription{}
+	if err := transaction.DB(ctx, p.db).First(sub, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (p *postgres) ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error) {
+	subs := []*entity.WebhookSubscription{}
+	if err := transaction.DB(ctx, p.db).Where("store_id = ?", storeID).Order("created_at").Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+func (p *postgres) GetActiveWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error) {
+	subs := []*entity.WebhookSubscription{}
+	if err := transaction.DB(ctx, p.db).Where("store_id = ? AND is_active", storeID).Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+func (p *postgres) UpdateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error {
+	return transaction.DB(ctx, p.db).Save(sub).Error
+}
+
+func (p *postgres) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	return transaction.DB(ctx, p.db).Where("id = ?", id).Delete(&entity.WebhookSubscription{}).Error
+}
+
+func (p *postgres) RecordWebhookSubscriptionFailure(ctx context.Context, id uuid.UUID) (int, error) {
+	tx := transaction.DB(ctx, p.db)
+	err := tx.Model(&entity.WebhookSubscription{}).
+		Where("id = ?", id).
+		UpdateColumn("consecutive_failures", gorm.Expr("consecutive_failures + 1")).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	var failures int
+	err = tx.Model(&entity.WebhookSubscription{}).
+		Where("id = ?", id).
+		Select("consecutive_failures").
+		Scan(&failures).
+		Error
+	return failures, err
+}
+
+func (p *postgres) ResetWebhookSubscriptionFailures(ctx context.Context, id uuid.UUID) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.WebhookSubscription{}).
+		Where("id = ? AND consecutive_failures > 0", id).
+		UpdateColumn("consecutive_failures", 0).
+		Error
+}
+
+func (p *postgres) DisableWebhookSubscription(ctx context.Context, id uuid.UUID, disabledAt time.Time, reason string) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.WebhookSubscription{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"is_active":       false,
+			"disabled_at":     disabledAt,
+			"disabled_reason": reason,
+		}).
+		Error
+}
+
+func (p *postgres) CreateWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return transaction.DB(ctx, p.db).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}}, DoNothing: true}).
+		Create(deliveries).
+		Error
+}
+
+func (p *postgres) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	delivery := &entity.WebhookDelivery{}
+	if err := transaction.DB(ctx, p.db).First(delivery, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (p *postgres) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, error) {
+	deliveries := []*entity.WebhookDelivery{}
+	err := transaction.DB(ctx, p.db).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&deliveries).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (p *postgres) ClaimWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	tx := transaction.DB(ctx, p.db)
+	deliveries := []*entity.WebhookDelivery{}
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "webhook_deliveries"}, Options: "SKIP LOCKED"}).
+		Joins("Subscription").
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", entity.DeliveryPending, now).
+		Where("webhook_deliveries.locked_until IS NULL OR webhook_deliveries.locked_until <= ?", now).
+		Where(`"Subscription".is_active`).
+		Order("webhook_deliveries.created_at").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+	if err != nil || len(deliveries) == 0 {
+		return deliveries, err
+	}
+
+	ids := make([]uuid.UUID, len(deliveries))
+	for i, d := range deliveries {
+		ids[i] = d.ID
+		d.LockedUntil = &lockedUntil
+	}
+	err = tx.Model(&entity.WebhookDelivery{}).
+		Where("id IN ?", ids).
+		UpdateColumn("locked_until", lockedUntil).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (p *postgres) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return transaction.DB(ctx, p.db).Omit(clause.Associations).Save(delivery).Error
+}
+
+func (p *postgres) ReleaseWebhookDeliveries(ctx context.Context, ids []uuid.UUID) error {
+	return transaction.DB(ctx, p.db).
+		Model(&entity.WebhookDelivery{}).
+		Where("id IN ?", ids).
+		UpdateColumn("locked_until", nil).
+		Error
+}
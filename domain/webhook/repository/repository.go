@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/google/uuid"
+)
+
+// WebhookRepository stores the webhooks of the stores and their deliveries.
+type WebhookRepository interface {
+	CreateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error
+	// GetWebhookSubscription returns nil when the subscription does not exist.
+	GetWebhookSubscription(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error)
+	// GetActiveWebhookSubscriptions returns the enabled subscriptions of a store.
+	GetActiveWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, sub *entity.WebhookSubscription) error
+	DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error
+
+	// RecordWebhookSubscriptionFailure increments the consecutive failures of the subscription and
+	// returns the new count.
+	RecordWebhookSubscriptionFailure(ctx context.Context, id uuid.UUID) (int, error)
+	// ResetWebhookSubscriptionFailures clears the consecutive failures after a successful delivery.
+	ResetWebhookSubscriptionFailures(ctx context.Context, id uuid.UUID) error
+	DisableWebhookSubscription(ctx context.Context, id uuid.UUID, disabledAt time.Time, reason string) error
+
+	// CreateWebhookDeliveries adds deliveries, a delivery of an event which already exists for the
+	// subscription is skipped so events published again are not delivered twice.
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+	// GetWebhookDelivery returns nil when the delivery does not exist.
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, page, limit int) ([]*entity.WebhookDelivery, error)
+	// ClaimWebhookDeliveries leases up to limit pending deliveries of enabled subscriptions which are
+	// due at now until lockedUntil, the oldest first, with their subscription. Deliveries leased by
+	// another dispatcher are skipped.
+	ClaimWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	// ReleaseWebhookDeliveries ends the lease of deliveries which were claimed but not sent.
+	ReleaseWebhookDeliveries(ctx context.Context, ids []uuid.UUID) error
+}
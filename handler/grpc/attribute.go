@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"google.golang.org/grpc/codes"
+)
+
+func (g *GrpcRoute) UpsertProductAttributeDefinition(ctx context.Context, req *pb.UpsertProductAttributeDefinitionRequest) (*pb.ProductAttributeDefinitionResponse, error) {
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	definition := &prodEntity.ProductAttributeDefinition{}
+	req.Attribute.ProductTypeId = req.ProductTypeId
+	definition.FromProto(req.Attribute)
+	saved, err := g.service.UpsertProductAttributeDefinition(ctx, claims.UserID, definition)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProductAttributeDefinitionResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    saved.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) ListProductAttributeDefinitions(ctx context.Context, req *pb.ListProductAttributeDefinitionsRequest) (*pb.ListProductAttributeDefinitionsResponse, error) {
+	definitions, err := g.service.ListProductAttributeDefinitions(ctx, req.ProductTypeId)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.ListProductAttributeDefinitionsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, d := range definitions {
+		result.Data = append(result.Data, d.ToProto())
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func parseUUIDs(values []string, name string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(values))
+	for i, v := range values {
+		id, err := parseUUID(v, fmt.Sprintf("%s[%d]", name, i))
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (g *GrpcRoute) BatchGetStores(ctx context.Context, req *pb.BatchGetStoresRequest) (*pb.BatchGetStoresResponse, error) {
+	ids, err := parseUUIDs(req.Ids, "ids")
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := g.service.BatchGetStores(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.BatchGetStoresResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for i, store := range stores {
+		item := &pb.BatchGetStoreResult{Id: req.Ids[i]}
+		if store != nil {
+			item.Found = true
+			item.Store = store.ToProto()
+		}
+		result.Data = append(result.Data, item)
+	}
+	return result, nil
+}
+
+func (g *GrpcRoute) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsResponse, error) {
+	ids, err := parseUUIDs(req.Ids, "ids")
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := g.service.BatchGetProducts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.BatchGetProductsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for i, p := range products {
+		item := &pb.BatchGetProductResult{Id: req.Ids[i]}
+		if p != nil {
+			item.Found = true
+			item.Product = p.ToProto()
+		}
+		result.Data = append(result.Data, item)
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"google.golang.org/grpc/codes"
+)
+
+func (g *GrpcRoute) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	categories, err := g.service.GetCategoryTree(ctx, req.RootId, req.IsIncludeDeactivated)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.GetCategoryTreeResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, c := range categories {
+		result.Data = append(result.Data, c.ToTreeProto())
+	}
+	return result, nil
+}
+
+func (g *GrpcRoute) MoveProductCategory(ctx context.Context, req *pb.MoveProductCategoryRequest) (*pb.ProductCategoryResponse, error) {
+	if req.Id == 0 {
+		return nil, storeerror.Required("id")
+	}
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	var parentID *int64
+	if req.ParentId != 0 {
+		parentID = &req.ParentId
+	}
+	category, err := g.service.MoveProductCategory(ctx, claims.UserID, req.Id, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ProductCategoryResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    category.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) RenameProductCategory(ctx context.Context, req *pb.RenameProductCategoryRequest) (*pb.ProductCategoryResponse, error) {
+	if req.Id == 0 {
+		return nil, storeerror.Required("id")
+	}
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	category, err := g.service.RenameProductCategory(ctx, claims.UserID, req.Id, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ProductCategoryResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    category.ToProto(),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// ifMatchKeys are the metadata keys of the If-Match header, the HTTP gateway forwards it with the
+// grpcgateway- prefix while gRPC clients may send it as is.
+var ifMatchKeys = []string{"grpcgateway-if-match", "if-match"}
+
+// requestVersion returns the version an update is based on. It is taken from the If-Match header
+// when one is sent, otherwise the version of the request body is used.
+func requestVersion(ctx context.Context, bodyVersion int64) (int64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range ifMatchKeys {
+		values := md.Get(key)
+		if len(values) == 0 {
+			continue
+		}
+		version, err := parseETag(values[0])
+		if err != nil {
+			return 0, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_INVALID_ETAG)
+		}
+		if bodyVersion != 0 && bodyVersion != version {
+			return 0, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_ETAG_DOES_NOT_MATCH_VERSION)
+		}
+		return version, nil
+	}
+	return bodyVersion, nil
+}
+
+// setETag sends the version as etag header, the HTTP gateway returns it as the ETag header.
+func setETag(ctx context.Context, version int64) {
+	// SetHeader only fails when ctx does not belong to a server call
+	grpc.SetHeader(ctx, metadata.Pairs("etag", formatETag(version)))
+}
+
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+func parseETag(etag string) (int64, error) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func Test_requestVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		md              metadata.MD
+		bodyVersion     int64
+		expectedVersion int64
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "NoIfMatch_BodyVersionUsed",
+			bodyVersion:     3,
+			expectedVersion: 3,
+		},
+		{
+			name:            "GatewayIfMatch_VersionFromHeader",
+			md:              metadata.Pairs("grpcgateway-if-match", `"4"`),
+			expectedVersion: 4,
+		},
+		{
+			name:            "WeakIfMatch_VersionFromHeader",
+			md:              metadata.Pairs("if-match", `W/"5"`),
+			bodyVersion:     5,
+			expectedVersion: 5,
+		},
+		{
+			name:         "IfMatchDiffersFromBody_InvalidArgument",
+			md:           metadata.Pairs("grpcgateway-if-match", `"4"`),
+			bodyVersion:  3,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "InvalidIfMatch_InvalidArgument",
+			md:           metadata.Pairs("grpcgateway-if-match", "*"),
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			version, err := requestVersion(ctx, tt.bodyVersion)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedVersion, version)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"errors"
+	"strings"
+)
+
+// validateMasked validates msg like ValidateAll but only reports the fields listed in paths, the other
+// fields are left out of a partial update and keep their stored value. Every field is validated when
+// paths is empty.
+func validateMasked(msg interface{ ValidateAll() error }, paths []string) error {
+	err := msg.ValidateAll()
+	multi, ok := err.(interface{ AllErrors() []error })
+	if !ok || len(paths) == 0 {
+		return err
+	}
+
+	// validation errors name the go field, e.g. StoreName for store_name or Images[0] for images
+	listed := make(map[string]bool)
+	for _, p := range paths {
+		listed[strings.ReplaceAll(p, "_", "")] = true
+	}
+
+	var errs []error
+	for _, e := range multi.AllErrors() {
+		fieldErr, ok := e.(interface{ Field() string })
+		if !ok {
+			errs = append(errs, e)
+			continue
+		}
+		field, _, _ := strings.Cut(fieldErr.Field(), "[")
+		if listed[strings.ToLower(field)] {
+			errs = append(errs, e)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	serviceMock "github.com/Mitra-Apps/be-store-service/service/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const productID = "7d56be32-70a2-4f49-b66b-63e6f8e719d7"
+
+func Test_validateMasked(t *testing.T) {
+	// only the phone is sent, the other required fields are left out
+	store := &pb.Store{Phone: "08123456789"}
+
+	assert.Error(t, validateMasked(store, nil))
+	assert.NoError(t, validateMasked(store, []string{"phone", "city"}))
+	assert.Error(t, validateMasked(store, []string{"phone", "store_name"}))
+	assert.Error(t, validateMasked(&pb.Store{Images: []*pb.StoreImage{{}}}, []string{"images"}))
+}
+
+func TestGrpcRoute_UpdateProduct_PartialUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svcMock := serviceMock.NewMockService(ctrl)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID))
+	productIDUuid := uuid.MustParse(productID)
+
+	// the handler leaves the merge with the stored product to the service
+	svcMock.EXPECT().GetProductById(gomock.Any(), gomock.Any()).Times(0)
+	svcMock.EXPECT().UpsertProducts(ctx, uuid.MustParse(userID), gomock.Any(), uuid.Nil, true, []string{"price"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ []string, _ uuid.UUID, _ bool, _ []string, products ...*prodEntity.Product) error {
+			assert.Len(t, products, 1)
+			assert.Equal(t, productIDUuid, products[0].ID)
+			assert.Equal(t, prodEntity.MoneyFromFloat(3500, prodEntity.DefaultCurrency), products[0].Price)
+			assert.Equal(t, int64(3), products[0].Version)
+			return nil
+		})
+
+	s := &GrpcRoute{service: svcMock}
+	_, err := s.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		ProductId:  productID,
+		Product:    &pb.Product{Price: 3500, Version: 3},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+	})
+	assert.NoError(t, err)
+}
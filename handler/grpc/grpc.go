@@ -5,18 +5,18 @@ import (
 	"fmt"
 	"strings"
 
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
 	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
 	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
 	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
 	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"github.com/Mitra-Apps/be-store-service/lib"
 	"github.com/Mitra-Apps/be-store-service/service"
-	util "github.com/Mitra-Apps/be-utility-service/service"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -32,29 +32,6 @@ func New(service service.Service) pb.StoreServiceServer {
 }
 
 func (s *GrpcRoute) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.CreateStoreResponse, error) {
-	if err := req.ValidateAll(); err != nil {
-		st := status.New(codes.InvalidArgument, "Error when validating request")
-		if multiErrs, ok := err.(pb.CreateStoreRequestMultiError); ok {
-			for _, multiErr := range multiErrs {
-				if validationErr, ok := multiErr.(pb.CreateStoreRequestValidationError); ok {
-					// print type of validationErr error
-					fmt.Printf("%T\n", validationErr.Cause())
-					detail := errdetails.BadRequest{
-						FieldViolations: []*errdetails.BadRequest_FieldViolation{
-							{
-								Field:       validationErr.Field(),
-								Description: validationErr.Cause().Error(),
-							},
-						},
-					}
-					st, _ = st.WithDetails(&detail)
-				}
-			}
-		}
-
-		return nil, st.Err()
-	}
-
 	storeHoursErr := validateStoreHours(req.Store.Hours)
 	if storeHoursErr != nil {
 		return nil, storeHoursErr
@@ -78,10 +55,6 @@ func (s *GrpcRoute) CreateStore(ctx context.Context, req *pb.CreateStoreRequest)
 }
 
 func (s *GrpcRoute) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.GetStoreResponse, error) {
-	if err := req.ValidateAll(); err != nil {
-		return nil, err
-	}
-
 	store, err := s.service.GetStore(ctx, req.StoreId)
 	if err != nil {
 		return nil, err
@@ -95,6 +68,7 @@ func (s *GrpcRoute) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.
 		}, nil
 	}
 
+	setETag(ctx, store.Version)
 	return &pb.GetStoreResponse{
 		Code:    int32(codes.OK),
 		Message: codes.OK.String(),
@@ -103,8 +77,12 @@ func (s *GrpcRoute) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.
 }
 
 func (s *GrpcRoute) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.UpdateStoreResponse, error) {
-	if err := req.ValidateAll(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	// the Validation interceptor leaves the partial updates to the handler
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) > 0 {
+		if err := validateMasked(req.GetStore(), paths); err != nil {
+			return nil, storeerror.FromMessageValidation("store", err)
+		}
 	}
 
 	storeHoursErr := validateStoreHours(req.Store.Hours)
@@ -116,12 +94,18 @@ func (s *GrpcRoute) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest)
 	if err := store.FromProto(req.Store); err != nil {
 		return nil, err
 	}
+	version, err := requestVersion(ctx, store.Version)
+	if err != nil {
+		return nil, err
+	}
+	store.Version = version
 
-	data, err := s.service.UpdateStore(ctx, req.StoreId, store)
+	data, err := s.service.UpdateStore(ctx, req.StoreId, store, paths)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, data.Version)
 	return &pb.UpdateStoreResponse{
 		Code:    int32(codes.OK),
 		Message: codes.OK.String(),
@@ -133,7 +117,7 @@ func (s *GrpcRoute) DeleteStore(ctx context.Context, req *pb.DeleteStoreRequest)
 	for _, id := range req.GetIds() {
 		_, err := uuid.Parse(id)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Error when parsing store id to uuid")
+			return nil, storeerror.InvalidID("ids")
 		}
 	}
 
@@ -141,10 +125,6 @@ func (s *GrpcRoute) DeleteStore(ctx context.Context, req *pb.DeleteStoreRequest)
 }
 
 func (s *GrpcRoute) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
 	stores, err := s.service.ListStores(ctx, 1, 20)
 	if err != nil {
 		return nil, err
@@ -165,7 +145,7 @@ func (s *GrpcRoute) ListStores(ctx context.Context, req *pb.ListStoresRequest) (
 func (s *GrpcRoute) GetStoreByUserID(ctx context.Context, req *pb.GetStoreByUserIDRequest) (*pb.GetStoreByUserIDResponse, error) {
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 	store, err := s.service.GetStoreByUserID(ctx, claims.UserID)
 	if err != nil {
@@ -187,13 +167,9 @@ func (s *GrpcRoute) GetStoreByUserID(ctx context.Context, req *pb.GetStoreByUser
 }
 
 func (s *GrpcRoute) OpenCloseStore(ctx context.Context, req *pb.OpenCloseStoreRequest) (*pb.OpenCloseStoreResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	err = s.service.OpenCloseStore(ctx, claims.UserID, claims.RoleNames, req.StoreId, req.IsActive)
@@ -209,28 +185,29 @@ func (s *GrpcRoute) OpenCloseStore(ctx context.Context, req *pb.OpenCloseStoreRe
 func (s *GrpcRoute) InsertProducts(ctx context.Context, req *pb.InsertProductsRequest) (*pb.GenericResponse, error) {
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, util.NewError(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN.String(), "Error saat mendapatkan claims dari jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	productList := []*prodEntity.Product{}
-	for _, p := range req.ProductList {
+	violations := []*storeerror.Violation{}
+	for i, p := range req.ProductList {
 		pr := prodEntity.Product{}
 		if err := pr.FromProto(p, &req.StoreId); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			return nil, err
 		}
 		productList = append(productList, &pr)
+		violations = append(violations, validateProduct(fmt.Sprintf("product_list[%d]", i), &pr, nil)...)
 	}
-
-	if err := validateProduct(productList...); err != nil {
-		return nil, err
+	if len(violations) > 0 {
+		return nil, storeerror.BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT, violations...)
 	}
 
 	storeIdUuid, err := uuid.Parse(req.StoreId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, storeerror.InvalidID("store_id")
 	}
 
-	err = s.service.UpsertProducts(ctx, claims.UserID, claims.RoleNames, storeIdUuid, false, productList...)
+	err = s.service.UpsertProducts(ctx, claims.UserID, claims.RoleNames, storeIdUuid, false, nil, productList...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,27 +221,39 @@ func (s *GrpcRoute) InsertProducts(ctx context.Context, req *pb.InsertProductsRe
 func (s *GrpcRoute) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.GenericResponse, error) {
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, util.NewError(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN.String(), "Error saat mendapatkan claims dari jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	// the Validation interceptor leaves the partial updates to the handler
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) > 0 {
+		if err := validateMasked(req.GetProduct(), paths); err != nil {
+			return nil, storeerror.FromMessageValidation("product", err)
+		}
 	}
 
 	product := &prodEntity.Product{}
 	req.Product.Id = req.ProductId
 	if err := product.FromProto(req.Product, nil); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
-	}
-	if err := validateProduct(product); err != nil {
 		return nil, err
 	}
+	// the service merges the fields which are not listed in the mask with the stored product
+	if violations := validateProduct("product", product, paths); len(violations) > 0 {
+		return nil, storeerror.BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT, violations...)
+	}
 
+	product.Version, err = requestVersion(ctx, product.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
-	err = s.service.UpsertProducts(ctx, claims.UserID, claims.RoleNames, product.StoreID, true, product)
+	err = s.service.UpsertProducts(ctx, claims.UserID, claims.RoleNames, product.StoreID, true, paths, product)
 	if err != nil {
 		return nil, err
 	}
 
+	setETag(ctx, product.Version)
+
 	return &pb.GenericResponse{
 		Code:    int32(codes.OK),
 		Message: codes.OK.String(),
@@ -274,43 +263,35 @@ func (s *GrpcRoute) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequ
 func (s *GrpcRoute) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*empty.Empty, error) {
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, util.NewError(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN.String(), "Error saat mendapatkan claims dari jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	id, err := uuid.Parse(req.GetProductId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Error when parsing store id to uuid")
+		return nil, storeerror.InvalidID("product_id")
 	}
 
 	return &emptypb.Empty{}, s.service.DeleteProductById(ctx, claims.UserID, id)
 }
 
-func validateProduct(products ...*prodEntity.Product) error {
-	for _, p := range products {
-		if p.Name == "" {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_NAME_IS_REQUIRED.String(), "Nama tidak boleh kosong")
-		}
-		if p.Price <= 0 {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRICE_IS_REQUIRED.String(), "Harga tidak boleh kosong")
-		}
-		if p.Uom == "" {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan")
-		}
-		if p.ProductTypeID == 0 {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED.String(), "product type diperlukan")
-		}
+// validateProduct returns the violations of the rules of the product named field which its validation
+// annotations can not express, e.g. the price is sent in price or in price_money, or the check digit of
+// the barcode. Only the fields listed in paths are validated, every field when paths is empty.
+func validateProduct(field string, p *prodEntity.Product, paths []string) []*storeerror.Violation {
+	violations := []*storeerror.Violation{}
+	if (lib.InFieldMask(paths, "price") || lib.InFieldMask(paths, "price_money")) && p.Price.MinorUnits <= 0 {
+		violations = append(violations, storeerror.Field(field+".price", errPb.StoreErrorCode_PRICE_IS_REQUIRED))
 	}
-	return nil
-}
-
-func (g *GrpcRoute) UpsertUnitOfMeasure(ctx context.Context, req *pb.UpsertUnitOfMeasureRequest) (*pb.UpsertUnitOfMeasureResponse, error) {
-	if req.Uom.Name == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	if (lib.InFieldMask(paths, "uom_id") || lib.InFieldMask(paths, "uom")) && p.UomID == 0 && p.Uom == "" {
+		violations = append(violations, storeerror.Field(field+".uom_id", errPb.StoreErrorCode_UOM_IS_REQUIRED))
 	}
-	if req.Uom.Symbol == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	if lib.InFieldMask(paths, "barcode") && p.Barcode != "" && !prodEntity.ValidBarcodeCheckDigit(p.Barcode) {
+		violations = append(violations, storeerror.Field(field+".barcode", errPb.StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT, p.Barcode))
 	}
+	return violations
+}
 
+func (g *GrpcRoute) UpsertUnitOfMeasure(ctx context.Context, req *pb.UpsertUnitOfMeasureRequest) (*pb.UpsertUnitOfMeasureResponse, error) {
 	uom := prodEntity.UnitOfMeasure{}
 	if err := uom.FromProto(req.Uom); err != nil {
 		return nil, err
@@ -318,7 +299,7 @@ func (g *GrpcRoute) UpsertUnitOfMeasure(ctx context.Context, req *pb.UpsertUnitO
 
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 	uom.CreatedBy = claims.UserID
 
@@ -333,10 +314,6 @@ func (g *GrpcRoute) UpsertUnitOfMeasure(ctx context.Context, req *pb.UpsertUnitO
 }
 
 func (g *GrpcRoute) UpdateUnitOfMeasure(ctx context.Context, req *pb.UpdateUnitOfMeasureRequest) (*pb.UpdateUnitOfMeasureResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
 	uom := prodEntity.UnitOfMeasure{}
 	if err := uom.FromProto(req.Uom); err != nil {
 		return nil, err
@@ -344,7 +321,7 @@ func (g *GrpcRoute) UpdateUnitOfMeasure(ctx context.Context, req *pb.UpdateUnitO
 
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 	uom.UpdatedBy = claims.UserID
 
@@ -363,30 +340,9 @@ func (g *GrpcRoute) UpsertProductCategory(ctx context.Context, req *pb.UpsertPro
 		req.ProductCategory.Id = req.GetId()
 	}
 
-	if err := req.ValidateAll(); err != nil {
-		st := status.New(codes.InvalidArgument, "Error when validating request")
-		if multiErrs, ok := err.(pb.UpsertProductCategoryRequestMultiError); ok {
-			for _, multiErr := range multiErrs {
-				if validationErr, ok := multiErr.(pb.UpsertProductCategoryRequestValidationError); ok {
-					detail := errdetails.BadRequest{
-						FieldViolations: []*errdetails.BadRequest_FieldViolation{
-							{
-								Field:       validationErr.Field(),
-								Description: validationErr.Cause().Error(),
-							},
-						},
-					}
-					st, _ = st.WithDetails(&detail)
-				}
-			}
-		}
-
-		return nil, st.Err()
-	}
-
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	prodCat := new(prodEntity.ProductCategory)
@@ -405,21 +361,13 @@ func (g *GrpcRoute) UpsertProductCategory(ctx context.Context, req *pb.UpsertPro
 
 func (g *GrpcRoute) UpdateProductCategory(ctx context.Context, req *pb.UpsertProductCategoryRequest) (*pb.UpsertProductCategoryResponse, error) {
 	if req.GetId() == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+		return nil, storeerror.Required("id")
 	}
 
 	return g.UpsertProductCategory(ctx, req)
 }
 
 func (g *GrpcRoute) UpsertProductType(ctx context.Context, req *pb.UpsertProductTypeRequest) (*pb.UpsertProductTypeResponse, error) {
-	if req.ProductType.Name == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "name is required")
-	}
-
-	if req.ProductType.ProductCategoryId == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "product category id is required")
-	}
-
 	prodType := prodEntity.ProductType{}
 	if err := prodType.FromProto(req.ProductType); err != nil {
 		return nil, err
@@ -427,7 +375,7 @@ func (g *GrpcRoute) UpsertProductType(ctx context.Context, req *pb.UpsertProduct
 
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting claims from jwt token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 	prodType.CreatedBy = claims.UserID
 
@@ -460,12 +408,35 @@ func (g *GrpcRoute) GetUnitOfMeasures(ctx context.Context, req *pb.GetUnitOfMeas
 func (g *GrpcRoute) GetProductById(ctx context.Context, req *pb.GetProductByIdRequest) (*pb.GetProductByIdResponse, error) {
 	prodId, err := uuid.Parse(req.ProductId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Error when parsing product id to uuid")
+		return nil, storeerror.InvalidID("product_id")
 	}
 	prod, err := g.service.GetProductById(ctx, prodId)
 	if err != nil {
 		return nil, err
 	}
+	if prod != nil {
+		setETag(ctx, prod.Version)
+	}
+	return &pb.GetProductByIdResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    prod.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) GetProductByBarcode(ctx context.Context, req *pb.GetProductByBarcodeRequest) (*pb.GetProductByIdResponse, error) {
+	storeId, err := uuid.Parse(req.StoreId)
+	if err != nil {
+		return nil, storeerror.InvalidID("store_id")
+	}
+	if !prodEntity.ValidBarcodeCheckDigit(req.Barcode) {
+		return nil, storeerror.Invalid("barcode", errPb.StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT, req.Barcode)
+	}
+	prod, err := g.service.GetProductByBarcode(ctx, storeId, req.Barcode)
+	if err != nil {
+		return nil, err
+	}
+	setETag(ctx, prod.Version)
 	return &pb.GetProductByIdResponse{
 		Code:    int32(codes.OK),
 		Message: codes.OK.String(),
@@ -475,17 +446,20 @@ func (g *GrpcRoute) GetProductById(ctx context.Context, req *pb.GetProductByIdRe
 
 func (g *GrpcRoute) GetProductList(ctx context.Context, req *pb.GetProductListRequest) (*pb.GetProductListResponse, error) {
 	if strings.Trim(req.StoreId, " ") == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Store id is required")
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED)
 	}
 	storeId, err := uuid.Parse(req.StoreId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Error when parsing store id to uuid")
+		return nil, storeerror.InvalidID("store_id")
 	}
-	var productTypeId *int64
+	var productTypeId, productCategoryId *int64
 	if req.ProductTypeId != 0 {
 		productTypeId = &req.ProductTypeId
 	}
-	products, err := g.service.GetProductsByStoreId(ctx, storeId, productTypeId, req.IsIncludeDeactivated)
+	if req.ProductCategoryId != 0 {
+		productCategoryId = &req.ProductCategoryId
+	}
+	products, err := g.service.GetProductsByStoreId(ctx, storeId, productTypeId, productCategoryId, req.Attributes, req.IsIncludeDeactivated)
 	if err != nil {
 		return nil, err
 	}
@@ -509,12 +483,19 @@ func (g *GrpcRoute) GetProductCategories(ctx context.Context, req *pb.GetProduct
 	for _, u := range cat {
 		cats = append(cats, u.ToProto())
 	}
+	uomNames := []string{}
+	uoms := []*pb.UnitOfMeasure{}
+	for _, u := range uom {
+		uomNames = append(uomNames, u.Name)
+		uoms = append(uoms, u.ToProto())
+	}
 	return &pb.GetProductCategoriesResponse{
 		Code:    int32(codes.OK),
 		Message: codes.OK.String(),
 		Data: &pb.GetProductCategoriesResponseItem{
 			ProductCategory: cats,
-			Uom:             uom,
+			Uom:             uomNames,
+			UnitOfMeasures:  uoms,
 		},
 	}, nil
 }
@@ -535,6 +516,42 @@ func (g *GrpcRoute) GetProductTypes(ctx context.Context, req *pb.GetProductTypes
 	}, nil
 }
 
+func (g *GrpcRoute) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	filter := auditEntity.AuditEventFilter{
+		EntityType: req.EntityType,
+		EntityID:   req.EntityId,
+		Page:       int(req.Page),
+		Limit:      int(req.Limit),
+	}
+	if req.ActorId != "" {
+		actorID, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			return nil, storeerror.InvalidID("actor_id")
+		}
+		filter.ActorID = actorID
+	}
+	if req.StartTime != nil {
+		filter.From = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		filter.To = req.EndTime.AsTime()
+	}
+
+	events, err := g.service.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ListAuditEventsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, e := range events {
+		result.Data = append(result.Data, e.ToProto())
+	}
+	return result, nil
+}
+
 func validateStoreHours(hours []*pb.StoreHour) error {
 	DAYS := map[int32]string{
 		0: "MONDAY",
@@ -546,16 +563,20 @@ func validateStoreHours(hours []*pb.StoreHour) error {
 		6: "SUNDAY",
 	}
 
-	errs := []string{}
+	days := []string{}
+	violations := []*storeerror.Violation{}
 
-	for _, hour := range hours {
+	for i, hour := range hours {
 		if !hour.IsOpen && hour.Is24Hours {
-			errs = append(errs, DAYS[hour.DayOfWeek])
+			days = append(days, DAYS[hour.DayOfWeek])
+			violations = append(violations, storeerror.Field(fmt.Sprintf("store.hours[%d].is_open", i),
+				errPb.StoreErrorCode_STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED, DAYS[hour.DayOfWeek]))
 		}
 	}
 
-	if len(errs) > 0 {
-		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Store with is24hours=true cannot have isOpen=false: %v", strings.Join(errs, ", ")))
+	if len(violations) > 0 {
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_OPEN_24_HOURS_CAN_NOT_BE_CLOSED, strings.Join(days, ", ")).
+			WithViolations(violations...)
 	}
 
 	return nil
@@ -4,11 +4,19 @@ import (
 	"context"
 	"testing"
 
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
 	serviceMock "github.com/Mitra-Apps/be-store-service/service/mock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -85,3 +93,145 @@ func TestGrpcRoute_OpenCloseStore(t *testing.T) {
 		})
 	}
 }
+
+func TestGrpcRoute_BatchGetStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svcMock := serviceMock.NewMockService(ctrl)
+	ctx := context.Background()
+	g := &GrpcRoute{service: svcMock}
+	missingID := "6f3c2a1e-5b4d-4c3b-9a2e-1f0e9d8c7b6a"
+
+	t.Run("BatchGetStores_NoIds_InvalidArgumentError", func(t *testing.T) {
+		// the rules of the request are checked by the Validation interceptor
+		_, err := middleware.Validation(ctx, &pb.BatchGetStoresRequest{}, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return g.BatchGetStores(ctx, req.(*pb.BatchGetStoresRequest))
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("BatchGetStores_InvalidId_InvalidArgumentError", func(t *testing.T) {
+		_, err := g.BatchGetStores(ctx, &pb.BatchGetStoresRequest{Ids: []string{storeID, "abc"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("BatchGetStores_NoError_NotFoundMarker", func(t *testing.T) {
+		store := &entity.Store{StoreName: "Toko Mitra"}
+		store.ID = uuid.MustParse(storeID)
+		svcMock.EXPECT().BatchGetStores(ctx, []uuid.UUID{uuid.MustParse(missingID), store.ID}).
+			Return([]*entity.Store{nil, store}, nil)
+
+		res, err := g.BatchGetStores(ctx, &pb.BatchGetStoresRequest{Ids: []string{missingID, storeID}})
+		assert.Nil(t, err)
+		assert.Len(t, res.Data, 2)
+		assert.Equal(t, missingID, res.Data[0].Id)
+		assert.False(t, res.Data[0].Found)
+		assert.Nil(t, res.Data[0].Store)
+		assert.Equal(t, storeID, res.Data[1].Id)
+		assert.True(t, res.Data[1].Found)
+		assert.Equal(t, "Toko Mitra", res.Data[1].Store.StoreName)
+	})
+}
+
+func TestGrpcRoute_InvalidId_ProtoFieldName(t *testing.T) {
+	g := &GrpcRoute{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		call  func() error
+		field string
+	}{
+		{
+			name: "UpdateWebhookSubscription_InvalidStoreId",
+			call: func() error {
+				_, err := g.UpdateWebhookSubscription(ctx, &pb.UpdateWebhookSubscriptionRequest{StoreId: "abc", Id: storeID})
+				return err
+			},
+			field: "store_id",
+		},
+		{
+			name: "UpdateWebhookSubscription_InvalidId",
+			call: func() error {
+				_, err := g.UpdateWebhookSubscription(ctx, &pb.UpdateWebhookSubscriptionRequest{StoreId: storeID, Id: "abc"})
+				return err
+			},
+			field: "id",
+		},
+		{
+			name: "ListWebhookDeliveries_InvalidSubscriptionId",
+			call: func() error {
+				_, err := g.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{StoreId: storeID, SubscriptionId: "abc"})
+				return err
+			},
+			field: "subscription_id",
+		},
+		{
+			name: "DeleteProductDiscount_InvalidProductId",
+			call: func() error {
+				_, err := g.DeleteProductDiscount(ctx, &pb.DeleteProductDiscountRequest{ProductId: "abc", Id: storeID})
+				return err
+			},
+			field: "product_id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, storeerror.InvalidID(tt.field), tt.call())
+		})
+	}
+}
+
+func TestGrpcRoute_InsertProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svcMock := serviceMock.NewMockService(ctrl)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID))
+	g := &GrpcRoute{service: svcMock}
+
+	t.Run("InsertProducts_NoPriceNoUom_EveryViolation", func(t *testing.T) {
+		_, err := g.InsertProducts(ctx, &pb.InsertProductsRequest{
+			StoreId: storeID,
+			ProductList: []*pb.Product{
+				{Name: "Kopi", ProductTypeId: 1, UomId: 1},
+				{Name: "Teh", ProductTypeId: 1, Price: 5000},
+			},
+		})
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		violations := err.(*storeerror.Error).Violations
+		require.Len(t, violations, 2)
+		assert.Equal(t, "product_list[0].price", violations[0].Field)
+		assert.Equal(t, errPb.StoreErrorCode_PRICE_IS_REQUIRED, violations[0].Reason)
+		assert.Equal(t, "product_list[1].uom_id", violations[1].Field)
+		assert.Equal(t, errPb.StoreErrorCode_UOM_IS_REQUIRED, violations[1].Reason)
+	})
+
+	t.Run("InsertProducts_WrongCheckDigit_Violation", func(t *testing.T) {
+		_, err := g.InsertProducts(ctx, &pb.InsertProductsRequest{
+			StoreId:     storeID,
+			ProductList: []*pb.Product{{Name: "Kopi", ProductTypeId: 1, UomId: 1, Price: 5000, Barcode: "8991002101631"}},
+		})
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		violations := err.(*storeerror.Error).Violations
+		require.Len(t, violations, 1)
+		assert.Equal(t, "product_list[0].barcode", violations[0].Field)
+		assert.Equal(t, errPb.StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT, violations[0].Reason)
+	})
+}
+
+func TestGrpcRoute_GetProductByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svcMock := serviceMock.NewMockService(ctrl)
+	g := &GrpcRoute{service: svcMock}
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+
+	svcMock.EXPECT().GetProductByBarcode(ctx, storeIdUuid, "8991002101630").Return(&prodEntity.Product{Name: "Kopi", Barcode: "8991002101630"}, nil)
+	resp, err := g.GetProductByBarcode(ctx, &pb.GetProductByBarcodeRequest{StoreId: storeID, Barcode: "8991002101630"})
+	require.NoError(t, err)
+	assert.Equal(t, "Kopi", resp.Data.Name)
+	assert.Equal(t, "8991002101630", resp.Data.Barcode)
+
+	_, err = g.GetProductByBarcode(ctx, &pb.GetProductByBarcodeRequest{StoreId: storeID, Barcode: "8991002101631"})
+	assert.Equal(t, storeerror.Invalid("barcode", errPb.StoreErrorCode_INVALID_BARCODE_CHECK_DIGIT, "8991002101631"), err)
+}
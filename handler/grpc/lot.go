@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"google.golang.org/grpc/codes"
+)
+
+func (g *GrpcRoute) CreateProductLot(ctx context.Context, req *pb.CreateProductLotRequest) (*pb.ProductLotResponse, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+
+	lot := &prodEntity.ProductLot{}
+	if err := lot.FromProto(req.Lot); err != nil {
+		return nil, err
+	}
+	lot.ProductID = productID
+	lot, err = g.service.CreateProductLot(ctx, lot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ProductLotResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    lot.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) ListProductLots(ctx context.Context, req *pb.ListProductLotsRequest) (*pb.ListProductLotsResponse, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := g.service.ListProductLots(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	return lotsResponse(lots), nil
+}
+
+func (g *GrpcRoute) GetExpiringProducts(ctx context.Context, req *pb.GetExpiringProductsRequest) (*pb.ListProductLotsResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := g.service.GetExpiringProducts(ctx, storeID, int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+	return lotsResponse(lots), nil
+}
+
+func lotsResponse(lots []*prodEntity.ProductLot) *pb.ListProductLotsResponse {
+	result := &pb.ListProductLotsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, l := range lots {
+		result.Data = append(result.Data, l.ToProto())
+	}
+	return result
+}
@@ -6,12 +6,13 @@ import (
 	"os"
 	"strings"
 
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
 	userService "github.com/Mitra-Apps/be-user-service/service"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 )
 
 var excludedMethods = []string{
@@ -25,34 +26,62 @@ type JwtClaims struct {
 }
 
 func Auth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuth authenticates the caller of a streaming call like Auth does for the unary calls.
+func StreamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate verifies the token of the caller and returns ctx with the user id and the roles of the
+// token in its metadata.
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
 	for _, excludedMethod := range excludedMethods {
-		if strings.Contains(info.FullMethod, excludedMethod) {
-			return handler(ctx, req)
+		if strings.Contains(fullMethod, excludedMethod) {
+			return ctx, nil
 		}
 	}
 
 	headers, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "no headers provided")
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_TOKEN_IS_REQUIRED)
 	}
 
 	token := getTokenValue(headers)
 	if token == "" {
-		return nil, status.Errorf(codes.Unauthenticated, "no token provided")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_TOKEN_IS_REQUIRED)
 	}
 
 	// extract the jwt token and get the userId
 	userId, roleNames, err := verifyToken(ctx, token)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_INVALID_TOKEN)
 	}
 
-	headers.Append("x-user-id", userId)
-	headers.Append("x-role-names", roleNames...)
+	// the claims replace any x-user-id or x-role-names sent by the caller
+	headers = headers.Copy()
+	headers.Set("x-user-id", userId)
+	headers.Set("x-role-names", roleNames...)
 
-	ctx = metadata.NewIncomingContext(ctx, headers)
-
-	return handler(ctx, req)
+	return metadata.NewIncomingContext(ctx, headers), nil
 }
 
 func getTokenValue(headers metadata.MD) string {
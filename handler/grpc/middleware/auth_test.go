@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamAuth(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/StoreService/WatchStoreEvents"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		t.Fatal("the handler is called without a token")
+		return nil
+	}
+
+	// the user id sent by the caller is not trusted without a token
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "2f27d467-9f83-4170-96ab-36e0994f37ca"))
+	err := StreamAuth(nil, &contextStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors renders the errors of the catalogue in the locale of the caller, see LocaleFromContext.
+// Errors without a gRPC status are returned as INTERNAL_ERROR, so every error carries a reason.
+func Errors(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, localizeError(ctx, err)
+	}
+	return resp, nil
+}
+
+// StreamErrors is the stream counterpart of Errors.
+func StreamErrors(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return localizeError(ss.Context(), err)
+	}
+	return nil
+}
+
+func localizeError(ctx context.Context, err error) error {
+	var storeErr *storeerror.Error
+	if errors.As(err, &storeErr) {
+		return storeErr.Status(LocaleFromContext(ctx)).Err()
+	}
+	if st := status.FromContextError(err); st.Code() != codes.Unknown {
+		return st.Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return storeerror.Internal(err).Status(LocaleFromContext(ctx)).Err()
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrors(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/StoreService/GetStore"}
+	english := metadata.NewIncomingContext(context.Background(), metadata.Pairs(LocaleKey, "en-US"))
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+		wantReason  string
+	}{
+		{
+			name:        "CatalogueError_DefaultLocale",
+			ctx:         context.Background(),
+			err:         storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND),
+			wantCode:    codes.NotFound,
+			wantMessage: "Toko tidak ditemukan",
+			wantReason:  "STORE_NOT_FOUND",
+		},
+		{
+			name:        "CatalogueError_CallerLocale",
+			ctx:         english,
+			err:         storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND),
+			wantCode:    codes.NotFound,
+			wantMessage: "The store is not found",
+			wantReason:  "STORE_NOT_FOUND",
+		},
+		{
+			name:        "PlainError_Internal",
+			ctx:         english,
+			err:         errors.New("connection refused"),
+			wantCode:    codes.Internal,
+			wantMessage: "Internal server error : connection refused",
+			wantReason:  "INTERNAL_ERROR",
+		},
+		{
+			name:        "ContextError",
+			ctx:         english,
+			err:         context.DeadlineExceeded,
+			wantCode:    codes.DeadlineExceeded,
+			wantMessage: context.DeadlineExceeded.Error(),
+		},
+		{
+			name:        "StatusError_Kept",
+			ctx:         english,
+			err:         status.Error(codes.Unavailable, "image service is temporarily unavailable"),
+			wantCode:    codes.Unavailable,
+			wantMessage: "image service is temporarily unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Errors(tt.ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tt.err
+			})
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.wantMessage, st.Message())
+			if tt.wantReason == "" {
+				assert.Empty(t, st.Details())
+				return
+			}
+			require.NotEmpty(t, st.Details())
+			assert.Equal(t, tt.wantReason, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+		})
+	}
+
+	t.Run("NoError", func(t *testing.T) {
+		resp, err := Errors(english, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
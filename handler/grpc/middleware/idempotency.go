@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/repository"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// IdempotencyKey is the metadata key of the idempotency key, the HTTP gateway forwards the
+	// Idempotency-Key header to it.
+	IdempotencyKey = "idempotency-key"
+	// IdempotentReplayedKey is set in the response header when the response is the one of an earlier call.
+	IdempotentReplayedKey = "idempotent-replayed"
+)
+
+// Idempotency makes the retries of a call sent with the same idempotency key return the response of
+// the first call instead of running it again. A key is scoped to the user and method, and may not be
+// reused with another payload until it expires.
+type Idempotency struct {
+	repo    repository.IdempotencyRepository
+	methods map[string]bool
+	ttl     time.Duration
+	// lockTimeout is how long a call may run before a retry runs the call again, so a key is not
+	// blocked by a replica which stopped while running the call
+	lockTimeout time.Duration
+	now         func() time.Time
+}
+
+// NewIdempotency returns the interceptor for the given full method names, other methods ignore the key.
+func NewIdempotency(repo repository.IdempotencyRepository, ttl time.Duration, methods ...string) *Idempotency {
+	i := &Idempotency{
+		repo:        repo,
+		methods:     map[string]bool{},
+		ttl:         ttl,
+		lockTimeout: time.Minute,
+		now:         time.Now,
+	}
+	for _, m := range methods {
+		i.methods[m] = true
+	}
+	return i
+}
+
+func (i *Idempotency) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !i.methods[info.FullMethod] || !ok {
+		return handler(ctx, req)
+	}
+	headers, _ := metadata.FromIncomingContext(ctx)
+	values := headers.Get(IdempotencyKey)
+	if len(values) == 0 || values[0] == "" {
+		return handler(ctx, req)
+	}
+	key := values[0]
+	if len(key) > 255 {
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_TOO_LONG)
+	}
+
+	hash, err := requestHash(msg)
+	if err != nil {
+		return nil, storeerror.Internal(err)
+	}
+	var userID uuid.UUID
+	if claims, err := GetClaimsFromContext(ctx); err == nil {
+		userID = claims.UserID
+	}
+
+	now := i.now()
+	record := &entity.IdempotencyKey{
+		UserID:      userID,
+		Method:      info.FullMethod,
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(i.ttl),
+	}
+	// a second round is needed when the existing key is expired or abandoned and has been deleted
+	for round := 0; ; round++ {
+		created, err := i.repo.CreateIdempotencyKey(ctx, record)
+		if err != nil {
+			return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY, err)
+		}
+		if created {
+			break
+		}
+
+		existing, err := i.repo.GetIdempotencyKey(ctx, userID, info.FullMethod, key)
+		if err != nil {
+			return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY, err)
+		}
+		if existing != nil && !i.isStale(existing, now) {
+			return i.replay(ctx, existing, hash)
+		}
+		if round > 0 {
+			return nil, storeerror.New(codes.Aborted, errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_IN_PROGRESS)
+		}
+		if existing != nil {
+			if err := i.repo.DeleteIdempotencyKey(ctx, userID, info.FullMethod, key); err != nil {
+				return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY, err)
+			}
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		// a failed call may be retried with the same key
+		if err := i.repo.DeleteIdempotencyKey(context.WithoutCancel(ctx), userID, info.FullMethod, key); err != nil {
+			log.Printf("Failed to release idempotency key %s : %v \n", key, err)
+		}
+		return resp, err
+	}
+
+	if m, ok := resp.(proto.Message); ok {
+		response, err := marshalResponse(m)
+		if err == nil {
+			err = i.repo.SaveIdempotencyResponse(context.WithoutCancel(ctx), userID, info.FullMethod, key, response)
+		}
+		if err != nil {
+			log.Printf("Failed to save the response of idempotency key %s : %v \n", key, err)
+		}
+	}
+	return resp, nil
+}
+
+// isStale reports whether the key expired, or belongs to a call which has been running for too long.
+func (i *Idempotency) isStale(k *entity.IdempotencyKey, now time.Time) bool {
+	return now.After(k.ExpiresAt) || (k.IsPending() && now.Sub(k.CreatedAt) > i.lockTimeout)
+}
+
+func (i *Idempotency) replay(ctx context.Context, k *entity.IdempotencyKey, hash string) (interface{}, error) {
+	if k.RequestHash != hash {
+		return nil, storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_ALREADY_USED)
+	}
+	if k.IsPending() {
+		return nil, storeerror.New(codes.Aborted, errPb.StoreErrorCode_IDEMPOTENCY_KEY_IS_IN_PROGRESS)
+	}
+
+	resp, err := unmarshalResponse(k.Response)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IDEMPOTENCY_KEY, err)
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedKey, "true"))
+	return resp, nil
+}
+
+// Run deletes the expired keys every hour until ctx is done.
+func (i *Idempotency) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := i.repo.PurgeIdempotencyKeys(ctx, i.now()); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to purge expired idempotency keys : %v \n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func requestHash(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func marshalResponse(resp proto.Message) ([]byte, error) {
+	a, err := anypb.New(resp)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+func unmarshalResponse(b []byte) (proto.Message, error) {
+	a := &anypb.Any{}
+	if err := proto.Unmarshal(b, a); err != nil {
+		return nil, err
+	}
+	return a.UnmarshalNew()
+}
@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Mitra-Apps/be-store-service/domain/idempotency/entity"
+	idempotencyRepoMock "github.com/Mitra-Apps/be-store-service/domain/idempotency/repository/mock"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	userID = "8b15140c-f6d0-4f2f-8302-57383a51adaf"
+	method = "/StoreService/CreateStore"
+)
+
+func TestIdempotency_Unary(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &pb.CreateStoreRequest{Store: &pb.Store{StoreName: "Toko Mitra"}}
+	otherReq := &pb.CreateStoreRequest{Store: &pb.Store{StoreName: "Toko Lain"}}
+	resp := &pb.CreateStoreResponse{Code: int32(codes.OK), Data: &pb.Store{Id: uuid.NewString(), StoreName: "Toko Mitra"}}
+	hash, _ := requestHash(req)
+	saved, _ := marshalResponse(resp)
+	user := uuid.MustParse(userID)
+
+	tests := []struct {
+		name        string
+		key         string
+		method      string
+		handlerErr  error
+		mock        func(repo *idempotencyRepoMock.MockIdempotencyRepository)
+		wantHandler bool
+		wantResp    proto.Message
+		wantCode    codes.Code
+	}{
+		{
+			name:        "without key",
+			method:      method,
+			wantHandler: true,
+			wantResp:    resp,
+		},
+		{
+			name:        "method without idempotency",
+			key:         "key-1",
+			method:      "/StoreService/GetStore",
+			wantHandler: true,
+			wantResp:    resp,
+		},
+		{
+			name:   "first call saves the response",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), &entity.IdempotencyKey{
+					UserID: user, Method: method, Key: "key-1", RequestHash: hash, CreatedAt: now, ExpiresAt: now.Add(time.Hour),
+				}).Return(true, nil)
+				repo.EXPECT().SaveIdempotencyResponse(gomock.Any(), user, method, "key-1", saved).Return(nil)
+			},
+			wantHandler: true,
+			wantResp:    resp,
+		},
+		{
+			name:   "retry replays the response",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyKey(gomock.Any(), user, method, "key-1").Return(&entity.IdempotencyKey{
+					RequestHash: hash, Response: saved, CreatedAt: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour),
+				}, nil)
+			},
+			wantResp: resp,
+		},
+		{
+			name:   "key reused for another payload",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				otherHash, _ := requestHash(otherReq)
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyKey(gomock.Any(), user, method, "key-1").Return(&entity.IdempotencyKey{
+					RequestHash: otherHash, Response: saved, CreatedAt: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour),
+				}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:   "first call in progress",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetIdempotencyKey(gomock.Any(), user, method, "key-1").Return(&entity.IdempotencyKey{
+					RequestHash: hash, CreatedAt: now.Add(-time.Second), ExpiresAt: now.Add(time.Hour),
+				}, nil)
+			},
+			wantCode: codes.Aborted,
+		},
+		{
+			name:   "abandoned call runs again",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				gomock.InOrder(
+					repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, nil),
+					repo.EXPECT().GetIdempotencyKey(gomock.Any(), user, method, "key-1").Return(&entity.IdempotencyKey{
+						RequestHash: hash, CreatedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour),
+					}, nil),
+					repo.EXPECT().DeleteIdempotencyKey(gomock.Any(), user, method, "key-1").Return(nil),
+					repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(true, nil),
+					repo.EXPECT().SaveIdempotencyResponse(gomock.Any(), user, method, "key-1", saved).Return(nil),
+				)
+			},
+			wantHandler: true,
+			wantResp:    resp,
+		},
+		{
+			name:       "failed call releases the key",
+			key:        "key-1",
+			method:     method,
+			handlerErr: status.Errorf(codes.Internal, "db down"),
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(true, nil)
+				repo.EXPECT().DeleteIdempotencyKey(gomock.Any(), user, method, "key-1").Return(nil)
+			},
+			wantHandler: true,
+			wantCode:    codes.Internal,
+		},
+		{
+			name:   "key store unavailable",
+			key:    "key-1",
+			method: method,
+			mock: func(repo *idempotencyRepoMock.MockIdempotencyRepository) {
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, errors.New("db down"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := idempotencyRepoMock.NewMockIdempotencyRepository(ctrl)
+			if tt.mock != nil {
+				tt.mock(repo)
+			}
+			i := NewIdempotency(repo, time.Hour, method)
+			i.now = func() time.Time { return now }
+
+			md := metadata.Pairs("x-user-id", userID)
+			if tt.key != "" {
+				md.Set(IdempotencyKey, tt.key)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if tt.handlerErr != nil {
+					return nil, tt.handlerErr
+				}
+				return resp, nil
+			}
+
+			got, err := i.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.wantHandler, called)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantResp != nil {
+				assert.True(t, proto.Equal(tt.wantResp, got.(proto.Message)))
+			}
+		})
+	}
+}
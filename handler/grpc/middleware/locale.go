@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc/metadata"
+)
+
+// LocaleKey is the metadata key of the locales preferred by the caller, the HTTP gateway forwards the
+// Accept-Language header to it.
+const LocaleKey = "accept-language"
+
+// DefaultLocale is the locale of the names stored with the master data and of the error messages.
+const DefaultLocale = storeerror.DefaultLocale
+
+// SupportedLocales are the locales the app ships in.
+var SupportedLocales = []string{DefaultLocale, "en"}
+
+// IsSupportedLocale reports whether locale is one of SupportedLocales.
+func IsSupportedLocale(locale string) bool {
+	for _, l := range SupportedLocales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// LocaleFromContext returns the supported locale the caller prefers most, read as an Accept-Language
+// value, e.g. "en-US,en;q=0.9,id;q=0.8" selects en. DefaultLocale is returned when the caller sent
+// no supported locale.
+func LocaleFromContext(ctx context.Context) string {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	for _, locale := range parseAcceptLanguage(strings.Join(headers.Get(LocaleKey), ",")) {
+		if IsSupportedLocale(locale) {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// parseAcceptLanguage returns the primary language subtags of an Accept-Language value in lower case,
+// the preferred first. Languages with a quality of 0 are left out.
+func parseAcceptLanguage(value string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+	languages := []language{}
+	for _, part := range strings.Split(value, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.ToLower(strings.TrimSpace(params[0]))
+		if i := strings.IndexAny(tag, "-_"); i >= 0 {
+			tag = tag[:i]
+		}
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range params[1:] {
+			if q, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+		if quality > 0 {
+			languages = append(languages, language{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(languages, func(i, j int) bool { return languages[i].quality > languages[j].quality })
+
+	tags := make([]string, len(languages))
+	for i, l := range languages {
+		tags[i] = l.tag
+	}
+	return tags
+}
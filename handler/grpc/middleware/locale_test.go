@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLocaleFromContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "NoHeader", want: DefaultLocale},
+		{name: "Supported", values: []string{"en"}, want: "en"},
+		{name: "Region", values: []string{"en-US"}, want: "en"},
+		{name: "UpperCase", values: []string{"EN_gb"}, want: "en"},
+		{name: "FirstSupported", values: []string{"fr-FR, en;q=0.8, id;q=0.5"}, want: "en"},
+		{name: "Quality", values: []string{"en;q=0.5, id-ID;q=0.9"}, want: "id"},
+		{name: "QualityZero", values: []string{"en;q=0"}, want: DefaultLocale},
+		{name: "Unsupported", values: []string{"fr, de"}, want: DefaultLocale},
+		{name: "SeveralValues", values: []string{"fr", "en"}, want: "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.values != nil {
+				md.Set(LocaleKey, tt.values...)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			assert.Equal(t, tt.want, LocaleFromContext(ctx))
+		})
+	}
+}
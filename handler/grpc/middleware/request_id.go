@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey is the metadata key of the request id, the HTTP gateway forwards the X-Request-Id header to it.
+const RequestIDKey = "x-request-id"
+
+// RequestID makes sure every call carries a request id, the one sent by the caller or a generated one,
+// and returns it in the response header so it can be correlated with the logs and audit events.
+func RequestID(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	headers = headers.Copy()
+
+	if len(headers.Get(RequestIDKey)) == 0 || headers.Get(RequestIDKey)[0] == "" {
+		headers.Set(RequestIDKey, uuid.NewString())
+	}
+	ctx = metadata.NewIncomingContext(ctx, headers)
+
+	// SetHeader only fails when ctx does not belong to a server call
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, headers.Get(RequestIDKey)[0]))
+
+	return handler(ctx, req)
+}
+
+// RequestIDFromContext returns the request id of the call, or an empty string when it has none.
+func RequestIDFromContext(ctx context.Context) string {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	if values := headers.Get(RequestIDKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
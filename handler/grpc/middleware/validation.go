@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Validation runs the rules of protoc-gen-validate on every request which has them, all the broken
+// rules are returned in a single INVALID_ARGUMENT error with a field violation per rule. Partial
+// updates, which have an update mask, are validated by their handler as only the listed fields are set.
+func Validation(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamValidation is the stream counterpart of Validation, it validates every message received.
+func StreamValidation(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+func validate(req interface{}) error {
+	if masked, ok := req.(interface{ GetUpdateMask() *fieldmaskpb.FieldMask }); ok && len(masked.GetUpdateMask().GetPaths()) > 0 {
+		return nil
+	}
+	v, ok := req.(interface{ ValidateAll() error })
+	if !ok {
+		return nil
+	}
+	if err := v.ValidateAll(); err != nil {
+		return storeerror.FromValidation(err)
+	}
+	return nil
+}
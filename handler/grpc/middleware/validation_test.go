@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestValidation(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/StoreService/InsertProducts"}
+	handled := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("EveryViolation", func(t *testing.T) {
+		req := &pb.InsertProductsRequest{
+			StoreId: "7d56be32-70a2-4f49-b66b-63e6f8e719d5",
+			ProductList: []*pb.Product{
+				{Name: "Kopi", Price: -1, ProductTypeId: 1},
+				{UomId: -2},
+			},
+		}
+		resp, err := Validation(context.Background(), req, info, handled)
+
+		assert.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		fields := []string{}
+		for _, v := range err.(*storeerror.Error).Violations {
+			fields = append(fields, v.Field)
+		}
+		assert.Equal(t, []string{
+			"product_list[0].price",
+			"product_list[1].name",
+			"product_list[1].product_type_id",
+			"product_list[1].uom_id",
+		}, fields)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		req := &pb.UpsertProductTypeRequest{ProductType: &pb.ProductType{Name: "Kopi", ProductCategoryId: 1}}
+		resp, err := Validation(context.Background(), req, info, handled)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("PartialUpdate_LeftToHandler", func(t *testing.T) {
+		req := &pb.UpdateProductRequest{
+			Product:    &pb.Product{Price: 1000},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+		}
+		resp, err := Validation(context.Background(), req, info, handled)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("NoRules", func(t *testing.T) {
+		resp, err := Validation(context.Background(), "request", info, handled)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestStreamValidation(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/StoreService/WatchStore"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&pb.WatchStoreRequest{})
+	}
+
+	err := StreamValidation(nil, &recvStream{msg: &pb.WatchStoreRequest{AfterSequence: -1}}, info, handler)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "after_sequence", err.(*storeerror.Error).Violations[0].Field)
+
+	err = StreamValidation(nil, &recvStream{msg: &pb.WatchStoreRequest{AfterSequence: 3}}, info, handler)
+	assert.NoError(t, err)
+}
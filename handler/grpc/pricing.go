@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (g *GrpcRoute) CreateProductDiscount(ctx context.Context, req *pb.CreateProductDiscountRequest) (*pb.ProductDiscountResponse, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+
+	discount := &prodEntity.ProductDiscount{
+		ProductID:    productID,
+		DiscountType: req.DiscountType,
+		Value:        req.Value,
+	}
+	if req.Amount != nil {
+		if discount.Amount, err = prodEntity.MoneyFromProto(req.Amount); err != nil {
+			return nil, err
+		}
+	}
+	if req.StartsAt != nil {
+		discount.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		endsAt := req.EndsAt.AsTime()
+		discount.EndsAt = &endsAt
+	}
+	discount, err = g.service.CreateProductDiscount(ctx, discount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ProductDiscountResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    discount.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) ListProductDiscounts(ctx context.Context, req *pb.ListProductDiscountsRequest) (*pb.ListProductDiscountsResponse, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+
+	discounts, err := g.service.ListProductDiscounts(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ListProductDiscountsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, d := range discounts {
+		result.Data = append(result.Data, d.ToProto())
+	}
+	return result, nil
+}
+
+func (g *GrpcRoute) DeleteProductDiscount(ctx context.Context, req *pb.DeleteProductDiscountRequest) (*empty.Empty, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID(req.Id, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, g.service.DeleteProductDiscount(ctx, productID, id)
+}
+
+func (g *GrpcRoute) ListProductPriceHistory(ctx context.Context, req *pb.ListProductPriceHistoryRequest) (*pb.ListProductPriceHistoryResponse, error) {
+	productID, err := parseUUID(req.ProductId, "product_id")
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := g.service.ListProductPriceHistory(ctx, productID, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ListProductPriceHistoryResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, h := range histories {
+		result.Data = append(result.Data, h.ToProto())
+	}
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"google.golang.org/grpc/codes"
+)
+
+func (g *GrpcRoute) UpsertTranslations(ctx context.Context, req *pb.UpsertTranslationsRequest) (*pb.TranslationsResponse, error) {
+	translations := make([]*prodEntity.Translation, len(req.Translations))
+	for i, t := range req.Translations {
+		translations[i] = &prodEntity.Translation{}
+		translations[i].FromProto(t)
+	}
+	saved, err := g.service.UpsertTranslations(ctx, req.EntityType, req.EntityId, translations)
+	if err != nil {
+		return nil, err
+	}
+	return translationsResponse(saved), nil
+}
+
+func (g *GrpcRoute) GetTranslations(ctx context.Context, req *pb.GetTranslationsRequest) (*pb.TranslationsResponse, error) {
+	translations, err := g.service.GetTranslations(ctx, req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+	return translationsResponse(translations), nil
+}
+
+func translationsResponse(translations []*prodEntity.Translation) *pb.TranslationsResponse {
+	result := &pb.TranslationsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, t := range translations {
+		result.Data = append(result.Data, t.ToProto())
+	}
+	return result
+}
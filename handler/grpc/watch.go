@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+)
+
+func (g *GrpcRoute) WatchStore(req *pb.WatchStoreRequest, stream pb.StoreService_WatchStoreServer) error {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return err
+	}
+
+	return g.service.WatchStoreEvents(stream.Context(), storeID, outboxEntity.StoreEventTypes, req.AfterSequence, func(event *outboxEntity.OutboxEvent) error {
+		return stream.Send(event.ToStoreEvent())
+	})
+}
+
+func (g *GrpcRoute) WatchProducts(req *pb.WatchProductsRequest, stream pb.StoreService_WatchProductsServer) error {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return err
+	}
+
+	return g.service.WatchStoreEvents(stream.Context(), storeID, outboxEntity.ProductEventTypes, req.AfterSequence, func(event *outboxEntity.OutboxEvent) error {
+		return stream.Send(event.ToStoreEvent())
+	})
+}
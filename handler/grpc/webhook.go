@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	webhookEntity "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// parseUUID parses the id sent in field, which is named by its proto path like the other field violations.
+func parseUUID(value, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, storeerror.InvalidID(field)
+	}
+	return id, nil
+}
+
+func (g *GrpcRoute) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.WebhookSubscriptionResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := g.service.CreateWebhookSubscription(ctx, &webhookEntity.WebhookSubscription{
+		StoreID:    storeID,
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := sub.ToProto()
+	// the secret is only handed out once, the merchant needs it to verify the deliveries
+	data.Secret = sub.Secret
+	return &pb.WebhookSubscriptionResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (g *GrpcRoute) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := g.service.ListWebhookSubscriptions(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ListWebhookSubscriptionsResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, sub := range subs {
+		result.Data = append(result.Data, sub.ToProto())
+	}
+	return result, nil
+}
+
+func (g *GrpcRoute) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.WebhookSubscriptionResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID(req.Id, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	update := &webhookEntity.WebhookSubscription{
+		StoreID:    storeID,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		IsActive:   req.IsActive,
+	}
+	update.ID = id
+	sub, err := g.service.UpdateWebhookSubscription(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WebhookSubscriptionResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    sub.ToProto(),
+	}, nil
+}
+
+func (g *GrpcRoute) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*empty.Empty, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID(req.Id, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, g.service.DeleteWebhookSubscription(ctx, storeID, id)
+}
+
+func (g *GrpcRoute) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID, err := parseUUID(req.SubscriptionId, "subscription_id")
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := g.service.ListWebhookDeliveries(ctx, storeID, subscriptionID, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ListWebhookDeliveriesResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+	}
+	for _, d := range deliveries {
+		result.Data = append(result.Data, d.ToProto())
+	}
+	return result, nil
+}
+
+func (g *GrpcRoute) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.WebhookDeliveryResponse, error) {
+	storeID, err := parseUUID(req.StoreId, "store_id")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID, err := parseUUID(req.SubscriptionId, "subscription_id")
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseUUID(req.Id, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := g.service.ReplayWebhookDelivery(ctx, storeID, subscriptionID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WebhookDeliveryResponse{
+		Code:    int32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    delivery.ToProto(),
+	}, nil
+}
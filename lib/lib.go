@@ -1,11 +1,15 @@
 package lib
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 )
 
 const (
@@ -46,3 +50,31 @@ func ReadToFile(fileName string, format string, container interface{}) (err erro
 		return errors.New("format not defined")
 	}
 }
+
+// DecodeBase64Image decodes a base64 encoded image. A data URI header such as
+// "data:image/png;base64," is stripped before decoding.
+func DecodeBase64Image(image string) ([]byte, error) {
+	b64data := image[strings.IndexByte(image, ',')+1:]
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(b64data))
+}
+
+// ContentHash returns the hex encoded SHA-256 digest of data, used as the
+// content address of uploaded images.
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// InFieldMask reports whether field is written by an update with the given field mask paths.
+// An empty mask is a full update which writes every field.
+func InFieldMask(paths []string, field string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		if p == field {
+			return true
+		}
+	}
+	return false
+}
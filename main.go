@@ -2,29 +2,49 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	auditPostgre "github.com/Mitra-Apps/be-store-service/domain/audit/repository/postgres"
+	idempotencyPostgre "github.com/Mitra-Apps/be-store-service/domain/idempotency/repository/postgres"
+	imageCache "github.com/Mitra-Apps/be-store-service/domain/image/repository/cache"
 	imageGrpcRepo "github.com/Mitra-Apps/be-store-service/domain/image/repository/grpc"
+	imagePostgre "github.com/Mitra-Apps/be-store-service/domain/image/repository/postgres"
+	leasePostgre "github.com/Mitra-Apps/be-store-service/domain/lease/repository/postgres"
+	outboxPublisher "github.com/Mitra-Apps/be-store-service/domain/outbox/publisher"
+	outboxRelay "github.com/Mitra-Apps/be-store-service/domain/outbox/relay"
+	outboxPostgre "github.com/Mitra-Apps/be-store-service/domain/outbox/repository/postgres"
+	outboxWatch "github.com/Mitra-Apps/be-store-service/domain/outbox/watch"
 	prodPostgre "github.com/Mitra-Apps/be-store-service/domain/product/repository/postgres"
+	prodScheduler "github.com/Mitra-Apps/be-store-service/domain/product/scheduler"
 	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
 	grpcRoute "github.com/Mitra-Apps/be-store-service/handler/grpc"
 	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"github.com/Mitra-Apps/be-store-service/migration"
 	"github.com/Mitra-Apps/be-store-service/service"
 	util "github.com/Mitra-Apps/be-utility-service/config/tools"
 	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/joho/godotenv"
+	"github.com/nats-io/nats.go"
 	"github.com/sirupsen/logrus"
 	"go.elastic.co/apm/module/apmgrpc"
 
 	configPostgres "github.com/Mitra-Apps/be-store-service/config/postgres"
+	configRedis "github.com/Mitra-Apps/be-store-service/config/redis"
 	repositoryPostgres "github.com/Mitra-Apps/be-store-service/domain/store/repository/postgres"
 	"github.com/Mitra-Apps/be-store-service/domain/store/repository/storage"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	webhookDispatcher "github.com/Mitra-Apps/be-store-service/domain/webhook/dispatcher"
+	webhookPostgre "github.com/Mitra-Apps/be-store-service/domain/webhook/repository/postgres"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -39,6 +59,11 @@ func main() {
 
 	godotenv.Load()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(ctx, os.Args[2:])
+		return
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", os.Getenv("GRPC_PORT")))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -54,14 +79,39 @@ func main() {
 		utilityGrpcConn.Close()
 	}()
 	imageServiceClient := utilityPb.NewImageServiceClient(utilityGrpcConn)
-	imageGrpcRepo := imageGrpcRepo.New(imageServiceClient)
+	imageGrpcRepo := imageGrpcRepo.New(imageServiceClient, imageGrpcRepo.ConfigFromEnv())
+	imageRepo := imageCache.New(imageGrpcRepo, imageCacheStore(), imageCacheTTL())
 
 	db := configPostgres.Connection()
+	// replicas starting together wait on the migration lock, so pending migrations are applied once
+	migrator, err := migration.New(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	if err := migrator.Up(ctx); err != nil {
+		log.Fatalf("Failed to migrate: %v", err)
+	}
+
 	repoPostgres := repositoryPostgres.NewPostgres(db)
 	prodPostgreRepo := prodPostgre.NewPostgres(db)
+	imageObjectRepo := imagePostgre.NewPostgres(db)
 	repoStorage := storage.New()
-	svc := service.New(repoPostgres, prodPostgreRepo, repoStorage, imageGrpcRepo)
-	grpcServer := GrpcNewServer(ctx, []grpc.ServerOption{})
+	auditRepo := auditPostgre.NewPostgres(db)
+	outboxRepo := outboxPostgre.NewPostgres(db)
+	webhookRepo := webhookPostgre.NewPostgres(db)
+	trxManager := transaction.NewManager(db)
+	// watchers of every replica are woken by the notifications of the committed events
+	watchBroker := outboxWatch.NewBroker()
+	go outboxWatch.Listen(ctx, configPostgres.DSN(), watchBroker)
+	svc := service.New(repoPostgres, prodPostgreRepo, repoStorage, imageRepo, imageObjectRepo, trxManager, auditRepo, outboxRepo, webhookRepo, watchBroker)
+	// retries of these calls with the same Idempotency-Key get the response of the first call
+	idempotency := middleware.NewIdempotency(idempotencyPostgre.NewPostgres(db), idempotencyTTL(),
+		pb.StoreService_CreateStore_FullMethodName,
+		pb.StoreService_InsertProducts_FullMethodName,
+		pb.StoreService_CreateWebhookSubscription_FullMethodName,
+	)
+	go idempotency.Run(ctx)
+	grpcServer := GrpcNewServer(ctx, []grpc.ServerOption{}, idempotency.Unary)
 	route := grpcRoute.New(svc)
 	pb.RegisterStoreServiceServer(grpcServer, route)
 
@@ -70,12 +120,86 @@ func main() {
 		grpcServer.GracefulStop()
 	}()
 
+	// the relay also queues the deliveries of the merchant webhooks, which the dispatcher sends
+	brokerPublisher, closePublisher := newOutboxPublisher()
+	defer func() {
+		if err := closePublisher(); err != nil {
+			log.Printf("Failed to close the outbox publisher : %v \n", err)
+		}
+	}()
+	publisher := outboxPublisher.NewMulti(brokerPublisher, webhookDispatcher.NewFanout(webhookRepo))
+	relay := outboxRelay.New(outboxRepo, trxManager, publisher, outboxRelay.ConfigFromEnv())
+	go relay.Run(ctx)
+	dispatcher := webhookDispatcher.New(webhookRepo, trxManager, webhookDispatcher.NewHTTPClient(), webhookDispatcher.ConfigFromEnv())
+	go dispatcher.Run(ctx)
+	// the product schedules are applied by the replica holding the scheduler lease
+	scheduler := prodScheduler.New(leasePostgre.NewPostgres(db), svc, prodScheduler.ConfigFromEnv())
+	go scheduler.Run(ctx)
+
 	go HttpNewServer(ctx, os.Getenv("GRPC_PORT"), os.Getenv("HTTP_PORT"))
+	if metricsPort := os.Getenv("METRICS_PORT"); metricsPort != "" {
+		go MetricsNewServer(ctx, metricsPort)
+	}
 
 	grpcServer.Serve(lis)
 }
 
-func GrpcNewServer(ctx context.Context, opts []grpc.ServerOption) *grpc.Server {
+// imageCacheStore uses redis when it is configured so the cache is shared between instances,
+// otherwise image paths are cached in process.
+func imageCacheStore() imageCache.Store {
+	if client := configRedis.Connection(); client != nil {
+		return imageCache.NewRedis(client)
+	}
+	size, err := strconv.Atoi(os.Getenv("IMAGE_CACHE_SIZE"))
+	if err != nil {
+		size = 10000
+	}
+	return imageCache.NewLRU(size)
+}
+
+func idempotencyTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_TTL"))
+	if err != nil {
+		return 24 * time.Hour
+	}
+	return ttl
+}
+
+func imageCacheTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("IMAGE_CACHE_TTL"))
+	if err != nil {
+		return 10 * time.Minute
+	}
+	return ttl
+}
+
+// newOutboxPublisher returns the publisher selected by OUTBOX_PUBLISHER and the function closing its
+// connection, domain events are only logged when no broker is configured.
+func newOutboxPublisher() (outboxPublisher.Publisher, func() error) {
+	switch os.Getenv("OUTBOX_PUBLISHER") {
+	case "webhook":
+		return outboxPublisher.NewWebhook(os.Getenv("OUTBOX_WEBHOOK_URL"), &http.Client{Timeout: 10 * time.Second}), func() error { return nil }
+	case "nats":
+		conn, err := nats.Connect(os.Getenv("OUTBOX_NATS_URL"), nats.MaxReconnects(-1))
+		if err != nil {
+			log.Fatalf("Cannot connect to nats server: %v", err)
+		}
+		return outboxPublisher.NewNATS(conn, envOrDefault("OUTBOX_NATS_SUBJECT_PREFIX", "store")), conn.Drain
+	default:
+		return outboxPublisher.NewLog(), func() error { return nil }
+	}
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+// GrpcNewServer returns the server with the common interceptors, the given unary interceptors run
+// after the caller has been authenticated and the request validated.
+func GrpcNewServer(ctx context.Context, opts []grpc.ServerOption, interceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
 	logrusEntry := logrus.NewEntry(logrus.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
@@ -88,14 +212,20 @@ func GrpcNewServer(ctx context.Context, opts []grpc.ServerOption) *grpc.Server {
 			grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
 			grpc_recovery.StreamServerInterceptor(),
 			apmgrpc.NewStreamServerInterceptor(apmgrpc.WithRecovery()),
+			middleware.StreamErrors,
+			middleware.StreamAuth,
+			middleware.StreamValidation,
 		)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(append([]grpc.UnaryServerInterceptor{
 			grpc_ctxtags.UnaryServerInterceptor(),
 			grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
 			grpc_recovery.UnaryServerInterceptor(),
 			apmgrpc.NewUnaryServerInterceptor(apmgrpc.WithRecovery()),
+			middleware.RequestID,
+			middleware.Errors,
 			middleware.Auth,
-		)),
+			middleware.Validation,
+		}, interceptors...)...)),
 	)
 
 	myServer := grpc.NewServer(opts...)
@@ -104,8 +234,43 @@ func GrpcNewServer(ctx context.Context, opts []grpc.ServerOption) *grpc.Server {
 	return myServer
 }
 
+// incomingHeaderMatcher forwards the X-Request-Id header as is, so the request id of the caller is
+// kept, the Idempotency-Key header and the Accept-Language header, so gRPC and HTTP callers select
+// their locale the same way. Other headers are forwarded as by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, middleware.RequestIDKey) {
+		return middleware.RequestIDKey, true
+	}
+	if strings.EqualFold(key, middleware.IdempotencyKey) {
+		return middleware.IdempotencyKey, true
+	}
+	if strings.EqualFold(key, middleware.LocaleKey) {
+		return middleware.LocaleKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the etag metadata as the ETag header, so HTTP clients can send it
+// back in If-Match, the request id as the X-Request-Id header and the replay of an idempotent call
+// as the Idempotent-Replayed header. Other metadata keeps the default Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "etag":
+		return "ETag", true
+	case middleware.RequestIDKey:
+		return "X-Request-Id", true
+	case middleware.IdempotentReplayedKey:
+		return "Idempotent-Replayed", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func HttpNewServer(ctx context.Context, grpcPort, httpPort string) error {
-	mux := runtime.NewServeMux(runtime.WithErrorHandler(util.CustomErrorHandler))
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(util.CustomErrorHandler),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 	mux.HandlePath("GET", "/docs/v1/stores/openapi.yaml", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		http.ServeFile(w, r, "docs/openapi.yaml")
 	})
@@ -133,3 +298,24 @@ func HttpNewServer(ctx context.Context, grpcPort, httpPort string) error {
 
 	return srv.ListenAndServe()
 }
+
+// MetricsNewServer serves the expvar metrics on /debug/vars. They expose the command line and the
+// memory stats of the process, so the port must only be reachable from the internal network.
+func MetricsNewServer(ctx context.Context, metricsPort string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%s", metricsPort),
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := srv.Shutdown(ctx); err != nil {
+			logrus.Panicf("failed to shutdown metrics server: %v", err)
+		}
+	}()
+
+	return srv.ListenAndServe()
+}
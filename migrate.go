@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	configPostgres "github.com/Mitra-Apps/be-store-service/config/postgres"
+	"github.com/Mitra-Apps/be-store-service/migration"
+)
+
+const migrateUsage = "usage: migrate [up | down [steps] | status]"
+
+// runMigrate handles the migrate subcommand.
+func runMigrate(ctx context.Context, args []string) {
+	migrator, err := migration.New(configPostgres.Connection())
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+
+	switch cmd {
+	case "up":
+		if err := migrator.Up(ctx); err != nil {
+			log.Fatalf("Failed to migrate: %v", err)
+		}
+		log.Println("Database is up to date")
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				log.Fatal(migrateUsage)
+			}
+		}
+		if err := migrator.Down(ctx, steps); err != nil {
+			log.Fatalf("Failed to revert migrations: %v", err)
+		}
+		log.Printf("Reverted %d migration(s)", steps)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to get migration status: %v", err)
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(os.Stdout, "%06d_%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
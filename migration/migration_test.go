@@ -0,0 +1,104 @@
+package migration
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		fsys          fstest.MapFS
+		expected      []Migration
+		expectedError string
+	}{
+		{
+			name: "Load_ValidFiles_SortedByVersion",
+			fsys: fstest.MapFS{
+				"sql/000002_add_column.up.sql":   {Data: []byte("ALTER TABLE a ADD COLUMN b int;")},
+				"sql/000001_init.up.sql":         {Data: []byte("CREATE TABLE a (id int);")},
+				"sql/000001_init.down.sql":       {Data: []byte("DROP TABLE a;")},
+				"sql/000002_add_column.down.sql": {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+			},
+			expected: []Migration{
+				{Version: 1, Name: "init", Up: "CREATE TABLE a (id int);", Down: "DROP TABLE a;"},
+				{Version: 2, Name: "add_column", Up: "ALTER TABLE a ADD COLUMN b int;", Down: "ALTER TABLE a DROP COLUMN b;"},
+			},
+		},
+		{
+			name: "Load_MissingUpScript_Error",
+			fsys: fstest.MapFS{
+				"sql/000001_init.down.sql": {Data: []byte("DROP TABLE a;")},
+			},
+			expectedError: "migration 1_init has no up script",
+		},
+		{
+			name: "Load_DuplicateVersion_Error",
+			fsys: fstest.MapFS{
+				"sql/000001_init.up.sql":  {Data: []byte("CREATE TABLE a (id int);")},
+				"sql/000001_other.up.sql": {Data: []byte("CREATE TABLE b (id int);")},
+			},
+			expectedError: "migration version 1 is used by init and other",
+		},
+		{
+			name: "Load_InvalidName_Error",
+			fsys: fstest.MapFS{
+				"sql/init.up.sql": {Data: []byte("CREATE TABLE a (id int);")},
+			},
+			expectedError: "migration init.up.sql should be named <version>_<name>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.fsys)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expected, migrations)
+			}
+		})
+	}
+}
+
+func TestLoad_EmbeddedMigrations(t *testing.T) {
+	migrations, err := Load(files)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, migrations)
+	for _, m := range migrations {
+		assert.NotEmpty(t, m.Down, "migration %d_%s should be revertible", m.Version, m.Name)
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	assert.NoError(t, err)
+	m := &Migrator{db: gormDB, migrations: []Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE a (id int);"},
+		{Version: 2, Name: "add_column", Up: "ALTER TABLE a ADD COLUMN b int;"},
+	}}
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "schema_migrations" ORDER BY version`)).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "name", "applied_at"}).AddRow(1, "init", nil))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE a ADD COLUMN b int;")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "schema_migrations"`)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.Nil(t, m.Up(context.Background()))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
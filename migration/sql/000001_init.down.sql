@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS image_objects;
+DROP TABLE IF EXISTS product_images;
+DROP TABLE IF EXISTS products;
+DROP TABLE IF EXISTS unit_of_measures;
+DROP TABLE IF EXISTS product_types;
+DROP TABLE IF EXISTS product_categories;
+DROP TABLE IF EXISTS store_hours;
+DROP TABLE IF EXISTS store_store_tags;
+DROP TABLE IF EXISTS store_tags;
+DROP TABLE IF EXISTS store_images;
+DROP TABLE IF EXISTS stores;
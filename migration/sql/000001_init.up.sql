@@ -0,0 +1,209 @@
+-- Initial schema. Databases created by gorm AutoMigrate already have these tables,
+-- so every statement is written to be a no-op there.
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS stores (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    user_id uuid NOT NULL UNIQUE,
+    store_name text NOT NULL UNIQUE,
+    store_description text NOT NULL,
+    address text NOT NULL,
+    city text,
+    state text,
+    zip_code text,
+    phone text,
+    email text,
+    website text,
+    location_lat decimal,
+    location_lng decimal,
+    status text,
+    is_active boolean
+);
+CREATE INDEX IF NOT EXISTS idx_stores_deleted_at ON stores (deleted_at);
+
+CREATE TABLE IF NOT EXISTS store_images (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    store_id uuid NOT NULL,
+    image_type text NOT NULL,
+    image_url text NOT NULL,
+    sort_order int NOT NULL DEFAULT 0,
+    is_primary boolean NOT NULL DEFAULT false,
+    CONSTRAINT fk_stores_images FOREIGN KEY (store_id) REFERENCES stores (id)
+);
+CREATE INDEX IF NOT EXISTS idx_store_images_deleted_at ON store_images (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_store_images_store_id ON store_images (store_id);
+
+CREATE TABLE IF NOT EXISTS store_tags (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    tag_name text
+);
+CREATE INDEX IF NOT EXISTS idx_store_tags_deleted_at ON store_tags (deleted_at);
+
+CREATE TABLE IF NOT EXISTS store_store_tags (
+    store_id uuid NOT NULL,
+    store_tag_id uuid NOT NULL,
+    PRIMARY KEY (store_id, store_tag_id),
+    CONSTRAINT fk_store_store_tags_store FOREIGN KEY (store_id) REFERENCES stores (id),
+    CONSTRAINT fk_store_store_tags_store_tag FOREIGN KEY (store_tag_id) REFERENCES store_tags (id)
+);
+
+CREATE TABLE IF NOT EXISTS store_hours (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    store_id uuid NOT NULL,
+    day_of_week text NOT NULL,
+    open text,
+    close text,
+    is24_hr boolean,
+    is_open boolean NOT NULL,
+    CONSTRAINT fk_stores_hours FOREIGN KEY (store_id) REFERENCES stores (id)
+);
+CREATE INDEX IF NOT EXISTS idx_store_hours_deleted_at ON store_hours (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_store_hours_store_id ON store_hours (store_id);
+
+CREATE TABLE IF NOT EXISTS product_categories (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    name varchar(255) NOT NULL UNIQUE,
+    is_active boolean NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_product_categories_deleted_at ON product_categories (deleted_at);
+
+CREATE TABLE IF NOT EXISTS product_types (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    name varchar(255) NOT NULL,
+    is_active boolean NOT NULL,
+    product_category_id bigint NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_product_types_deleted_at ON product_types (deleted_at);
+
+CREATE TABLE IF NOT EXISTS unit_of_measures (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    name varchar(255) NOT NULL UNIQUE,
+    symbol varchar(50) NOT NULL UNIQUE,
+    is_active boolean NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_unit_of_measures_deleted_at ON unit_of_measures (deleted_at);
+
+CREATE TABLE IF NOT EXISTS products (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    store_id uuid NOT NULL,
+    name varchar(255) NOT NULL,
+    sale_status boolean NOT NULL,
+    price decimal(17,2) NOT NULL DEFAULT 0,
+    stock int,
+    uom varchar(50),
+    product_type_id bigint NOT NULL,
+    CONSTRAINT fk_stores_products FOREIGN KEY (store_id) REFERENCES stores (id)
+);
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products (deleted_at);
+
+CREATE TABLE IF NOT EXISTS product_images (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    product_id uuid NOT NULL,
+    image_id uuid NOT NULL,
+    sort_order int NOT NULL DEFAULT 0,
+    is_primary boolean NOT NULL DEFAULT false,
+    CONSTRAINT fk_products_images FOREIGN KEY (product_id) REFERENCES products (id)
+);
+CREATE INDEX IF NOT EXISTS idx_product_images_deleted_at ON product_images (deleted_at);
+
+CREATE TABLE IF NOT EXISTS image_objects (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    content_hash varchar(64) NOT NULL,
+    image_id uuid NOT NULL,
+    group_name varchar(50) NOT NULL,
+    ref_count int NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_image_objects_deleted_at ON image_objects (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_image_objects_content_hash ON image_objects (content_hash);
+CREATE INDEX IF NOT EXISTS idx_image_objects_image_id ON image_objects (image_id);
+
+-- Columns added to tables created before sort order and primary flag were introduced.
+ALTER TABLE store_images ADD COLUMN IF NOT EXISTS sort_order int NOT NULL DEFAULT 0;
+ALTER TABLE store_images ADD COLUMN IF NOT EXISTS is_primary boolean NOT NULL DEFAULT false;
+ALTER TABLE product_images ADD COLUMN IF NOT EXISTS sort_order int NOT NULL DEFAULT 0;
+ALTER TABLE product_images ADD COLUMN IF NOT EXISTS is_primary boolean NOT NULL DEFAULT false;
+
+-- product_types.product_category_id and products.product_type_id reference bigint ids but were
+-- declared as uuid columns. A uuid can not hold one of those ids, so the conversion only succeeds
+-- while the columns are empty and fails loudly otherwise.
+DO $$
+BEGIN
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'product_types' AND column_name = 'product_category_id') = 'uuid' THEN
+        ALTER TABLE product_types DROP CONSTRAINT IF EXISTS fk_product_categories_product_types;
+        ALTER TABLE product_types ALTER COLUMN product_category_id TYPE bigint USING product_category_id::text::bigint;
+    END IF;
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'products' AND column_name = 'product_type_id') = 'uuid' THEN
+        ALTER TABLE products DROP CONSTRAINT IF EXISTS fk_products_product_type;
+        ALTER TABLE products ALTER COLUMN product_type_id TYPE bigint USING product_type_id::text::bigint;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_product_categories_product_types') THEN
+        ALTER TABLE product_types ADD CONSTRAINT fk_product_categories_product_types
+            FOREIGN KEY (product_category_id) REFERENCES product_categories (id);
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_products_product_type') THEN
+        ALTER TABLE products ADD CONSTRAINT fk_products_product_type
+            FOREIGN KEY (product_type_id) REFERENCES product_types (id);
+    END IF;
+END $$;
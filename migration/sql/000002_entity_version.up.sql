@@ -0,0 +1,3 @@
+-- Version counters used to reject updates based on stale data.
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,14 @@
+-- Append only log of the changes made to stores, products and master data.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_id uuid NULL,
+    action varchar(50) NOT NULL,
+    entity_type varchar(50) NOT NULL,
+    entity_id varchar(255) NOT NULL,
+    changes jsonb NOT NULL,
+    request_id varchar(255) NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events (entity_type, entity_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events (actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at);
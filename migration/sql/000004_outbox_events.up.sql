@@ -0,0 +1,15 @@
+-- Domain events written with the changes they describe, published by the outbox relay.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_type varchar(100) NOT NULL,
+    aggregate_type varchar(50) NOT NULL,
+    aggregate_id varchar(255) NOT NULL,
+    payload jsonb NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error text NULL,
+    published_at timestamptz NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (next_attempt_at, created_at) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON outbox_events (published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS store_id;
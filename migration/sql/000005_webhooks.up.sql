@@ -0,0 +1,43 @@
+-- Store of the outbox events, used to deliver them to the webhooks of the store.
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS store_id uuid NULL;
+
+-- Webhooks registered by merchants for the events of their store.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    store_id uuid NOT NULL,
+    url varchar(2048) NOT NULL,
+    secret varchar(255) NOT NULL,
+    event_types jsonb NOT NULL DEFAULT '[]',
+    is_active boolean NOT NULL DEFAULT true,
+    consecutive_failures int NOT NULL DEFAULT 0,
+    disabled_at timestamptz NULL,
+    disabled_reason text NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_store_id ON webhook_subscriptions (store_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_deleted_at ON webhook_subscriptions (deleted_at);
+
+-- Deliveries of an event to a webhook, kept as the delivery log of the subscription.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id uuid NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_status int NOT NULL DEFAULT 0,
+    last_error text NULL,
+    delivered_at timestamptz NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz NULL,
+    UNIQUE (subscription_id, event_id)
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries (next_attempt_at, created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id, created_at);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS outbox_events_notify ON outbox_events;
+DROP FUNCTION IF EXISTS notify_outbox_event();
+DROP INDEX IF EXISTS idx_outbox_events_store_sequence;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS sequence;
@@ -0,0 +1,15 @@
+-- Order of the events, watchers resume a stream after the last sequence they received.
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS sequence bigserial;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_store_sequence ON outbox_events (store_id, sequence);
+
+-- Wakes the watchers of the store when one of its events is committed.
+CREATE OR REPLACE FUNCTION notify_outbox_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('outbox_events', json_build_object('store_id', NEW.store_id, 'sequence', NEW.sequence)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS outbox_events_notify ON outbox_events;
+CREATE TRIGGER outbox_events_notify AFTER INSERT ON outbox_events
+    FOR EACH ROW EXECUTE FUNCTION notify_outbox_event();
@@ -0,0 +1,12 @@
+-- Responses of the calls made with an Idempotency-Key, replayed to the retries of the call.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id uuid NOT NULL,
+    method varchar(255) NOT NULL,
+    key varchar(255) NOT NULL,
+    request_hash varchar(64) NOT NULL,
+    response bytea NULL,
+    created_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (user_id, method, key)
+);
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,28 @@
+-- Discounts of a product, scheduled from starts_at until ends_at.
+CREATE TABLE IF NOT EXISTS product_discounts (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_id uuid NOT NULL,
+    discount_type varchar(20) NOT NULL,
+    value decimal(17,2) NOT NULL,
+    starts_at timestamptz NOT NULL,
+    ends_at timestamptz NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL
+);
+CREATE INDEX IF NOT EXISTS idx_product_discounts_product_id ON product_discounts (product_id, starts_at);
+CREATE INDEX IF NOT EXISTS idx_product_discounts_deleted_at ON product_discounts (deleted_at);
+
+-- Every change of the price of a product.
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_id uuid NOT NULL,
+    price decimal(17,2) NOT NULL,
+    previous_price decimal(17,2) NOT NULL,
+    changed_by uuid NOT NULL,
+    changed_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_product_price_history_product_id ON product_price_history (product_id, changed_at);
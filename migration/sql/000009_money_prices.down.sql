@@ -0,0 +1,14 @@
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS price decimal(17,2) NOT NULL DEFAULT 0;
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS previous_price decimal(17,2) NOT NULL DEFAULT 0;
+UPDATE product_price_history SET
+    price = price_minor_units / 100.0,
+    previous_price = previous_price_minor_units / 100.0;
+ALTER TABLE product_price_history DROP COLUMN price_minor_units;
+ALTER TABLE product_price_history DROP COLUMN price_currency;
+ALTER TABLE product_price_history DROP COLUMN previous_price_minor_units;
+ALTER TABLE product_price_history DROP COLUMN previous_price_currency;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS price decimal(17,2) NOT NULL DEFAULT 0;
+UPDATE products SET price = price_minor_units / 100.0;
+ALTER TABLE products DROP COLUMN price_minor_units;
+ALTER TABLE products DROP COLUMN price_currency;
@@ -0,0 +1,15 @@
+-- Prices are stored as exact minor units, hundredths of the currency unit, with their ISO 4217 currency.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS price_minor_units bigint NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS price_currency char(3) NOT NULL DEFAULT 'IDR';
+UPDATE products SET price_minor_units = round(price * 100)::bigint;
+ALTER TABLE products DROP COLUMN price;
+
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS price_minor_units bigint NOT NULL DEFAULT 0;
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS price_currency char(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS previous_price_minor_units bigint NOT NULL DEFAULT 0;
+ALTER TABLE product_price_history ADD COLUMN IF NOT EXISTS previous_price_currency char(3) NOT NULL DEFAULT 'IDR';
+UPDATE product_price_history SET
+    price_minor_units = round(price * 100)::bigint,
+    previous_price_minor_units = round(previous_price * 100)::bigint;
+ALTER TABLE product_price_history DROP COLUMN price;
+ALTER TABLE product_price_history DROP COLUMN previous_price;
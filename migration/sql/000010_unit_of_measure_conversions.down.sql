@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_products_uom_id;
+ALTER TABLE products DROP COLUMN IF EXISTS base_stock;
+ALTER TABLE products DROP COLUMN IF EXISTS uom_id;
+
+ALTER TABLE unit_of_measures DROP COLUMN IF EXISTS conversion_factor;
+ALTER TABLE unit_of_measures DROP COLUMN IF EXISTS base_unit_id;
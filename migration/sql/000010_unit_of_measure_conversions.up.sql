@@ -0,0 +1,38 @@
+-- A unit with a base unit holds conversion_factor base units, the stock of products is also kept in
+-- the base unit of their unit of measure.
+ALTER TABLE unit_of_measures ADD COLUMN IF NOT EXISTS base_unit_id bigint NULL REFERENCES unit_of_measures (id);
+ALTER TABLE unit_of_measures ADD COLUMN IF NOT EXISTS conversion_factor decimal(18,6) NOT NULL DEFAULT 1;
+
+-- the units which used to be listed in uom.json
+INSERT INTO unit_of_measures (created_by, name, symbol, is_active) VALUES
+    ('00000000-0000-0000-0000-000000000000', 'pieces', 'pcs', true),
+    ('00000000-0000-0000-0000-000000000000', 'lusin', 'lsn', true),
+    ('00000000-0000-0000-0000-000000000000', 'gram', 'g', true),
+    ('00000000-0000-0000-0000-000000000000', 'ons', 'ons', true),
+    ('00000000-0000-0000-0000-000000000000', 'kilogram', 'kg', true),
+    ('00000000-0000-0000-0000-000000000000', 'pound', 'lb', true),
+    ('00000000-0000-0000-0000-000000000000', 'botol', 'btl', true)
+ON CONFLICT DO NOTHING;
+
+UPDATE unit_of_measures u SET base_unit_id = b.id, conversion_factor = c.factor
+FROM (VALUES
+    ('lusin', 'pieces', 12),
+    ('ons', 'gram', 100),
+    ('kilogram', 'gram', 1000),
+    ('pound', 'gram', 453.59237)
+) AS c (name, base_name, factor)
+JOIN unit_of_measures b ON b.name = c.base_name
+WHERE u.name = c.name AND u.base_unit_id IS NULL;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS uom_id bigint NULL REFERENCES unit_of_measures (id);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS base_stock bigint NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_products_uom_id ON products (uom_id);
+
+UPDATE products p SET uom_id = u.id
+FROM unit_of_measures u
+WHERE p.uom_id IS NULL AND (LOWER(p.uom) = LOWER(u.name) OR p.uom = u.symbol);
+
+UPDATE products SET base_stock = COALESCE(stock, 0);
+UPDATE products p SET base_stock = round(COALESCE(p.stock, 0) * u.conversion_factor)::bigint
+FROM unit_of_measures u
+WHERE u.id = p.uom_id AND u.base_unit_id IS NOT NULL;
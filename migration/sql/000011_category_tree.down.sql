@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_product_categories_parent_name;
+ALTER TABLE product_categories ADD CONSTRAINT product_categories_name_key UNIQUE (name);
+
+DROP INDEX IF EXISTS idx_product_categories_path;
+DROP INDEX IF EXISTS idx_product_categories_parent_id;
+ALTER TABLE product_categories DROP COLUMN IF EXISTS path;
+ALTER TABLE product_categories DROP COLUMN IF EXISTS parent_id;
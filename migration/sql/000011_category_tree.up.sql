@@ -0,0 +1,12 @@
+-- Categories form a tree, path holds the ids of the ancestors of a category from the root, e.g. /1/4/.
+ALTER TABLE product_categories ADD COLUMN IF NOT EXISTS parent_id bigint NULL REFERENCES product_categories (id);
+ALTER TABLE product_categories ADD COLUMN IF NOT EXISTS path varchar(1024) NOT NULL DEFAULT '/';
+CREATE INDEX IF NOT EXISTS idx_product_categories_parent_id ON product_categories (parent_id);
+CREATE INDEX IF NOT EXISTS idx_product_categories_path ON product_categories (path varchar_pattern_ops);
+
+-- names are unique among the children of a parent instead of across every category
+ALTER TABLE product_categories DROP CONSTRAINT IF EXISTS product_categories_name_key;
+ALTER TABLE product_categories DROP CONSTRAINT IF EXISTS uni_product_categories_name;
+DROP INDEX IF EXISTS idx_product_categories_name;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_categories_parent_name
+    ON product_categories (COALESCE(parent_id, 0), LOWER(name)) WHERE deleted_at IS NULL;
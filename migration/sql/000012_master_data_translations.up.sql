@@ -0,0 +1,21 @@
+-- Names of categories, product types and units of measure in other locales than the default id locale.
+CREATE TABLE IF NOT EXISTS master_data_translations (
+    id bigserial PRIMARY KEY,
+    entity_type varchar(50) NOT NULL,
+    entity_id bigint NOT NULL,
+    locale varchar(10) NOT NULL,
+    name varchar(255) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_master_data_translations_entity_locale
+    ON master_data_translations (entity_type, entity_id, locale);
+CREATE INDEX IF NOT EXISTS idx_master_data_translations_name
+    ON master_data_translations (entity_type, locale, LOWER(name));
+
+-- english names of the seeded units of measure which are not english already
+INSERT INTO master_data_translations (entity_type, entity_id, locale, name)
+SELECT 'unit_of_measure', u.id, 'en', t.name
+FROM unit_of_measures u
+JOIN (VALUES ('lusin', 'dozen'), ('botol', 'bottle')) AS t (uom, name) ON u.name = t.uom
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,28 @@
+-- Attributes the products of a type can record, e.g. the brand of a coffee or the expiry date of a milk.
+CREATE TABLE IF NOT EXISTS product_attribute_definitions (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    product_type_id bigint NOT NULL REFERENCES product_types (id),
+    name varchar(255) NOT NULL,
+    data_type varchar(20) NOT NULL,
+    is_required boolean NOT NULL DEFAULT false,
+    allowed_values jsonb NOT NULL DEFAULT '[]'
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_attribute_definitions_name
+    ON product_attribute_definitions (product_type_id, LOWER(name)) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_product_attribute_definitions_deleted_at ON product_attribute_definitions (deleted_at);
+
+-- Values of the attributes of the products, kept as text whatever the data type of the attribute.
+CREATE TABLE IF NOT EXISTS product_attributes (
+    id bigserial PRIMARY KEY,
+    product_id uuid NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    attribute_id bigint NOT NULL REFERENCES product_attribute_definitions (id),
+    value varchar(255) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_attributes_product_attribute ON product_attributes (product_id, attribute_id);
+CREATE INDEX IF NOT EXISTS idx_product_attributes_value ON product_attributes (attribute_id, LOWER(value));
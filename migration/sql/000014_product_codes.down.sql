@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_products_store_barcode;
+DROP INDEX IF EXISTS idx_products_store_sku;
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
+ALTER TABLE products DROP COLUMN IF EXISTS sku;
+ALTER TABLE products DROP COLUMN IF EXISTS description;
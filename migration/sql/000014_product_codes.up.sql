@@ -0,0 +1,9 @@
+-- Description, merchant stock keeping unit and EAN/UPC barcode of the products. The sku, ignoring the
+-- case, and the barcode are unique among the products of a store which have one.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS description text NOT NULL DEFAULT '';
+ALTER TABLE products ADD COLUMN IF NOT EXISTS sku varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode varchar(14) NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_store_sku
+    ON products (store_id, LOWER(sku)) WHERE sku <> '' AND deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_store_barcode
+    ON products (store_id, barcode) WHERE barcode <> '' AND deleted_at IS NULL;
@@ -0,0 +1,22 @@
+-- Lots of stock received for a product, the stock of the product is consumed from the lots with a
+-- remaining quantity first-expiry-first-out.
+CREATE TABLE IF NOT EXISTS product_lots (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by uuid NOT NULL,
+    updated_at timestamptz NULL,
+    updated_by uuid NULL,
+    deleted_at timestamptz NULL,
+    deleted_by uuid NULL,
+    product_id uuid NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    lot_number varchar(64) NOT NULL,
+    quantity bigint NOT NULL,
+    remaining_quantity bigint NOT NULL,
+    expiry_date date NOT NULL,
+    received_date date NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_lots_lot_number
+    ON product_lots (product_id, LOWER(lot_number)) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_product_lots_available
+    ON product_lots (product_id, expiry_date) WHERE remaining_quantity > 0;
+CREATE INDEX IF NOT EXISTS idx_product_lots_deleted_at ON product_lots (deleted_at);
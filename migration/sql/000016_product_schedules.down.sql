@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS leases;
+DROP INDEX IF EXISTS idx_products_unpublish_at;
+DROP INDEX IF EXISTS idx_products_publish_at;
+ALTER TABLE products DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE products DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,11 @@
+-- Times the products go on sale or are taken off sale, the scheduler applies them to sale_status and
+-- clears them. The scheduler runs on the replica holding its lease.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS publish_at timestamptz NULL;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS unpublish_at timestamptz NULL;
+CREATE INDEX IF NOT EXISTS idx_products_publish_at ON products (publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_unpublish_at ON products (unpublish_at) WHERE unpublish_at IS NOT NULL;
+CREATE TABLE IF NOT EXISTS leases (
+    name varchar(255) PRIMARY KEY,
+    holder varchar(255) NOT NULL,
+    expires_at timestamptz NOT NULL
+);
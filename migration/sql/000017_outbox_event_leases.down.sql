@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_outbox_events_aggregate_pending;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS locked_until;
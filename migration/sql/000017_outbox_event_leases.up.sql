@@ -0,0 +1,4 @@
+-- Lease of the relay publishing the event, the event is published outside of the transaction claiming it.
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS locked_until timestamptz NULL;
+-- Pending events of an aggregate, a claimed event must not have an earlier one waiting for a retry.
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate_pending ON outbox_events (aggregate_type, aggregate_id, sequence) WHERE published_at IS NULL;
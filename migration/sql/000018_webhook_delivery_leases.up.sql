@@ -0,0 +1,2 @@
+-- Lease of the dispatcher sending the delivery, the delivery is sent outside of the transaction claiming it.
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS locked_until timestamptz NULL;
@@ -0,0 +1,3 @@
+UPDATE product_discounts SET value = amount_minor_units / 100.0 WHERE discount_type = 'fixed';
+ALTER TABLE product_discounts DROP COLUMN amount_minor_units;
+ALTER TABLE product_discounts DROP COLUMN amount_currency;
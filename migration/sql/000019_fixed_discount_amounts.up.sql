@@ -0,0 +1,9 @@
+-- Fixed discounts are stored as exact minor units in the currency of the price of their product.
+ALTER TABLE product_discounts ADD COLUMN IF NOT EXISTS amount_minor_units bigint NOT NULL DEFAULT 0;
+ALTER TABLE product_discounts ADD COLUMN IF NOT EXISTS amount_currency char(3) NOT NULL DEFAULT 'IDR';
+UPDATE product_discounts d SET
+    amount_minor_units = round(d.value * 100)::bigint,
+    amount_currency = p.price_currency,
+    value = 0
+FROM products p
+WHERE p.id = d.product_id AND d.discount_type = 'fixed';
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *service) checkProductType(ctx context.Context, productTypeID int64) error {
+	types, err := s.productRepository.GetProductTypesByIds(ctx, []int64{productTypeID})
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err)
+	}
+	if len(types) == 0 {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND)
+	}
+	return nil
+}
+
+// UpsertProductAttributeDefinition defines an attribute of a product type, or updates it when its id
+// is set. The stored values of the products are checked against the attribute on their next update.
+func (s *service) UpsertProductAttributeDefinition(ctx context.Context, userID uuid.UUID, definition *prodEntity.ProductAttributeDefinition) (*prodEntity.ProductAttributeDefinition, error) {
+	if err := s.checkProductType(ctx, definition.ProductTypeID); err != nil {
+		return nil, err
+	}
+	if err := definition.NormalizeAllowedValues(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.productRepository.GetProductAttributeDefinitionByName(ctx, definition.ProductTypeID, definition.Name)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE, err)
+	}
+	if existing != nil && existing.ID != definition.ID {
+		return nil, storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED)
+	}
+
+	action := auditEntity.ActionCreate
+	var before *pb.ProductAttributeDefinition
+	if definition.ID != 0 {
+		stored, err := s.productRepository.GetProductAttributeDefinitionById(ctx, definition.ID)
+		if err != nil {
+			return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE, err)
+		}
+		if stored == nil || stored.ProductTypeID != definition.ProductTypeID {
+			return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NOT_FOUND)
+		}
+		action = auditEntity.ActionUpdate
+		before = stored.ToProto()
+		definition.CreatedAt = stored.CreatedAt
+		definition.CreatedBy = stored.CreatedBy
+		definition.UpdatedBy = userID
+	} else {
+		definition.CreatedBy = userID
+	}
+
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertProductAttributeDefinition(ctx, definition); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE, err)
+		}
+		return s.recordAudit(ctx, action, auditEntity.EntityProductAttribute, strconv.FormatInt(definition.ID, 10), before, definition.ToProto())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+func (s *service) ListProductAttributeDefinitions(ctx context.Context, productTypeID int64) ([]*prodEntity.ProductAttributeDefinition, error) {
+	if err := s.checkProductType(ctx, productTypeID); err != nil {
+		return nil, err
+	}
+	definitions, err := s.productRepository.GetProductAttributeDefinitions(ctx, []int64{productTypeID})
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE, err)
+	}
+	return definitions, nil
+}
+
+// validateProductAttributes checks the attribute values of the products against the attributes
+// defined for their types. The violations are named by the field of the products in the request, the
+// product of an update or the product_list of an insert.
+func (s *service) validateProductAttributes(ctx context.Context, isUpdate bool, productTypeIDs []int64, products []*prodEntity.Product) error {
+	definitions, err := s.productRepository.GetProductAttributeDefinitions(ctx, productTypeIDs)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE, err)
+	}
+	byType := make(map[int64][]*prodEntity.ProductAttributeDefinition)
+	for _, d := range definitions {
+		byType[d.ProductTypeID] = append(byType[d.ProductTypeID], d)
+	}
+
+	violations := []*storeerror.Violation{}
+	for i, p := range products {
+		field := "product"
+		if !isUpdate {
+			field = fmt.Sprintf("product_list[%d]", i)
+		}
+		violations = append(violations, p.ValidateAttributes(field, byType[p.ProductTypeID])...)
+	}
+	if len(violations) > 0 {
+		return storeerror.BadRequest(errPb.StoreErrorCode_PRODUCT_ATTRIBUTES_ARE_NOT_VALID, violations...)
+	}
+	return nil
+}
+
+// normalizeAttributeFilters writes the filtered values the way the values of their attribute are
+// stored, e.g. 2.50 as 2.5 for a number attribute.
+func (s *service) normalizeAttributeFilters(ctx context.Context, attributes map[int64]string) (map[int64]string, error) {
+	if len(attributes) == 0 {
+		return attributes, nil
+	}
+	ids := make([]int64, 0, len(attributes))
+	for id := range attributes {
+		ids = append(ids, id)
+	}
+	definitions, err := s.productRepository.GetProductAttributeDefinitionsByIds(ctx, ids)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_ATTRIBUTE, err)
+	}
+
+	normalized := make(map[int64]string, len(attributes))
+	for id, value := range attributes {
+		normalized[id] = value
+	}
+	for _, d := range definitions {
+		if value, ok := d.NormalizeValue(attributes[d.ID]); ok {
+			normalized[d.ID] = value
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
+	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordAudit appends an audit event of a change made by the caller. It has to run in the transaction
+// of the change, so the event is only kept when the change is committed and the change fails when
+// the event can not be written.
+func (s *service) recordAudit(ctx context.Context, action, entityType, entityID string, before, after proto.Message) error {
+	changes, err := auditEntity.Diff(before, after)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_RECORDING_AUDIT_EVENT, err)
+	}
+
+	event := &auditEntity.AuditEvent{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Changes:    changes,
+		RequestID:  middleware.RequestIDFromContext(ctx),
+	}
+	if claims, err := middleware.GetClaimsFromContext(ctx); err == nil {
+		event.ActorID = claims.UserID
+	}
+
+	if err := s.auditRepository.CreateAuditEvent(ctx, event); err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_RECORDING_AUDIT_EVENT, err)
+	}
+	return nil
+}
+
+// ListAuditEvents lists the audit events matching the filter, only admins may read them.
+func (s *service) ListAuditEvents(ctx context.Context, filter auditEntity.AuditEventFilter) ([]*auditEntity.AuditEvent, error) {
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+	if !claims.IsAdmin {
+		return nil, storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_READ_AUDIT_EVENTS)
+	}
+
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+
+	events, err := s.auditRepository.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_AUDIT_EVENTS, err)
+	}
+	return events, nil
+}
+
+// storeSnapshot is the audited state of a store, nil when the store does not exist.
+func storeSnapshot(store *entity.Store) *pb.Store {
+	if store == nil {
+		return nil
+	}
+	return store.ToProto()
+}
+
+// productSnapshot is the audited state of a product. The type and category names, the image urls, the
+// attribute names and the discounted price are resolved when the product is read, they are left out so only stored fields
+// are compared.
+func productSnapshot(product *prodEntity.Product) *pb.Product {
+	snapshot := product.ToProto()
+	if snapshot == nil {
+		return nil
+	}
+	snapshot.ProductTypeName = ""
+	snapshot.ProductCategoryId = 0
+	snapshot.ProductCategoryName = ""
+	snapshot.OriginalPrice = nil
+	snapshot.EffectivePrice = nil
+	snapshot.ActiveDiscount = nil
+	for _, img := range snapshot.Images {
+		img.ImageUrl = ""
+	}
+	for _, a := range snapshot.Attributes {
+		a.Name = ""
+		a.DataType = ""
+	}
+	return snapshot
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// GetProductByBarcode returns the product of the store with the barcode, e.g. scanned at a point of sale.
+func (s *service) GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*prodEntity.Product, error) {
+	if _, err := s.storeRepository.GetStore(ctx, storeID.String()); err != nil {
+		return nil, err
+	}
+	p, err := s.productRepository.GetProductByBarcode(ctx, storeID, barcode)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	}
+	if p == nil {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND)
+	}
+	if err := s.GetProductImagesInformation(ctx, p, nil); err != nil {
+		return nil, err
+	}
+	if err := s.applyPricing(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := s.localizeProducts(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// checkProductCodes makes sure the skus and barcodes of the products are not used by another product of
+// the store, nor twice in the products saved together. The skus are compared ignoring the case.
+func (s *service) checkProductCodes(ctx context.Context, storeID uuid.UUID, products []*prodEntity.Product) error {
+	saved := make(map[uuid.UUID]bool)
+	skus, barcodes := []string{}, []string{}
+	var usedSkus, usedBarcodes []string
+	seenSkus, seenBarcodes := make(map[string]bool), make(map[string]bool)
+	for _, p := range products {
+		if p.ID != uuid.Nil {
+			saved[p.ID] = true
+		}
+		if sku := strings.ToLower(p.SKU); sku != "" {
+			if seenSkus[sku] {
+				usedSkus = append(usedSkus, p.SKU)
+			}
+			seenSkus[sku] = true
+			skus = append(skus, p.SKU)
+		}
+		if p.Barcode != "" {
+			if seenBarcodes[p.Barcode] {
+				usedBarcodes = append(usedBarcodes, p.Barcode)
+			}
+			seenBarcodes[p.Barcode] = true
+			barcodes = append(barcodes, p.Barcode)
+		}
+	}
+
+	if len(skus) > 0 {
+		existingProds, err := s.productRepository.GetProductsByStoreIdAndSkus(ctx, storeID, skus)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+		}
+		for _, p := range existingProds {
+			if !saved[p.ID] {
+				usedSkus = append(usedSkus, p.SKU)
+			}
+		}
+	}
+	if len(usedSkus) > 0 {
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_SKUS_ARE_ALREADY_REGISTERED, strings.Join(usedSkus, ","))
+	}
+
+	if len(barcodes) > 0 {
+		existingProds, err := s.productRepository.GetProductsByStoreIdAndBarcodes(ctx, storeID, barcodes)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+		}
+		for _, p := range existingProds {
+			if !saved[p.ID] {
+				usedBarcodes = append(usedBarcodes, p.Barcode)
+			}
+		}
+	}
+	if len(usedBarcodes) > 0 {
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_BARCODES_ARE_ALREADY_REGISTERED, strings.Join(usedBarcodes, ","))
+	}
+	return nil
+}
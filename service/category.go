@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *service) getProductCategory(ctx context.Context, id int64) (*prodEntity.ProductCategory, error) {
+	category, err := s.productRepository.GetProductCategoryById(ctx, id)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+	}
+	if category == nil {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND)
+	}
+	return category, nil
+}
+
+// getParentCategory returns the category a category is placed below, nil for a root category.
+func (s *service) getParentCategory(ctx context.Context, parentID *int64) (*prodEntity.ProductCategory, error) {
+	if parentID == nil {
+		return nil, nil
+	}
+	parent, err := s.productRepository.GetProductCategoryById(ctx, *parentID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+	}
+	if parent == nil {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PARENT_PRODUCT_CATEGORY_NOT_FOUND)
+	}
+	return parent, nil
+}
+
+// checkCategoryName makes sure no other child of the parent has the name, the same name can be used
+// below different parents, e.g. Coffee > Instant and Noodle > Instant.
+func (s *service) checkCategoryName(ctx context.Context, parentID *int64, id int64, name string) error {
+	existing, err := s.productRepository.GetProductCategoryByName(ctx, parentID, strings.ToLower(name))
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+	}
+	if existing != nil && existing.ID != id {
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_CATEGORY_NAME_IS_ALREADY_USED)
+	}
+	return nil
+}
+
+// GetCategoryTree returns the category rootID with its subtree, or the tree of every category when
+// rootID is 0.
+func (s *service) GetCategoryTree(ctx context.Context, rootID int64, isIncludeDeactivated bool) ([]*prodEntity.ProductCategory, error) {
+	var root *prodEntity.ProductCategory
+	if rootID != 0 {
+		var err error
+		if root, err = s.getProductCategory(ctx, rootID); err != nil {
+			return nil, err
+		}
+	}
+
+	categories, err := s.productRepository.GetProductCategorySubtree(ctx, root, isIncludeDeactivated)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+	}
+	if err := s.localizeCategories(ctx, categories); err != nil {
+		return nil, err
+	}
+	return prodEntity.BuildCategoryTree(categories, root), nil
+}
+
+// MoveProductCategory moves a category with its subtree below parentID, or to the root when parentID
+// is nil. A category can not be moved below itself. The tree is locked while the category is moved, so
+// two concurrent moves can not place categories below each other.
+func (s *service) MoveProductCategory(ctx context.Context, userID uuid.UUID, id int64, parentID *int64) (*prodEntity.ProductCategory, error) {
+	var category *prodEntity.ProductCategory
+	err := s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.LockProductCategoryTree(ctx); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, err)
+		}
+		var err error
+		if category, err = s.getProductCategory(ctx, id); err != nil {
+			return err
+		}
+		parent, err := s.getParentCategory(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		if parent != nil && (parent.ID == category.ID || category.IsAncestorOf(parent)) {
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF)
+		}
+		if err := s.checkCategoryName(ctx, parentID, category.ID, category.Name); err != nil {
+			return err
+		}
+
+		before := category.ToProto()
+		oldSubtreePath := category.SubtreePath()
+		category.SetParent(parent)
+		category.UpdatedBy = userID
+
+		if err := s.productRepository.MoveProductCategory(ctx, category, oldSubtreePath); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionUpdate, auditEntity.EntityProductCategory, strconv.FormatInt(category.ID, 10), before, category.ToProto())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// RenameProductCategory renames a category, its subtree is kept as the paths only hold ids.
+func (s *service) RenameProductCategory(ctx context.Context, userID uuid.UUID, id int64, name string) (*prodEntity.ProductCategory, error) {
+	category, err := s.getProductCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCategoryName(ctx, category.ParentID, category.ID, name); err != nil {
+		return nil, err
+	}
+
+	before := category.ToProto()
+	category.Name = name
+	category.UpdatedBy = userID
+
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertProductCategory(ctx, category); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionUpdate, auditEntity.EntityProductCategory, strconv.FormatInt(category.ID, 10), before, category.ToProto())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
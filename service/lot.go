@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	prodRepository "github.com/Mitra-Apps/be-store-service/domain/product/repository"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// today returns the current date, dates of lots are kept at midnight UTC.
+func today() time.Time {
+	y, m, d := time.Now().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// CreateProductLot records a lot received for a product and adds its quantity to the stock of the
+// product, the lot is received today when it has no received date.
+func (s *service) CreateProductLot(ctx context.Context, lot *prodEntity.ProductLot) (*prodEntity.ProductLot, error) {
+	product, claims, err := s.getOwnedProduct(ctx, lot.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if lot.ReceivedDate.IsZero() {
+		lot.ReceivedDate = today()
+	}
+	if lot.ExpiryDate.Before(lot.ReceivedDate) {
+		return nil, storeerror.Invalid("lot.expiry_date", errPb.StoreErrorCode_LOT_EXPIRES_BEFORE_IT_IS_RECEIVED)
+	}
+
+	lots, err := s.productRepository.GetProductLots(ctx, lot.ProductID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT, err)
+	}
+	for _, l := range lots {
+		if strings.EqualFold(l.LotNumber, lot.LotNumber) {
+			return nil, storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_LOT_NUMBER_IS_ALREADY_USED, lot.LotNumber)
+		}
+	}
+
+	uom, err := s.productRepository.GetUnitOfMeasureById(ctx, product.UomID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	lot.RemainingQuantity = lot.Quantity
+	lot.CreatedBy = claims.UserID
+
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		updated := *product
+		updated.ProductType = prodEntity.ProductType{}
+		updated.Stock += lot.Quantity
+		updated.BaseStock = updated.Stock
+		if uom != nil {
+			updated.BaseStock = uom.ToBase(updated.Stock)
+		}
+		if err := s.productRepository.UpsertProducts(ctx, []*prodEntity.Product{&updated}); err != nil {
+			if errors.Is(err, prodRepository.ErrVersionConflict) {
+				return storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+			}
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT, err)
+		}
+		if err := s.productRepository.CreateProductLot(ctx, lot); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_LOT, err)
+		}
+		if err := s.recordAudit(ctx, auditEntity.ActionCreate, auditEntity.EntityProductLot, lot.ID.String(), nil, lot.ToProto()); err != nil {
+			return err
+		}
+		return s.recordProductChange(ctx, auditEntity.ActionUpdate, product, &updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lot, nil
+}
+
+// ListProductLots returns the lots of a product including the consumed ones, the first to expire first.
+func (s *service) ListProductLots(ctx context.Context, productID uuid.UUID) ([]*prodEntity.ProductLot, error) {
+	if _, _, err := s.getOwnedProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	lots, err := s.productRepository.GetProductLots(ctx, productID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT, err)
+	}
+	return lots, nil
+}
+
+// GetExpiringProducts returns the lots of the products of a store with a remaining quantity which expire
+// within days from today, the lots which have expired already included.
+func (s *service) GetExpiringProducts(ctx context.Context, storeID uuid.UUID, days int) ([]*prodEntity.ProductLot, error) {
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return nil, err
+	}
+	lots, err := s.productRepository.GetExpiringProductLots(ctx, storeID, today().AddDate(0, 0, days))
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT, err)
+	}
+	return lots, nil
+}
+
+// consumeProductLots takes the stock removed from the updated products out of their lots
+// first-expiry-first-out, before holds the stored products by id. The lots are left unchanged when the
+// unit of measure of a product changes, its stock can not be compared to their quantities anymore.
+func (s *service) consumeProductLots(ctx context.Context, before map[uuid.UUID]*prodEntity.Product, products []*prodEntity.Product) error {
+	for _, p := range products {
+		stored := before[p.ID]
+		if stored == nil || stored.UomID != p.UomID || p.Stock >= stored.Stock {
+			continue
+		}
+		lots, err := s.productRepository.GetAvailableProductLots(ctx, p.ID)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_LOT, err)
+		}
+		consumed := prodEntity.ConsumeLots(lots, stored.Stock-p.Stock)
+		if len(consumed) == 0 {
+			continue
+		}
+		if err := s.productRepository.UpdateProductLotsRemainingQuantity(ctx, consumed); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_LOT, err)
+		}
+	}
+	return nil
+}
@@ -12,9 +12,13 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	entity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
-	entity0 "github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	entity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	entity0 "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	entity1 "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	entity2 "github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	entity3 "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,11 +46,86 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ApplyProductSchedules mocks base method.
+func (m *MockService) ApplyProductSchedules(ctx context.Context, now time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyProductSchedules", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyProductSchedules indicates an expected call of ApplyProductSchedules.
+func (mr *MockServiceMockRecorder) ApplyProductSchedules(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyProductSchedules", reflect.TypeOf((*MockService)(nil).ApplyProductSchedules), ctx, now, limit)
+}
+
+// BatchGetProducts mocks base method.
+func (m *MockService) BatchGetProducts(ctx context.Context, ids []uuid.UUID) ([]*entity1.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetProducts", ctx, ids)
+	ret0, _ := ret[0].([]*entity1.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetProducts indicates an expected call of BatchGetProducts.
+func (mr *MockServiceMockRecorder) BatchGetProducts(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetProducts", reflect.TypeOf((*MockService)(nil).BatchGetProducts), ctx, ids)
+}
+
+// BatchGetStores mocks base method.
+func (m *MockService) BatchGetStores(ctx context.Context, storeIDs []uuid.UUID) ([]*entity2.Store, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetStores", ctx, storeIDs)
+	ret0, _ := ret[0].([]*entity2.Store)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetStores indicates an expected call of BatchGetStores.
+func (mr *MockServiceMockRecorder) BatchGetStores(ctx, storeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetStores", reflect.TypeOf((*MockService)(nil).BatchGetStores), ctx, storeIDs)
+}
+
+// CreateProductDiscount mocks base method.
+func (m *MockService) CreateProductDiscount(ctx context.Context, discount *entity1.ProductDiscount) (*entity1.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductDiscount", ctx, discount)
+	ret0, _ := ret[0].(*entity1.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductDiscount indicates an expected call of CreateProductDiscount.
+func (mr *MockServiceMockRecorder) CreateProductDiscount(ctx, discount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductDiscount", reflect.TypeOf((*MockService)(nil).CreateProductDiscount), ctx, discount)
+}
+
+// CreateProductLot mocks base method.
+func (m *MockService) CreateProductLot(ctx context.Context, lot *entity1.ProductLot) (*entity1.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductLot", ctx, lot)
+	ret0, _ := ret[0].(*entity1.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductLot indicates an expected call of CreateProductLot.
+func (mr *MockServiceMockRecorder) CreateProductLot(ctx, lot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductLot", reflect.TypeOf((*MockService)(nil).CreateProductLot), ctx, lot)
+}
+
 // CreateStore mocks base method.
-func (m *MockService) CreateStore(ctx context.Context, store *entity0.Store) (*entity0.Store, error) {
+func (m *MockService) CreateStore(ctx context.Context, store *entity2.Store) (*entity2.Store, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateStore", ctx, store)
-	ret0, _ := ret[0].(*entity0.Store)
+	ret0, _ := ret[0].(*entity2.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -57,6 +136,21 @@ func (mr *MockServiceMockRecorder) CreateStore(ctx, store any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStore", reflect.TypeOf((*MockService)(nil).CreateStore), ctx, store)
 }
 
+// CreateWebhookSubscription mocks base method.
+func (m *MockService) CreateWebhookSubscription(ctx context.Context, sub *entity3.WebhookSubscription) (*entity3.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, sub)
+	ret0, _ := ret[0].(*entity3.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockServiceMockRecorder) CreateWebhookSubscription(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockService)(nil).CreateWebhookSubscription), ctx, sub)
+}
+
 // DeleteProductById mocks base method.
 func (m *MockService) DeleteProductById(ctx context.Context, userId, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -71,6 +165,20 @@ func (mr *MockServiceMockRecorder) DeleteProductById(ctx, userId, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductById", reflect.TypeOf((*MockService)(nil).DeleteProductById), ctx, userId, id)
 }
 
+// DeleteProductDiscount mocks base method.
+func (m *MockService) DeleteProductDiscount(ctx context.Context, productID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductDiscount", ctx, productID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductDiscount indicates an expected call of DeleteProductDiscount.
+func (mr *MockServiceMockRecorder) DeleteProductDiscount(ctx, productID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductDiscount", reflect.TypeOf((*MockService)(nil).DeleteProductDiscount), ctx, productID, id)
+}
+
 // DeleteStores mocks base method.
 func (m *MockService) DeleteStores(ctx context.Context, storeIDs []string) error {
 	m.ctrl.T.Helper()
@@ -85,11 +193,70 @@ func (mr *MockServiceMockRecorder) DeleteStores(ctx, storeIDs any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStores", reflect.TypeOf((*MockService)(nil).DeleteStores), ctx, storeIDs)
 }
 
+// DeleteWebhookSubscription mocks base method.
+func (m *MockService) DeleteWebhookSubscription(ctx context.Context, storeID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, storeID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockServiceMockRecorder) DeleteWebhookSubscription(ctx, storeID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockService)(nil).DeleteWebhookSubscription), ctx, storeID, id)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockService) GetCategoryTree(ctx context.Context, rootID int64, isIncludeDeactivated bool) ([]*entity1.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx, rootID, isIncludeDeactivated)
+	ret0, _ := ret[0].([]*entity1.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockServiceMockRecorder) GetCategoryTree(ctx, rootID, isIncludeDeactivated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockService)(nil).GetCategoryTree), ctx, rootID, isIncludeDeactivated)
+}
+
+// GetExpiringProducts mocks base method.
+func (m *MockService) GetExpiringProducts(ctx context.Context, storeID uuid.UUID, days int) ([]*entity1.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiringProducts", ctx, storeID, days)
+	ret0, _ := ret[0].([]*entity1.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiringProducts indicates an expected call of GetExpiringProducts.
+func (mr *MockServiceMockRecorder) GetExpiringProducts(ctx, storeID, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiringProducts", reflect.TypeOf((*MockService)(nil).GetExpiringProducts), ctx, storeID, days)
+}
+
+// GetProductByBarcode mocks base method.
+func (m *MockService) GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*entity1.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByBarcode", ctx, storeID, barcode)
+	ret0, _ := ret[0].(*entity1.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByBarcode indicates an expected call of GetProductByBarcode.
+func (mr *MockServiceMockRecorder) GetProductByBarcode(ctx, storeID, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByBarcode", reflect.TypeOf((*MockService)(nil).GetProductByBarcode), ctx, storeID, barcode)
+}
+
 // GetProductById mocks base method.
-func (m *MockService) GetProductById(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+func (m *MockService) GetProductById(ctx context.Context, id uuid.UUID) (*entity1.Product, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProductById", ctx, id)
-	ret0, _ := ret[0].(*entity.Product)
+	ret0, _ := ret[0].(*entity1.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -101,11 +268,11 @@ func (mr *MockServiceMockRecorder) GetProductById(ctx, id any) *gomock.Call {
 }
 
 // GetProductCategories mocks base method.
-func (m *MockService) GetProductCategories(ctx context.Context, isIncludeDeactivated bool) ([]*entity.ProductCategory, []string, error) {
+func (m *MockService) GetProductCategories(ctx context.Context, isIncludeDeactivated bool) ([]*entity1.ProductCategory, []*entity1.UnitOfMeasure, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProductCategories", ctx, isIncludeDeactivated)
-	ret0, _ := ret[0].([]*entity.ProductCategory)
-	ret1, _ := ret[1].([]string)
+	ret0, _ := ret[0].([]*entity1.ProductCategory)
+	ret1, _ := ret[1].([]*entity1.UnitOfMeasure)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
@@ -117,10 +284,10 @@ func (mr *MockServiceMockRecorder) GetProductCategories(ctx, isIncludeDeactivate
 }
 
 // GetProductTypes mocks base method.
-func (m *MockService) GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) ([]*entity.ProductType, error) {
+func (m *MockService) GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) ([]*entity1.ProductType, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProductTypes", ctx, productCategoryID, isIncludeDeactivated)
-	ret0, _ := ret[0].([]*entity.ProductType)
+	ret0, _ := ret[0].([]*entity1.ProductType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -132,25 +299,25 @@ func (mr *MockServiceMockRecorder) GetProductTypes(ctx, productCategoryID, isInc
 }
 
 // GetProductsByStoreId mocks base method.
-func (m *MockService) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) ([]*entity.Product, error) {
+func (m *MockService) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId, productCategoryId *int64, attributes map[int64]string, isIncludeDeactivated bool) ([]*entity1.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByStoreId", ctx, storeID, productTypeId, isIncludeDeactivated)
-	ret0, _ := ret[0].([]*entity.Product)
+	ret := m.ctrl.Call(m, "GetProductsByStoreId", ctx, storeID, productTypeId, productCategoryId, attributes, isIncludeDeactivated)
+	ret0, _ := ret[0].([]*entity1.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductsByStoreId indicates an expected call of GetProductsByStoreId.
-func (mr *MockServiceMockRecorder) GetProductsByStoreId(ctx, storeID, productTypeId, isIncludeDeactivated any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetProductsByStoreId(ctx, storeID, productTypeId, productCategoryId, attributes, isIncludeDeactivated any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreId", reflect.TypeOf((*MockService)(nil).GetProductsByStoreId), ctx, storeID, productTypeId, isIncludeDeactivated)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByStoreId", reflect.TypeOf((*MockService)(nil).GetProductsByStoreId), ctx, storeID, productTypeId, productCategoryId, attributes, isIncludeDeactivated)
 }
 
 // GetStore mocks base method.
-func (m *MockService) GetStore(ctx context.Context, storeID string) (*entity0.Store, error) {
+func (m *MockService) GetStore(ctx context.Context, storeID string) (*entity2.Store, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStore", ctx, storeID)
-	ret0, _ := ret[0].(*entity0.Store)
+	ret0, _ := ret[0].(*entity2.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -162,10 +329,10 @@ func (mr *MockServiceMockRecorder) GetStore(ctx, storeID any) *gomock.Call {
 }
 
 // GetStoreByUserID mocks base method.
-func (m *MockService) GetStoreByUserID(ctx context.Context, userID uuid.UUID) (*entity0.Store, error) {
+func (m *MockService) GetStoreByUserID(ctx context.Context, userID uuid.UUID) (*entity2.Store, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoreByUserID", ctx, userID)
-	ret0, _ := ret[0].(*entity0.Store)
+	ret0, _ := ret[0].(*entity2.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -176,11 +343,26 @@ func (mr *MockServiceMockRecorder) GetStoreByUserID(ctx, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreByUserID", reflect.TypeOf((*MockService)(nil).GetStoreByUserID), ctx, userID)
 }
 
+// GetTranslations mocks base method.
+func (m *MockService) GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*entity1.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslations", ctx, entityType, entityID)
+	ret0, _ := ret[0].([]*entity1.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslations indicates an expected call of GetTranslations.
+func (mr *MockServiceMockRecorder) GetTranslations(ctx, entityType, entityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslations", reflect.TypeOf((*MockService)(nil).GetTranslations), ctx, entityType, entityID)
+}
+
 // GetUnitOfMeasures mocks base method.
-func (m *MockService) GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) ([]*entity.UnitOfMeasure, error) {
+func (m *MockService) GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) ([]*entity1.UnitOfMeasure, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnitOfMeasures", ctx, isIncludeDeactivated)
-	ret0, _ := ret[0].([]*entity.UnitOfMeasure)
+	ret0, _ := ret[0].([]*entity1.UnitOfMeasure)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -191,11 +373,86 @@ func (mr *MockServiceMockRecorder) GetUnitOfMeasures(ctx, isIncludeDeactivated a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitOfMeasures", reflect.TypeOf((*MockService)(nil).GetUnitOfMeasures), ctx, isIncludeDeactivated)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockService) ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter) ([]*entity.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, filter)
+	ret0, _ := ret[0].([]*entity.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockServiceMockRecorder) ListAuditEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockService)(nil).ListAuditEvents), ctx, filter)
+}
+
+// ListProductAttributeDefinitions mocks base method.
+func (m *MockService) ListProductAttributeDefinitions(ctx context.Context, productTypeID int64) ([]*entity1.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductAttributeDefinitions", ctx, productTypeID)
+	ret0, _ := ret[0].([]*entity1.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductAttributeDefinitions indicates an expected call of ListProductAttributeDefinitions.
+func (mr *MockServiceMockRecorder) ListProductAttributeDefinitions(ctx, productTypeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductAttributeDefinitions", reflect.TypeOf((*MockService)(nil).ListProductAttributeDefinitions), ctx, productTypeID)
+}
+
+// ListProductDiscounts mocks base method.
+func (m *MockService) ListProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*entity1.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductDiscounts", ctx, productID)
+	ret0, _ := ret[0].([]*entity1.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductDiscounts indicates an expected call of ListProductDiscounts.
+func (mr *MockServiceMockRecorder) ListProductDiscounts(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductDiscounts", reflect.TypeOf((*MockService)(nil).ListProductDiscounts), ctx, productID)
+}
+
+// ListProductLots mocks base method.
+func (m *MockService) ListProductLots(ctx context.Context, productID uuid.UUID) ([]*entity1.ProductLot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductLots", ctx, productID)
+	ret0, _ := ret[0].([]*entity1.ProductLot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductLots indicates an expected call of ListProductLots.
+func (mr *MockServiceMockRecorder) ListProductLots(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductLots", reflect.TypeOf((*MockService)(nil).ListProductLots), ctx, productID)
+}
+
+// ListProductPriceHistory mocks base method.
+func (m *MockService) ListProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*entity1.ProductPriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductPriceHistory", ctx, productID, page, limit)
+	ret0, _ := ret[0].([]*entity1.ProductPriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductPriceHistory indicates an expected call of ListProductPriceHistory.
+func (mr *MockServiceMockRecorder) ListProductPriceHistory(ctx, productID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductPriceHistory", reflect.TypeOf((*MockService)(nil).ListProductPriceHistory), ctx, productID, page, limit)
+}
+
 // ListStores mocks base method.
-func (m *MockService) ListStores(ctx context.Context, page, limit int32) ([]*entity0.Store, error) {
+func (m *MockService) ListStores(ctx context.Context, page, limit int32) ([]*entity2.Store, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStores", ctx, page, limit)
-	ret0, _ := ret[0].([]*entity0.Store)
+	ret0, _ := ret[0].([]*entity2.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -206,6 +463,51 @@ func (mr *MockServiceMockRecorder) ListStores(ctx, page, limit any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStores", reflect.TypeOf((*MockService)(nil).ListStores), ctx, page, limit)
 }
 
+// ListWebhookDeliveries mocks base method.
+func (m *MockService) ListWebhookDeliveries(ctx context.Context, storeID, subscriptionID uuid.UUID, page, limit int) ([]*entity3.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, storeID, subscriptionID, page, limit)
+	ret0, _ := ret[0].([]*entity3.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockServiceMockRecorder) ListWebhookDeliveries(ctx, storeID, subscriptionID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockService)(nil).ListWebhookDeliveries), ctx, storeID, subscriptionID, page, limit)
+}
+
+// ListWebhookSubscriptions mocks base method.
+func (m *MockService) ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*entity3.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptions", ctx, storeID)
+	ret0, _ := ret[0].([]*entity3.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptions indicates an expected call of ListWebhookSubscriptions.
+func (mr *MockServiceMockRecorder) ListWebhookSubscriptions(ctx, storeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptions", reflect.TypeOf((*MockService)(nil).ListWebhookSubscriptions), ctx, storeID)
+}
+
+// MoveProductCategory mocks base method.
+func (m *MockService) MoveProductCategory(ctx context.Context, userID uuid.UUID, id int64, parentID *int64) (*entity1.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProductCategory", ctx, userID, id, parentID)
+	ret0, _ := ret[0].(*entity1.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProductCategory indicates an expected call of MoveProductCategory.
+func (mr *MockServiceMockRecorder) MoveProductCategory(ctx, userID, id, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProductCategory", reflect.TypeOf((*MockService)(nil).MoveProductCategory), ctx, userID, id, parentID)
+}
+
 // OpenCloseStore mocks base method.
 func (m *MockService) OpenCloseStore(ctx context.Context, userID uuid.UUID, roleNames []string, storeID string, isActive bool) error {
 	m.ctrl.T.Helper()
@@ -220,23 +522,53 @@ func (mr *MockServiceMockRecorder) OpenCloseStore(ctx, userID, roleNames, storeI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenCloseStore", reflect.TypeOf((*MockService)(nil).OpenCloseStore), ctx, userID, roleNames, storeID, isActive)
 }
 
+// RenameProductCategory mocks base method.
+func (m *MockService) RenameProductCategory(ctx context.Context, userID uuid.UUID, id int64, name string) (*entity1.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameProductCategory", ctx, userID, id, name)
+	ret0, _ := ret[0].(*entity1.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameProductCategory indicates an expected call of RenameProductCategory.
+func (mr *MockServiceMockRecorder) RenameProductCategory(ctx, userID, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameProductCategory", reflect.TypeOf((*MockService)(nil).RenameProductCategory), ctx, userID, id, name)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockService) ReplayWebhookDelivery(ctx context.Context, storeID, subscriptionID, deliveryID uuid.UUID) (*entity3.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, storeID, subscriptionID, deliveryID)
+	ret0, _ := ret[0].(*entity3.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockServiceMockRecorder) ReplayWebhookDelivery(ctx, storeID, subscriptionID, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockService)(nil).ReplayWebhookDelivery), ctx, storeID, subscriptionID, deliveryID)
+}
+
 // UpdateStore mocks base method.
-func (m *MockService) UpdateStore(ctx context.Context, storeID string, update *entity0.Store) (*entity0.Store, error) {
+func (m *MockService) UpdateStore(ctx context.Context, storeID string, update *entity2.Store, paths []string) (*entity2.Store, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStore", ctx, storeID, update)
-	ret0, _ := ret[0].(*entity0.Store)
+	ret := m.ctrl.Call(m, "UpdateStore", ctx, storeID, update, paths)
+	ret0, _ := ret[0].(*entity2.Store)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStore indicates an expected call of UpdateStore.
-func (mr *MockServiceMockRecorder) UpdateStore(ctx, storeID, update any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateStore(ctx, storeID, update, paths any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStore", reflect.TypeOf((*MockService)(nil).UpdateStore), ctx, storeID, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStore", reflect.TypeOf((*MockService)(nil).UpdateStore), ctx, storeID, update, paths)
 }
 
 // UpdateUnitOfMeasure mocks base method.
-func (m *MockService) UpdateUnitOfMeasure(ctx context.Context, uomId int64, uom *entity.UnitOfMeasure) error {
+func (m *MockService) UpdateUnitOfMeasure(ctx context.Context, uomId int64, uom *entity1.UnitOfMeasure) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateUnitOfMeasure", ctx, uomId, uom)
 	ret0, _ := ret[0].(error)
@@ -249,8 +581,38 @@ func (mr *MockServiceMockRecorder) UpdateUnitOfMeasure(ctx, uomId, uom any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnitOfMeasure", reflect.TypeOf((*MockService)(nil).UpdateUnitOfMeasure), ctx, uomId, uom)
 }
 
+// UpdateWebhookSubscription mocks base method.
+func (m *MockService) UpdateWebhookSubscription(ctx context.Context, update *entity3.WebhookSubscription) (*entity3.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookSubscription", ctx, update)
+	ret0, _ := ret[0].(*entity3.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookSubscription indicates an expected call of UpdateWebhookSubscription.
+func (mr *MockServiceMockRecorder) UpdateWebhookSubscription(ctx, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookSubscription", reflect.TypeOf((*MockService)(nil).UpdateWebhookSubscription), ctx, update)
+}
+
+// UpsertProductAttributeDefinition mocks base method.
+func (m *MockService) UpsertProductAttributeDefinition(ctx context.Context, userID uuid.UUID, definition *entity1.ProductAttributeDefinition) (*entity1.ProductAttributeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProductAttributeDefinition", ctx, userID, definition)
+	ret0, _ := ret[0].(*entity1.ProductAttributeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProductAttributeDefinition indicates an expected call of UpsertProductAttributeDefinition.
+func (mr *MockServiceMockRecorder) UpsertProductAttributeDefinition(ctx, userID, definition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProductAttributeDefinition", reflect.TypeOf((*MockService)(nil).UpsertProductAttributeDefinition), ctx, userID, definition)
+}
+
 // UpsertProductCategory mocks base method.
-func (m *MockService) UpsertProductCategory(ctx context.Context, prodCategory *entity.ProductCategory) error {
+func (m *MockService) UpsertProductCategory(ctx context.Context, prodCategory *entity1.ProductCategory) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpsertProductCategory", ctx, prodCategory)
 	ret0, _ := ret[0].(error)
@@ -264,7 +626,7 @@ func (mr *MockServiceMockRecorder) UpsertProductCategory(ctx, prodCategory any)
 }
 
 // UpsertProductType mocks base method.
-func (m *MockService) UpsertProductType(ctx context.Context, prodType *entity.ProductType) error {
+func (m *MockService) UpsertProductType(ctx context.Context, prodType *entity1.ProductType) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpsertProductType", ctx, prodType)
 	ret0, _ := ret[0].(error)
@@ -278,9 +640,9 @@ func (mr *MockServiceMockRecorder) UpsertProductType(ctx, prodType any) *gomock.
 }
 
 // UpsertProducts mocks base method.
-func (m *MockService) UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, products ...*entity.Product) error {
+func (m *MockService) UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, paths []string, products ...*entity1.Product) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID, roleNames, storeID, isUpdate}
+	varargs := []any{ctx, userID, roleNames, storeID, isUpdate, paths}
 	for _, a := range products {
 		varargs = append(varargs, a)
 	}
@@ -290,14 +652,29 @@ func (m *MockService) UpsertProducts(ctx context.Context, userID uuid.UUID, role
 }
 
 // UpsertProducts indicates an expected call of UpsertProducts.
-func (mr *MockServiceMockRecorder) UpsertProducts(ctx, userID, roleNames, storeID, isUpdate any, products ...any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpsertProducts(ctx, userID, roleNames, storeID, isUpdate, paths any, products ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID, roleNames, storeID, isUpdate}, products...)
+	varargs := append([]any{ctx, userID, roleNames, storeID, isUpdate, paths}, products...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProducts", reflect.TypeOf((*MockService)(nil).UpsertProducts), varargs...)
 }
 
+// UpsertTranslations mocks base method.
+func (m *MockService) UpsertTranslations(ctx context.Context, entityType string, entityID int64, translations []*entity1.Translation) ([]*entity1.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTranslations", ctx, entityType, entityID, translations)
+	ret0, _ := ret[0].([]*entity1.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTranslations indicates an expected call of UpsertTranslations.
+func (mr *MockServiceMockRecorder) UpsertTranslations(ctx, entityType, entityID, translations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTranslations", reflect.TypeOf((*MockService)(nil).UpsertTranslations), ctx, entityType, entityID, translations)
+}
+
 // UpsertUnitOfMeasure mocks base method.
-func (m *MockService) UpsertUnitOfMeasure(ctx context.Context, uom *entity.UnitOfMeasure) error {
+func (m *MockService) UpsertUnitOfMeasure(ctx context.Context, uom *entity1.UnitOfMeasure) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpsertUnitOfMeasure", ctx, uom)
 	ret0, _ := ret[0].(error)
@@ -309,3 +686,17 @@ func (mr *MockServiceMockRecorder) UpsertUnitOfMeasure(ctx, uom any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUnitOfMeasure", reflect.TypeOf((*MockService)(nil).UpsertUnitOfMeasure), ctx, uom)
 }
+
+// WatchStoreEvents mocks base method.
+func (m *MockService) WatchStoreEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, send func(*entity0.OutboxEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchStoreEvents", ctx, storeID, eventTypes, afterSequence, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchStoreEvents indicates an expected call of WatchStoreEvents.
+func (mr *MockServiceMockRecorder) WatchStoreEvents(ctx, storeID, eventTypes, afterSequence, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchStoreEvents", reflect.TypeOf((*MockService)(nil).WatchStoreEvents), ctx, storeID, eventTypes, afterSequence, send)
+}
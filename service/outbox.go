@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// addEvent writes a domain event of a store to the outbox. It has to run in the transaction of the
+// change, so the event is only published when the change is committed.
+func (s *service) addEvent(ctx context.Context, storeID uuid.UUID, eventType, aggregateType, aggregateID string, payload interface{}) error {
+	event, err := outboxEntity.NewOutboxEvent(storeID, eventType, aggregateType, aggregateID, payload)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_WRITING_DOMAIN_EVENT, err)
+	}
+	if err := s.outboxRepository.CreateOutboxEvent(ctx, event); err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_WRITING_DOMAIN_EVENT, err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// applyPricing sets the effective price of the products from the discounts active now.
+func (s *service) applyPricing(ctx context.Context, products ...*prodEntity.Product) error {
+	ids := []uuid.UUID{}
+	for _, p := range products {
+		if p != nil {
+			ids = append(ids, p.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	discounts, err := s.productRepository.GetActiveProductDiscounts(ctx, ids, now)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+	}
+	for _, p := range products {
+		if p != nil {
+			p.ApplyDiscounts(discounts, now)
+		}
+	}
+	return nil
+}
+
+// recordPriceChanges appends a price history entry for every created product and every product whose
+// price changed, before holds the stored products by id.
+func (s *service) recordPriceChanges(ctx context.Context, userID uuid.UUID, before map[uuid.UUID]*prodEntity.Product, products []*prodEntity.Product) error {
+	histories := []*prodEntity.ProductPriceHistory{}
+	now := time.Now()
+	for _, p := range products {
+		history := &prodEntity.ProductPriceHistory{
+			ProductID:     p.ID,
+			Price:         p.Price,
+			PreviousPrice: prodEntity.NewMoney(0, p.Price.Currency),
+			ChangedBy:     userID,
+			ChangedAt:     now,
+		}
+		if stored := before[p.ID]; stored != nil {
+			if stored.Price == p.Price {
+				continue
+			}
+			history.PreviousPrice = stored.Price
+		}
+		histories = append(histories, history)
+	}
+	if len(histories) == 0 {
+		return nil
+	}
+
+	if err := s.productRepository.CreateProductPriceHistories(ctx, histories); err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+	}
+	return nil
+}
+
+// getOwnedProduct returns the product when the caller owns its store or is an admin.
+func (s *service) getOwnedProduct(ctx context.Context, productID uuid.UUID) (*prodEntity.Product, *middleware.JwtClaims, error) {
+	product, err := s.productRepository.GetProductById(ctx, productID)
+	if err != nil {
+		return nil, nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	}
+	if product == nil {
+		return nil, nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND)
+	}
+	claims, err := s.authorizeStoreOwner(ctx, product.StoreID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return product, claims, nil
+}
+
+// validateDiscount checks a discount of product. A fixed discount sent only as value is taken in the
+// currency of the price of the product.
+func validateDiscount(d *prodEntity.ProductDiscount, product *prodEntity.Product) error {
+	switch d.DiscountType {
+	case prodEntity.DiscountPercentage:
+		if d.Value <= 0 || d.Value > 100 {
+			return storeerror.Invalid("value", errPb.StoreErrorCode_INVALID_PERCENTAGE_DISCOUNT)
+		}
+		d.Amount = prodEntity.NewMoney(0, product.Price.Currency)
+	case prodEntity.DiscountFixed:
+		field := "amount"
+		if d.Amount == (prodEntity.Money{}) {
+			field = "value"
+			d.Amount = prodEntity.MoneyFromFloat(d.Value, product.Price.Currency)
+		}
+		d.Value = 0
+		if d.Amount.MinorUnits <= 0 {
+			return storeerror.Invalid(field, errPb.StoreErrorCode_INVALID_FIXED_DISCOUNT)
+		}
+		if err := d.Amount.Validate(); err != nil {
+			return err
+		}
+		if d.Amount.Currency != product.Price.Currency {
+			return storeerror.Invalid(field, errPb.StoreErrorCode_DISCOUNT_CURRENCY_MISMATCH, d.Amount.Currency, product.Price.Currency)
+		}
+	default:
+		return storeerror.Invalid("discount_type", errPb.StoreErrorCode_INVALID_PRODUCT_DISCOUNT)
+	}
+	if d.EndsAt != nil && !d.EndsAt.After(d.StartsAt) {
+		return storeerror.Invalid("ends_at", errPb.StoreErrorCode_INVALID_DISCOUNT_PERIOD)
+	}
+	return nil
+}
+
+// CreateProductDiscount schedules a discount of a product. When several discounts are active at the
+// same time the lowest price applies.
+func (s *service) CreateProductDiscount(ctx context.Context, discount *prodEntity.ProductDiscount) (*prodEntity.ProductDiscount, error) {
+	product, claims, err := s.getOwnedProduct(ctx, discount.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if discount.StartsAt.IsZero() {
+		discount.StartsAt = time.Now()
+	}
+	if err := validateDiscount(discount, product); err != nil {
+		return nil, err
+	}
+	discount.CreatedBy = claims.UserID
+
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.CreateProductDiscount(ctx, discount); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionCreate, auditEntity.EntityProductDiscount, discount.ID.String(), nil, discount.ToProto())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return discount, nil
+}
+
+// ListProductDiscounts returns the discounts of a product including the ended ones, the latest first.
+func (s *service) ListProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*prodEntity.ProductDiscount, error) {
+	if _, _, err := s.getOwnedProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	discounts, err := s.productRepository.GetProductDiscounts(ctx, productID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+	}
+	return discounts, nil
+}
+
+func (s *service) DeleteProductDiscount(ctx context.Context, productID, id uuid.UUID) error {
+	_, claims, err := s.getOwnedProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	discount, err := s.productRepository.GetProductDiscountById(ctx, id)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+	}
+	if discount == nil || discount.ProductID != productID {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_DISCOUNT_NOT_FOUND)
+	}
+	discount.DeletedBy = claims.UserID
+
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.DeleteProductDiscount(ctx, discount); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionDelete, auditEntity.EntityProductDiscount, discount.ID.String(), discount.ToProto(), nil)
+	})
+}
+
+// ListProductPriceHistory returns the price changes of a product, the latest first.
+func (s *service) ListProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*prodEntity.ProductPriceHistory, error) {
+	if _, _, err := s.getOwnedProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	histories, err := s.productRepository.GetProductPriceHistory(ctx, productID, page, limit)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_PRICE, err)
+	}
+	return histories, nil
+}
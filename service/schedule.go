@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	prodRepository "github.com/Mitra-Apps/be-store-service/domain/product/repository"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc/codes"
+)
+
+// ApplyProductSchedules applies the publish and unpublish schedules due at now to the sale status of up
+// to limit products and returns how many products were claimed. The products are locked until the
+// change is committed, so a schedule is applied once even when several schedulers run.
+func (s *service) ApplyProductSchedules(ctx context.Context, now time.Time, limit int) (int, error) {
+	claimed := 0
+	err := s.transaction.Do(ctx, func(ctx context.Context) error {
+		products, err := s.productRepository.ClaimScheduledProducts(ctx, now, limit)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+		}
+		claimed = len(products)
+
+		for _, p := range products {
+			stored := *p
+			if !p.ApplySchedule(now) {
+				continue
+			}
+			if err := s.productRepository.UpsertProducts(ctx, []*prodEntity.Product{p}); err != nil {
+				if errors.Is(err, prodRepository.ErrVersionConflict) {
+					return storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+				}
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT, err)
+			}
+			if err := s.recordProductChange(ctx, auditEntity.ActionUpdate, &stored, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return claimed, err
+}
@@ -2,20 +2,31 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	auditRepository "github.com/Mitra-Apps/be-store-service/domain/audit/repository"
+	imageEntity "github.com/Mitra-Apps/be-store-service/domain/image/entity"
 	imageRepository "github.com/Mitra-Apps/be-store-service/domain/image/repository"
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	outboxRepository "github.com/Mitra-Apps/be-store-service/domain/outbox/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/watch"
 	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
 	prodRepository "github.com/Mitra-Apps/be-store-service/domain/product/repository"
 	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
 	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
 	"github.com/Mitra-Apps/be-store-service/domain/store/repository"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	webhookEntity "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	webhookRepository "github.com/Mitra-Apps/be-store-service/domain/webhook/repository"
 	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
 	"github.com/Mitra-Apps/be-store-service/lib"
 	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
-	util "github.com/Mitra-Apps/be-utility-service/service"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,29 +34,61 @@ import (
 
 type Service interface {
 	CreateStore(ctx context.Context, store *entity.Store) (*entity.Store, error)
-	UpdateStore(ctx context.Context, storeID string, update *entity.Store) (*entity.Store, error)
+	UpdateStore(ctx context.Context, storeID string, update *entity.Store, paths []string) (*entity.Store, error)
 	GetStore(ctx context.Context, storeID string) (*entity.Store, error)
+	BatchGetStores(ctx context.Context, storeIDs []uuid.UUID) ([]*entity.Store, error)
 	ListStores(ctx context.Context, page int32, limit int32) ([]*entity.Store, error)
 	DeleteStores(ctx context.Context, storeIDs []string) error
 	OpenCloseStore(ctx context.Context, userID uuid.UUID, roleNames []string, storeID string, isActive bool) error
-	UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, products ...*prodEntity.Product) error
+	UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, paths []string, products ...*prodEntity.Product) error
 	UpsertUnitOfMeasure(ctx context.Context, uom *prodEntity.UnitOfMeasure) error
 	UpsertProductCategory(ctx context.Context, prodCategory *prodEntity.ProductCategory) error
 	UpsertProductType(ctx context.Context, prodType *prodEntity.ProductType) error
 	GetProductById(ctx context.Context, id uuid.UUID) (*prodEntity.Product, error)
-	GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) (products []*prodEntity.Product, err error)
+	GetProductByBarcode(ctx context.Context, storeID uuid.UUID, barcode string) (*prodEntity.Product, error)
+	CreateProductLot(ctx context.Context, lot *prodEntity.ProductLot) (*prodEntity.ProductLot, error)
+	ListProductLots(ctx context.Context, productID uuid.UUID) ([]*prodEntity.ProductLot, error)
+	GetExpiringProducts(ctx context.Context, storeID uuid.UUID, days int) ([]*prodEntity.ProductLot, error)
+	ApplyProductSchedules(ctx context.Context, now time.Time, limit int) (int, error)
+	BatchGetProducts(ctx context.Context, ids []uuid.UUID) ([]*prodEntity.Product, error)
+	GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, productCategoryId *int64, attributes map[int64]string, isIncludeDeactivated bool) (products []*prodEntity.Product, err error)
 	DeleteProductById(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
 	GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) (uom []*prodEntity.UnitOfMeasure, err error)
-	GetProductCategories(ctx context.Context, isIncludeDeactivated bool) (cat []*prodEntity.ProductCategory, uom []string, err error)
+	GetProductCategories(ctx context.Context, isIncludeDeactivated bool) (cat []*prodEntity.ProductCategory, uom []*prodEntity.UnitOfMeasure, err error)
 	GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) (types []*prodEntity.ProductType, err error)
 	GetStoreByUserID(ctx context.Context, userID uuid.UUID) (store *entity.Store, err error)
 	UpdateUnitOfMeasure(ctx context.Context, uomId int64, uom *prodEntity.UnitOfMeasure) error
+	ListAuditEvents(ctx context.Context, filter auditEntity.AuditEventFilter) ([]*auditEntity.AuditEvent, error)
+	CreateWebhookSubscription(ctx context.Context, sub *webhookEntity.WebhookSubscription) (*webhookEntity.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*webhookEntity.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, update *webhookEntity.WebhookSubscription) (*webhookEntity.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, storeID, id uuid.UUID) error
+	ListWebhookDeliveries(ctx context.Context, storeID, subscriptionID uuid.UUID, page, limit int) ([]*webhookEntity.WebhookDelivery, error)
+	ReplayWebhookDelivery(ctx context.Context, storeID, subscriptionID, deliveryID uuid.UUID) (*webhookEntity.WebhookDelivery, error)
+	WatchStoreEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, send func(*outboxEntity.OutboxEvent) error) error
+	CreateProductDiscount(ctx context.Context, discount *prodEntity.ProductDiscount) (*prodEntity.ProductDiscount, error)
+	ListProductDiscounts(ctx context.Context, productID uuid.UUID) ([]*prodEntity.ProductDiscount, error)
+	DeleteProductDiscount(ctx context.Context, productID, id uuid.UUID) error
+	ListProductPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) ([]*prodEntity.ProductPriceHistory, error)
+	GetCategoryTree(ctx context.Context, rootID int64, isIncludeDeactivated bool) ([]*prodEntity.ProductCategory, error)
+	MoveProductCategory(ctx context.Context, userID uuid.UUID, id int64, parentID *int64) (*prodEntity.ProductCategory, error)
+	RenameProductCategory(ctx context.Context, userID uuid.UUID, id int64, name string) (*prodEntity.ProductCategory, error)
+	GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*prodEntity.Translation, error)
+	UpsertTranslations(ctx context.Context, entityType string, entityID int64, translations []*prodEntity.Translation) ([]*prodEntity.Translation, error)
+	UpsertProductAttributeDefinition(ctx context.Context, userID uuid.UUID, definition *prodEntity.ProductAttributeDefinition) (*prodEntity.ProductAttributeDefinition, error)
+	ListProductAttributeDefinitions(ctx context.Context, productTypeID int64) ([]*prodEntity.ProductAttributeDefinition, error)
 }
 type service struct {
-	storeRepository   repository.StoreServiceRepository
-	productRepository prodRepository.ProductRepository
-	storage           repository.Storage
-	imageRepository   imageRepository.ImageRepository
+	storeRepository       repository.StoreServiceRepository
+	productRepository     prodRepository.ProductRepository
+	storage               repository.Storage
+	imageRepository       imageRepository.ImageRepository
+	imageObjectRepository imageRepository.ImageObjectRepository
+	transaction           transaction.Manager
+	auditRepository       auditRepository.AuditRepository
+	outboxRepository      outboxRepository.OutboxRepository
+	webhookRepository     webhookRepository.WebhookRepository
+	notifier              watch.Notifier
 }
 
 func New(
@@ -53,19 +96,31 @@ func New(
 	prodRepository prodRepository.ProductRepository,
 	storage repository.Storage,
 	imageRepo imageRepository.ImageRepository,
+	imageObjectRepo imageRepository.ImageObjectRepository,
+	trx transaction.Manager,
+	auditRepo auditRepository.AuditRepository,
+	outboxRepo outboxRepository.OutboxRepository,
+	webhookRepo webhookRepository.WebhookRepository,
+	notifier watch.Notifier,
 ) Service {
 	return &service{
-		storeRepository:   storeRepository,
-		productRepository: prodRepository,
-		storage:           storage,
-		imageRepository:   imageRepo,
+		storeRepository:       storeRepository,
+		productRepository:     prodRepository,
+		storage:               storage,
+		imageRepository:       imageRepo,
+		imageObjectRepository: imageObjectRepo,
+		transaction:           trx,
+		auditRepository:       auditRepo,
+		outboxRepository:      outboxRepo,
+		webhookRepository:     webhookRepo,
+		notifier:              notifier,
 	}
 }
 
 func (s *service) CreateStore(ctx context.Context, store *entity.Store) (*entity.Store, error) {
 	claims, err := middleware.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting user id")
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	store.UserID = claims.UserID
@@ -73,13 +128,14 @@ func (s *service) CreateStore(ctx context.Context, store *entity.Store) (*entity
 
 	exist, err := s.storeRepository.GetStoreByUserID(ctx, store.UserID)
 	if err != nil {
-		return nil, err
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_STORE, err)
 	}
 
 	if exist != nil {
-		return nil, status.Errorf(codes.AlreadyExists, "User already has a store")
+		return nil, storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_USER_ALREADY_HAS_STORE)
 	}
 
+	store.NormalizeImages()
 	for _, img := range store.Images {
 		imageURL, err := s.storage.UploadImage(ctx, img.ImageBase64, store.UserID.String())
 		if err != nil {
@@ -99,93 +155,225 @@ func (s *service) CreateStore(ctx context.Context, store *entity.Store) (*entity
 		}
 	}
 
-	return s.storeRepository.CreateStore(ctx, store)
+	var created *entity.Store
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if created, err = s.storeRepository.CreateStore(ctx, store); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_STORE, err)
+		}
+		if err := s.recordAudit(ctx, auditEntity.ActionCreate, auditEntity.EntityStore, created.ID.String(), nil, storeSnapshot(created)); err != nil {
+			return err
+		}
+		return s.addEvent(ctx, created.ID, outboxEntity.StoreCreated, outboxEntity.AggregateStore, created.ID.String(), storeSnapshot(created))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 func (s *service) GetStore(ctx context.Context, storeID string) (*entity.Store, error) {
 	return s.storeRepository.GetStore(ctx, storeID)
 }
 
-func (s *service) UpdateStore(ctx context.Context, storeID string, update *entity.Store) (*entity.Store, error) {
-	claims, err := middleware.GetClaimsFromContext(ctx)
+// BatchGetStores returns the stores in the order of storeIDs, a missing store is nil.
+func (s *service) BatchGetStores(ctx context.Context, storeIDs []uuid.UUID) ([]*entity.Store, error) {
+	stores, err := s.storeRepository.GetStoresByIds(ctx, storeIDs)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "Error when getting user id")
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_STORE, err)
+	}
+	storeMap := make(map[uuid.UUID]*entity.Store, len(stores))
+	for _, store := range stores {
+		storeMap[store.ID] = store
 	}
 
-	if claims.UserID.String() != update.UserID.String() && !claims.IsAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "You don't have permission to update this store")
+	result := make([]*entity.Store, len(storeIDs))
+	for i, id := range storeIDs {
+		result[i] = storeMap[id]
+	}
+	return result, nil
+}
+
+// UpdateStore updates the store fields listed in paths, every field when paths is empty.
+func (s *service) UpdateStore(ctx context.Context, storeID string, update *entity.Store, paths []string) (*entity.Store, error) {
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
 	}
 
 	update.UpdatedBy = claims.UserID
 	update.ID, err = uuid.Parse(storeID)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "store id should be uuid")
+		return nil, storeerror.InvalidID("store_id")
+	}
+	if update.Version == 0 {
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_VERSION_IS_REQUIRED)
 	}
 
-	if exist, err := s.GetStore(ctx, storeID); err != nil {
+	exist, err := s.GetStore(ctx, storeID)
+	if err != nil {
 		return nil, err
-	} else if exist.UserID != claims.UserID {
-		return nil, status.Errorf(codes.PermissionDenied, "You don't have permission to update this store")
+	}
+	before := storeSnapshot(exist)
+	if len(paths) > 0 {
+		if err := update.ApplyFieldMask(exist, paths); err != nil {
+			return nil, err
+		}
 	}
 
-	for _, img := range update.Images {
-		if img.ImageBase64 != "" {
-			if img.ImageURL, err = s.storage.UploadImage(ctx, img.ImageBase64, storeID); err != nil {
-				return nil, err
+	if claims.UserID.String() != update.UserID.String() && !claims.IsAdmin {
+		return nil, storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE)
+	}
+	if exist.UserID != claims.UserID {
+		return nil, storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE)
+	}
+	if exist.Version != update.Version {
+		// fail before uploading the images, the repository checks the version again when saving
+		return nil, storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+	}
+
+	// collections which are not listed in the mask are kept as they are stored
+	if lib.InFieldMask(paths, "images") {
+		update.NormalizeImages()
+		for _, img := range update.Images {
+			if img.ImageBase64 != "" {
+				if img.ImageURL, err = s.storage.UploadImage(ctx, img.ImageBase64, storeID); err != nil {
+					return nil, err
+				}
 			}
-		}
 
-		img.UpdatedBy = claims.UserID
-		img.ImageBase64 = ""
-		img.StoreID = update.ID
-		img.ID = uuid.Nil
+			img.UpdatedBy = claims.UserID
+			img.ImageBase64 = ""
+			img.StoreID = update.ID
+			img.ID = uuid.Nil
+		}
 	}
 
-	for _, tag := range update.Tags {
-		tag.UpdatedBy = claims.UserID
-		tag.ID = uuid.Nil
+	if lib.InFieldMask(paths, "tags") {
+		for _, tag := range update.Tags {
+			tag.UpdatedBy = claims.UserID
+			tag.ID = uuid.Nil
+		}
 	}
 
-	for _, hour := range update.Hours {
-		hour.UpdatedBy = claims.UserID
-		hour.StoreID = update.ID
-		hour.ID = uuid.Nil
+	if lib.InFieldMask(paths, "hours") {
+		for _, hour := range update.Hours {
+			hour.UpdatedBy = claims.UserID
+			hour.StoreID = update.ID
+			hour.ID = uuid.Nil
 
-		if !hour.IsOpen {
-			hour.Open = "00:00"
-			hour.Close = "00:00"
-		} else if hour.Is24Hr {
-			hour.Open = "00:00"
-			hour.Close = "23:59"
+			if !hour.IsOpen {
+				hour.Open = "00:00"
+				hour.Close = "00:00"
+			} else if hour.Is24Hr {
+				hour.Open = "00:00"
+				hour.Close = "23:59"
+			}
 		}
 	}
 
-	return s.storeRepository.UpdateStore(ctx, update)
+	var store *entity.Store
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if store, err = s.storeRepository.UpdateStore(ctx, update, paths); err != nil {
+			if errors.Is(err, repository.ErrVersionConflict) {
+				return storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+			}
+			return err
+		}
+		if err := s.recordAudit(ctx, auditEntity.ActionUpdate, auditEntity.EntityStore, storeID, before, storeSnapshot(store)); err != nil {
+			return err
+		}
+		return s.addEvent(ctx, exist.ID, outboxEntity.StoreUpdated, outboxEntity.AggregateStore, storeID, storeSnapshot(store))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
 }
 
 func (s *service) ListStores(ctx context.Context, page int32, limit int32) ([]*entity.Store, error) {
 	return s.storeRepository.ListStores(ctx, int(page), int(limit))
 }
 
+// DeleteStores deletes the stores together with their products and releases the product images.
 func (s *service) DeleteStores(ctx context.Context, storeIDs []string) error {
-	return s.storeRepository.DeleteStores(ctx, storeIDs)
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	var releasedImageIds []uuid.UUID
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		deleted := []*entity.Store{}
+		prodIds := []uuid.UUID{}
+		for _, storeID := range storeIDs {
+			id, err := uuid.Parse(storeID)
+			if err != nil {
+				return storeerror.InvalidID("store_id")
+			}
+			store, err := s.storeRepository.GetStore(ctx, storeID)
+			if err != nil {
+				return err
+			}
+			deleted = append(deleted, store)
+			products, err := s.productRepository.GetProductsByStoreId(ctx, id, nil, nil, nil, true)
+			if err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+			}
+			for _, p := range products {
+				prodIds = append(prodIds, p.ID)
+			}
+		}
+
+		if len(prodIds) > 0 {
+			prodImages, _, err := s.productRepository.GetProductImagesByProductIds(ctx, prodIds)
+			if err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE, err)
+			}
+			if len(prodImages) > 0 {
+				if releasedImageIds, err = s.releaseImageReferences(ctx, prodImages); err != nil {
+					return err
+				}
+				if err := s.productRepository.DeleteProductImages(ctx, prodImages); err != nil {
+					return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE, err)
+				}
+			}
+		}
+
+		if err := s.storeRepository.DeleteStores(ctx, storeIDs); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_STORE, err)
+		}
+		for _, store := range deleted {
+			if err := s.recordAudit(ctx, auditEntity.ActionDelete, auditEntity.EntityStore, store.ID.String(), storeSnapshot(store), nil); err != nil {
+				return err
+			}
+			if err := s.addEvent(ctx, store.ID, outboxEntity.StoreDeleted, outboxEntity.AggregateStore, store.ID.String(), storeSnapshot(store)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.removeImagesFromStorage(ctx, releasedImageIds, claims.UserID)
+	return nil
 }
 
 func (s *service) OpenCloseStore(ctx context.Context, userID uuid.UUID, roleNames []string, storeID string, isActive bool) error {
 	if strings.Trim(storeID, " ") == "" {
-		return status.Errorf(codes.InvalidArgument, "store id is required")
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED)
 	}
 	storeIDUuid, err := uuid.Parse(storeID)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "store id should be uuid")
+		return storeerror.InvalidID("store_id")
 	}
 
 	store, err := s.storeRepository.GetStore(ctx, storeID)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, err.Error())
+		return err
 	}
 	if store == nil {
-		return status.Errorf(codes.InvalidArgument, "Invalid store id")
+		return storeerror.InvalidID("store_id")
 	}
 
 	var isAdmin bool
@@ -196,23 +384,48 @@ func (s *service) OpenCloseStore(ctx context.Context, userID uuid.UUID, roleName
 	}
 
 	if userID != store.UserID && !isAdmin {
-		return status.Errorf(codes.PermissionDenied, "You do not have permission to open / close this store")
+		return storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE)
 	}
 
-	err = s.storeRepository.OpenCloseStore(ctx, storeIDUuid, isActive)
-	if err != nil {
-		return status.Errorf(codes.Internal, "Error when opening / closing store")
-	}
-	return nil
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.storeRepository.OpenCloseStore(ctx, storeIDUuid, isActive); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_STORE, err)
+		}
+		before := storeSnapshot(store)
+		after := storeSnapshot(store)
+		after.IsActive = isActive
+		if err := s.recordAudit(ctx, auditEntity.ActionUpdate, auditEntity.EntityStore, storeID, before, after); err != nil {
+			return err
+		}
+		eventType := outboxEntity.StoreClosed
+		if isActive {
+			eventType = outboxEntity.StoreOpened
+		}
+		return s.addEvent(ctx, storeIDUuid, eventType, outboxEntity.AggregateStore, storeID, after)
+	})
 }
 
-func (s *service) UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, products ...*prodEntity.Product) error {
+func (s *service) UpsertProducts(ctx context.Context, userID uuid.UUID, roleNames []string, storeID uuid.UUID, isUpdate bool, paths []string, products ...*prodEntity.Product) error {
 	if len(products) == 0 {
-		return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED.String(), "tidak ada produk yang disimpan")
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED)
+	}
+
+	// a partial update keeps the stored value of the fields which are not listed in the mask
+	if isUpdate && len(paths) > 0 {
+		for _, p := range products {
+			if err := s.mergeStoredProduct(ctx, p, paths); err != nil {
+				return err
+			}
+			if storeID == uuid.Nil {
+				storeID = p.StoreID
+			} else if storeID != p.StoreID {
+				return storeerror.Invalid("store_id", errPb.StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED, "store_id")
+			}
+		}
 	}
 
 	if storeID == uuid.Nil {
-		return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED.String(), "Store id diperlukan")
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED)
 	}
 
 	existingStoreByStoreId, err := s.storeRepository.GetStore(ctx, storeID.String())
@@ -229,7 +442,7 @@ func (s *service) UpsertProducts(ctx context.Context, userID uuid.UUID, roleName
 		}
 	}
 	if existingStoreByStoreId.UserID != userID && !isAdmin {
-		return util.NewError(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE.String(), "Anda tidak memiliki izin untuk membuat/memperbarui produk untuk toko ini")
+		return storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE)
 	}
 
 	names := []string{}
@@ -237,21 +450,24 @@ func (s *service) UpsertProducts(ctx context.Context, userID uuid.UUID, roleName
 	prodTypeIdsMap := make(map[int64]bool)
 	for _, p := range products {
 		if isUpdate && p.ID == uuid.Nil {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED.String(), "Product id diperlukan")
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED)
 		} else if !isUpdate && p.ID != uuid.Nil {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_ID_SHOULD_BE_EMPTY.String(), "Product id harus kosong")
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_ID_SHOULD_BE_EMPTY)
+		} else if isUpdate && p.Version == 0 {
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_VERSION_IS_REQUIRED)
 		}
 
 		p.StoreID = storeID
+		p.NormalizeImages()
 		names = append(names, p.Name)
-		if p.Uom == "" {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan")
+		if p.UomID == 0 && p.Uom == "" {
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED)
 		}
 		if p.ProductTypeID == 0 {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED.String(), "Product type id diperlukan")
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED)
 		}
 		if p.Stock < 0 {
-			return util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE.String(), "Stock harus positif")
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE)
 		}
 		if !prodTypeIdsMap[p.ProductTypeID] {
 			productTypeIds = append(productTypeIds, p.ProductTypeID)
@@ -261,332 +477,630 @@ func (s *service) UpsertProducts(ctx context.Context, userID uuid.UUID, roleName
 	if !isUpdate {
 		existingProds, err := s.productRepository.GetProductsByStoreIdAndNames(ctx, storeID, names)
 		if err != nil {
-			return err
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
 		}
 		if len(existingProds) > 0 {
 			existingProdNames := []string{}
 			for _, p := range existingProds {
 				existingProdNames = append(existingProdNames, p.Name)
 			}
-			return util.NewError(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED.String(),
-				fmt.Sprintf("Produk sudah terdaftar : %s", strings.Join(existingProdNames, ",")))
+			return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED, strings.Join(existingProdNames, ","))
 		}
 	}
+	if err := s.checkProductCodes(ctx, storeID, products); err != nil {
+		return err
+	}
 
 	existingProdTypes, err := s.productRepository.GetProductTypesByIds(ctx, productTypeIds)
 	if err != nil {
-		return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE.String(), "Error saat mencari data product type")
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err)
 	}
 	if len(productTypeIds) > len(existingProdTypes) {
-		return util.NewError(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND.String(), "Product type id tidak ditemukan")
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND)
 	}
-
-	s.productRepository.InitiateTransaction(ctx)
-	err = s.productRepository.UpsertProducts(ctx, products)
-	if err != nil {
-		return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT.String(), "Error saat membuat / memperbarui data produk : "+err.Error())
+	if err := s.validateProductAttributes(ctx, isUpdate, productTypeIds, products); err != nil {
+		return err
 	}
-
-	var prodIds []uuid.UUID
-	for _, p := range products {
-		prodIds = append(prodIds, p.ID)
+	if err := s.resolveProductUoms(ctx, products); err != nil {
+		return err
+	}
+	uploadedImageIds, err := s.uploadProductImages(ctx, userID, isUpdate, products)
+	if err != nil {
+		return err
 	}
 
-	var addProductImages []*prodEntity.ProductImage
-	if isUpdate {
-		// add product images if the product image id is nil
-		// remove product images if if the product image id exist in db but not exist in the request.
-		// if the product images id exist in the db and in the request, do nothing.
-		var removeProductImages []*prodEntity.ProductImage
-
-		prodImages, existingProdImagesByProdIdMap, err := s.productRepository.GetProductImagesByProductIds(ctx, prodIds)
-		if err != nil {
-			return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE.String(), "Error saat melakukan pencarian gambar produk : "+err.Error())
+	// products, their images and the image references are saved atomically
+	var releasedImageIds []uuid.UUID
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		// the stored products are read in the transaction so the audited changes match the update
+		before := make(map[uuid.UUID]*prodEntity.Product)
+		if isUpdate {
+			for _, p := range products {
+				stored, err := s.productRepository.GetProductById(ctx, p.ID)
+				if err != nil {
+					return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+				}
+				before[p.ID] = stored
+			}
 		}
 
-		existingProdImagesMap := make(map[uuid.UUID]*prodEntity.ProductImage)
-		for _, i := range prodImages {
-			existingProdImagesMap[i.ID] = i
+		if err := s.productRepository.UpsertProducts(ctx, products); err != nil {
+			if errors.Is(err, prodRepository.ErrVersionConflict) {
+				return storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+			}
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT, err)
+		}
+		if err := s.recordPriceChanges(ctx, userID, before, products); err != nil {
+			return err
+		}
+		if err := s.productRepository.ReplaceProductAttributes(ctx, products); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_ATTRIBUTE, err)
+		}
+		if err := s.consumeProductLots(ctx, before, products); err != nil {
+			return err
 		}
 
+		var prodIds []uuid.UUID
 		for _, p := range products {
-			fromReqProdImagesMap := make(map[uuid.UUID]*prodEntity.ProductImage)
-			for _, i := range p.Images {
-				if i.BaseModel.ID == uuid.Nil {
-					i.ProductId = p.ID
-					addProductImages = append(addProductImages, i)
-				} else {
-					fromReqProdImagesMap[i.ID] = i
-				}
-			}
+			prodIds = append(prodIds, p.ID)
+		}
 
+		var addProductImages []*prodEntity.ProductImage
+		if isUpdate {
+			// add product images if the product image id is nil
 			// remove product images if if the product image id exist in db but not exist in the request.
-			for _, i := range existingProdImagesByProdIdMap[p.ID] {
-				if fromReqProdImagesMap[i.ID] == nil {
-					removeProductImages = append(removeProductImages, i)
-				}
+			// if the product images id exist in the db and in the request, only update its sort order and primary flag.
+			prodImages, existingProdImagesByProdIdMap, err := s.productRepository.GetProductImagesByProductIds(ctx, prodIds)
+			if err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE, err)
 			}
 
-			// upload new images
-			for _, i := range addProductImages {
-				imageId, err := s.UploadImageToStorage(ctx, i.ImageBase64Str, userID)
-				if err != nil {
-					s.productRepository.TransactionRollback()
-					return err
-				}
-				i.ImageId = *imageId
+			existingProdImagesMap := make(map[uuid.UUID]*prodEntity.ProductImage)
+			for _, i := range prodImages {
+				existingProdImagesMap[i.ID] = i
 			}
 
-			// remove images from storage and remove product_image data from database
-			if len(removeProductImages) > 0 {
-				removeProdImageIds := []string{}
-				for _, i := range removeProductImages {
-					log.Println("Remove : ", i.ID.String())
-					removeProdImageIds = append(removeProdImageIds, i.ID.String())
-				}
-				log.Printf("Remove product images : %v \n", removeProdImageIds)
-				if err := s.imageRepository.RemoveImage(ctx, removeProdImageIds, "product", userID.String()); err != nil {
-					s.productRepository.TransactionRollback()
-					return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE.String(), "Error saat menghapus gambar dari penyimpanan : "+err.Error())
+			for _, p := range products {
+				var newProductImages, removeProductImages []*prodEntity.ProductImage
+				fromReqProdImagesMap := make(map[uuid.UUID]*prodEntity.ProductImage)
+				for _, i := range p.Images {
+					if i.BaseModel.ID == uuid.Nil {
+						i.ProductId = p.ID
+						newProductImages = append(newProductImages, i)
+					} else {
+						fromReqProdImagesMap[i.ID] = i
+					}
 				}
-				if err := s.productRepository.DeleteProductImages(ctx, removeProductImages); err != nil {
-					s.productRepository.TransactionRollback()
-					return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE.String(), "Error saat menghapus gambar produk : "+err.Error())
+
+				// remove product images if if the product image id exist in db but not exist in the request.
+				for _, i := range existingProdImagesByProdIdMap[p.ID] {
+					if reqImage := fromReqProdImagesMap[i.ID]; reqImage == nil {
+						removeProductImages = append(removeProductImages, i)
+					} else if i.SortOrder != reqImage.SortOrder || i.IsPrimary != reqImage.IsPrimary {
+						i.SortOrder = reqImage.SortOrder
+						i.IsPrimary = reqImage.IsPrimary
+						addProductImages = append(addProductImages, i)
+					}
 				}
-			}
-		}
-	} else {
-		for _, p := range products {
-			for _, i := range p.Images {
-				if i != nil {
-					imageId, err := s.UploadImageToStorage(ctx, i.ImageBase64Str, userID)
+
+				addProductImages = append(addProductImages, newProductImages...)
+
+				// release the image references and remove product_image data from database
+				if len(removeProductImages) > 0 {
+					released, err := s.releaseImageReferences(ctx, removeProductImages)
 					if err != nil {
 						return err
 					}
-					i.ProductId = p.ID
-					i.ImageId = *imageId
-					addProductImages = append(addProductImages, i)
+					releasedImageIds = append(releasedImageIds, released...)
+					if err := s.productRepository.DeleteProductImages(ctx, removeProductImages); err != nil {
+						return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE, err)
+					}
+				}
+			}
+		} else {
+			for _, p := range products {
+				for _, i := range p.Images {
+					if i != nil {
+						i.ProductId = p.ID
+						addProductImages = append(addProductImages, i)
+					}
 				}
 			}
 		}
-	}
 
-	if len(addProductImages) > 0 {
-		log.Printf("Add product images count : %d \n", len(addProductImages))
-		if err := s.productRepository.UpsertProductImages(ctx, addProductImages); err != nil {
-			s.productRepository.TransactionRollback()
-			return err
+		if len(addProductImages) > 0 {
+			log.Printf("Add product images count : %d \n", len(addProductImages))
+			if err := s.productRepository.UpsertProductImages(ctx, addProductImages); err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT, err)
+			}
 		}
-	}
 
-	if err := s.productRepository.TransactionCommit(); err != nil {
+		action := auditEntity.ActionCreate
+		if isUpdate {
+			action = auditEntity.ActionUpdate
+		}
+		for _, p := range products {
+			if err := s.recordProductChange(ctx, action, before[p.ID], p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.releaseUploadedImages(ctx, uploadedImageIds, userID)
 		return err
 	}
+	s.removeImagesFromStorage(ctx, releasedImageIds, userID)
 
 	log.Println("Product is successfully inserted")
 
 	return nil
 }
 
+// recordProductChange audits the change of a product from stored, nil for a created product, and adds
+// its ProductUpserted event, with a StockChanged event when its stock changed.
+func (s *service) recordProductChange(ctx context.Context, action string, stored, p *prodEntity.Product) error {
+	if err := s.recordAudit(ctx, action, auditEntity.EntityProduct, p.ID.String(), productSnapshot(stored), productSnapshot(p)); err != nil {
+		return err
+	}
+	if err := s.addEvent(ctx, p.StoreID, outboxEntity.ProductUpserted, outboxEntity.AggregateProduct, p.ID.String(), productSnapshot(p)); err != nil {
+		return err
+	}
+	if stored != nil && (stored.Stock != p.Stock || stored.BaseStock != p.BaseStock) {
+		stockChange := outboxEntity.StockChange{
+			ProductID:         p.ID.String(),
+			StoreID:           p.StoreID.String(),
+			PreviousStock:     stored.Stock,
+			Stock:             p.Stock,
+			PreviousBaseStock: stored.BaseStock,
+			BaseStock:         p.BaseStock,
+		}
+		if err := s.addEvent(ctx, p.StoreID, outboxEntity.StockChanged, outboxEntity.AggregateProduct, p.ID.String(), stockChange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uploadProductImages uploads the new images of the products before their transaction is opened, so
+// no row is locked during the calls to the image service. It returns the ids of the uploaded images,
+// which are released by releaseUploadedImages when the products are not saved.
+func (s *service) uploadProductImages(ctx context.Context, userID uuid.UUID, isUpdate bool, products []*prodEntity.Product) ([]uuid.UUID, error) {
+	var imageIds []uuid.UUID
+	for _, p := range products {
+		for _, i := range p.Images {
+			// an update keeps the stored images, only the images without an id are new
+			if i == nil || (isUpdate && i.ID != uuid.Nil) {
+				continue
+			}
+			imageId, err := s.UploadImageToStorage(ctx, i.ImageBase64Str, userID)
+			if err != nil {
+				s.releaseUploadedImages(ctx, imageIds, userID)
+				return nil, err
+			}
+			i.ImageId = *imageId
+			imageIds = append(imageIds, *imageId)
+		}
+	}
+	return imageIds, nil
+}
+
+// releaseUploadedImages drops the references taken by uploadProductImages when the products are not
+// saved and removes the images which are no longer referenced from the storage. A failure only leaves
+// unused images behind and is logged.
+func (s *service) releaseUploadedImages(ctx context.Context, imageIds []uuid.UUID, userID uuid.UUID) {
+	if len(imageIds) == 0 {
+		return
+	}
+
+	unreferencedIds, err := s.imageObjectRepository.ReleaseImageObjects(ctx, imageIds)
+	if err != nil {
+		log.Printf("Failed to release uploaded images %v : %v \n", imageIds, err)
+		return
+	}
+	s.removeImagesFromStorage(ctx, unreferencedIds, userID)
+}
+
+// UploadImageToStorage uploads a product image and returns its image id. Images are addressed by the
+// SHA-256 hash of their content, so uploading an image which is already stored only adds a reference to it.
 func (s *service) UploadImageToStorage(ctx context.Context, imageBase64Str string, userID uuid.UUID) (*uuid.UUID, error) {
 	if strings.Trim(imageBase64Str, " ") == "" {
-		return nil, util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT.String(), "Gambar produk harus dalam format base 64")
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT)
+	}
+	decodedImage, err := lib.DecodeBase64Image(imageBase64Str)
+	if err != nil {
+		return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT)
+	}
+	contentHash := lib.ContentHash(decodedImage)
+
+	existing, err := s.imageObjectRepository.AcquireImageObject(ctx, contentHash)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IMAGE_REFERENCE, err)
+	}
+	if existing != nil {
+		return &existing.ImageId, nil
 	}
+
 	imageId, err := s.imageRepository.UploadImage(ctx, imageBase64Str, "product", userID.String())
 	if err != nil {
-		s.productRepository.TransactionRollback()
-		return nil, err
+		// the code of the image service is kept, e.g. Unavailable while its circuit breaker is open
+		return nil, storeerror.New(status.Code(err), errPb.StoreErrorCode_ERROR_WHEN_UPLOADING_IMAGE, err)
+	}
+
+	imageObject := &imageEntity.ImageObject{
+		ContentHash: contentHash,
+		ImageId:     *imageId,
+		GroupName:   "product",
+	}
+	imageObject.CreatedBy = userID
+	stored, err := s.imageObjectRepository.CreateImageObject(ctx, imageObject)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IMAGE_REFERENCE, err)
+	}
+
+	// a concurrent upload of the same content registered its object first, drop the duplicate blob
+	if stored.ImageId != *imageId {
+		if err := s.imageRepository.RemoveImage(ctx, []string{imageId.String()}, "product", userID.String()); err != nil {
+			log.Printf("Failed to remove duplicate image %s : %v \n", imageId.String(), err)
+		}
+	}
+	return &stored.ImageId, nil
+}
+
+// releaseImageReferences drops the references of the given product images and returns the ids of
+// the stored images which are no longer referenced by any product. It runs in the transaction which
+// deletes the product images, the images are removed from the storage once it is committed.
+func (s *service) releaseImageReferences(ctx context.Context, prodImages []*prodEntity.ProductImage) ([]uuid.UUID, error) {
+	imageIds := []uuid.UUID{}
+	for _, i := range prodImages {
+		imageIds = append(imageIds, i.ImageId)
+	}
+
+	unreferencedIds, err := s.imageObjectRepository.ReleaseImageObjects(ctx, imageIds)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_IMAGE_REFERENCE, err)
+	}
+	return unreferencedIds, nil
+}
+
+// removeImagesFromStorage removes the images released by a committed transaction. The rows
+// referencing them are already gone, so a failure only leaves unreferenced images in the storage and
+// is logged instead of failing the call.
+func (s *service) removeImagesFromStorage(ctx context.Context, imageIds []uuid.UUID, userID uuid.UUID) {
+	if len(imageIds) == 0 {
+		return
+	}
+
+	removeImageIds := []string{}
+	for _, id := range imageIds {
+		removeImageIds = append(removeImageIds, id.String())
+	}
+	log.Printf("Remove images : %v \n", removeImageIds)
+	if err := s.imageRepository.RemoveImage(ctx, removeImageIds, "product", userID.String()); err != nil {
+		log.Printf("Failed to remove images %v from storage : %v \n", removeImageIds, err)
 	}
-	return imageId, nil
 }
 
 func (s *service) UpsertUnitOfMeasure(ctx context.Context, uom *prodEntity.UnitOfMeasure) error {
 	existingUom, err := s.productRepository.GetUnitOfMeasureByName(ctx, uom.Name)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error when getting uom by name : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
 	}
 	if existingUom != nil {
-		return status.Errorf(codes.AlreadyExists, "Uom name is already exist in database")
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_NAME_IS_ALREADY_USED)
 	}
 	existingUom, err = s.productRepository.GetUnitOfMeasureBySymbol(ctx, uom.Symbol)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error when getting uom by symbol : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
 	}
 	if existingUom != nil {
-		return status.Errorf(codes.AlreadyExists, "Uom symbol is already exist in database")
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED)
 	}
-	if err := s.productRepository.UpsertUnitOfMeasure(ctx, uom); err != nil {
-		return status.Errorf(codes.Internal, "Error when inserting / updating unit of measure :"+err.Error())
+	if err := s.validateUomConversion(ctx, uom); err != nil {
+		return err
 	}
-	return nil
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertUnitOfMeasure(ctx, uom); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_UOM, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionCreate, auditEntity.EntityUnitOfMeasure, strconv.FormatInt(uom.ID, 10), nil, uom.ToProto())
+	})
 }
 
 func (s *service) UpdateUnitOfMeasure(ctx context.Context, uomId int64, uom *prodEntity.UnitOfMeasure) error {
 	currentUom, getUomByIdErr := s.productRepository.GetUnitOfMeasureById(ctx, uomId)
 	if getUomByIdErr != nil {
-		return status.Errorf(codes.Internal, "Error when getting uom: "+getUomByIdErr.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, getUomByIdErr)
 	}
 
 	existingUom, err := s.productRepository.GetUnitOfMeasureByName(ctx, uom.Name)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error when getting uom by name : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
 	}
 	if existingUom != nil && existingUom.ID != currentUom.ID {
-		return status.Errorf(codes.AlreadyExists, "Name is already used by another UoM")
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_NAME_IS_ALREADY_USED)
 	}
 
 	existingUom, err = s.productRepository.GetUnitOfMeasureBySymbol(ctx, uom.Symbol)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error when getting uom by symbol : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
 	}
 	if existingUom != nil && existingUom.ID != currentUom.ID {
-		return status.Errorf(codes.AlreadyExists, "Symbol is already used by another UoM")
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED)
 	}
 
+	before := currentUom.ToProto()
 	currentUom.Name = strings.ToLower(uom.Name)
 	currentUom.Symbol = uom.Symbol
 	currentUom.IsActive = uom.IsActive
-
-	if err := s.productRepository.UpsertUnitOfMeasure(ctx, currentUom); err != nil {
-		return status.Errorf(codes.Internal, "Error when updating unit of measure: "+err.Error())
+	currentUom.BaseUnitID = uom.BaseUnitID
+	currentUom.ConversionFactor = uom.ConversionFactor
+	if err := s.validateUomConversion(ctx, currentUom); err != nil {
+		return err
 	}
+	conversionChanged := before.BaseUnitId != currentUom.ToProto().BaseUnitId || before.ConversionFactor != currentUom.ConversionFactor
 
-	return nil
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertUnitOfMeasure(ctx, currentUom); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_UOM, err)
+		}
+		if conversionChanged {
+			// the stock of the products is kept in the base unit, so it is converted with the new factor
+			if err := s.productRepository.UpdateProductsBaseStock(ctx, currentUom.ID, currentUom.ConversionFactor); err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_INSERTING_OR_UPDATING_PRODUCT, err)
+			}
+		}
+		return s.recordAudit(ctx, auditEntity.ActionUpdate, auditEntity.EntityUnitOfMeasure, strconv.FormatInt(currentUom.ID, 10), before, currentUom.ToProto())
+	})
 }
 
+// UpsertProductCategory creates a category below its parent or updates a category, a category keeps
+// its place in the tree when it is updated.
 func (s *service) UpsertProductCategory(ctx context.Context, prodCategory *prodEntity.ProductCategory) error {
-	existingProdCategory, err := s.productRepository.GetProductCategoryByName(ctx, strings.ToLower(prodCategory.Name))
-	if err != nil {
-		return err
+	var before *prodEntity.ProductCategory
+	var err error
+	if prodCategory.ID != 0 {
+		if before, err = s.productRepository.GetProductCategoryById(ctx, prodCategory.ID); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+		}
+	}
+	if before != nil {
+		prodCategory.ParentID = before.ParentID
+		prodCategory.Path = before.Path
+	} else {
+		parent, err := s.getParentCategory(ctx, prodCategory.ParentID)
+		if err != nil {
+			return err
+		}
+		prodCategory.SetParent(parent)
 	}
 
-	if existingProdCategory != nil && existingProdCategory.ID != prodCategory.ID {
-		return status.Errorf(codes.AlreadyExists, "Name is already used by another product category")
+	if err := s.checkCategoryName(ctx, prodCategory.ParentID, prodCategory.ID, prodCategory.Name); err != nil {
+		return err
 	}
 
-	return s.productRepository.UpsertProductCategory(ctx, prodCategory)
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertProductCategory(ctx, prodCategory); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, err)
+		}
+		action := auditEntity.ActionCreate
+		if before != nil {
+			action = auditEntity.ActionUpdate
+		}
+		return s.recordAudit(ctx, action, auditEntity.EntityProductCategory, strconv.FormatInt(prodCategory.ID, 10), before.ToProto(), prodCategory.ToProto())
+	})
 }
 
 func (s *service) UpsertProductType(ctx context.Context, prodType *prodEntity.ProductType) error {
 	if prodCat, err := s.productRepository.GetProductCategoryById(ctx, prodType.ProductCategoryID); err != nil {
-		return status.Errorf(codes.AlreadyExists, "Error getting product category by id data")
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
 	} else if prodCat == nil {
-		return status.Errorf(codes.NotFound, "Related product category data is not found")
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND)
 	}
 	existingProdType, err := s.productRepository.GetProductTypeByName(ctx, prodType.ProductCategoryID, prodType.Name)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error when getting product type by name : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err)
 	}
 	if existingProdType != nil {
-		return status.Errorf(codes.AlreadyExists, "Product type is already exist for this product category")
+		return storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_TYPE_NAME_IS_ALREADY_USED)
 	}
-	if err := s.productRepository.UpsertProductType(ctx, prodType); err != nil {
-		return status.Errorf(codes.Internal, "Error when inserting / updating product type :"+err.Error())
-	}
-	return nil
+	return s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertProductType(ctx, prodType); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_TYPE, err)
+		}
+		return s.recordAudit(ctx, auditEntity.ActionCreate, auditEntity.EntityProductType, strconv.FormatInt(prodType.ID, 10), nil, prodType.ToProto())
+	})
 }
 
 func (s *service) DeleteProductById(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	product, err := s.productRepository.GetProductById(ctx, id)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	} else if product == nil {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND)
+	}
+
 	prodIds := []uuid.UUID{id}
 	prodImages, _, err := s.productRepository.GetProductImagesByProductIds(ctx, prodIds)
 	if err != nil {
-		return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE.String(), "Error saat melakukan pencarian gambar produk : "+err.Error())
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_IMAGE, err)
 	}
 
-	s.productRepository.InitiateTransaction(ctx)
-	removeProdImageIds := []string{}
-	for _, i := range prodImages {
-		log.Println("Remove : ", i.ID.String())
-		removeProdImageIds = append(removeProdImageIds, i.ID.String())
-	}
+	var releasedImageIds []uuid.UUID
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if len(prodImages) > 0 {
+			released, err := s.releaseImageReferences(ctx, prodImages)
+			if err != nil {
+				return err
+			}
+			releasedImageIds = released
 
-	log.Printf("Remove product images : %v \n", removeProdImageIds)
-	if len(removeProdImageIds) > 0 {
-		if err := s.imageRepository.RemoveImage(ctx, removeProdImageIds, "product", userId.String()); err != nil {
-			s.productRepository.TransactionRollback()
-			return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_REMOVING_IMAGE_FROM_STORAGE.String(), "Error saat menghapus gambar dari penyimpanan : "+err.Error())
+			if err := s.productRepository.DeleteProductImages(ctx, prodImages); err != nil {
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE, err)
+			}
 		}
 
-		if err := s.productRepository.DeleteProductImages(ctx, prodImages); err != nil {
-			s.productRepository.TransactionRollback()
-			return util.NewError(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT_IMAGE.String(), "Error saat menghapus gambar produk : "+err.Error())
+		if err := s.productRepository.DeleteProductById(ctx, id); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_DELETING_PRODUCT, err)
 		}
-	}
 
-	err = s.productRepository.DeleteProductById(ctx, id)
+		if err := s.recordAudit(ctx, auditEntity.ActionDelete, auditEntity.EntityProduct, id.String(), productSnapshot(product), nil); err != nil {
+			return err
+		}
+		return s.addEvent(ctx, product.StoreID, outboxEntity.ProductDeleted, outboxEntity.AggregateProduct, id.String(), productSnapshot(product))
+	})
 	if err != nil {
-		s.productRepository.TransactionRollback()
-		return util.NewError(codes.Internal, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED.String(), "Error saat menghapus produk : "+err.Error())
+		return err
 	}
-
-	s.productRepository.TransactionCommit()
-
-	return err
+	s.removeImagesFromStorage(ctx, releasedImageIds, userId)
+	return nil
 }
 
 func (s *service) GetUnitOfMeasures(ctx context.Context, isIncludeDeactivated bool) (uom []*prodEntity.UnitOfMeasure, err error) {
 	if uom, err = s.productRepository.GetUnitOfMeasures(ctx, isIncludeDeactivated); err != nil {
-		return nil, status.Errorf(codes.Internal, "Error when getting unit of measures :"+err.Error())
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	if err := s.localizeUnitOfMeasures(ctx, uom); err != nil {
+		return nil, err
 	}
 	return uom, nil
 }
 
-func (s *service) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, isIncludeDeactivated bool) (products []*prodEntity.Product, err error) {
+func (s *service) GetProductsByStoreId(ctx context.Context, storeID uuid.UUID, productTypeId *int64, productCategoryId *int64, attributes map[int64]string, isIncludeDeactivated bool) (products []*prodEntity.Product, err error) {
 	if _, err := s.storeRepository.GetStore(ctx, storeID.String()); err != nil {
 		return nil, err
 	}
 
-	if products, err = s.productRepository.GetProductsByStoreId(ctx, storeID, productTypeId, isIncludeDeactivated); err != nil {
-		return nil, status.Errorf(codes.Internal, "Error when getting product list :"+err.Error())
+	var category *prodEntity.ProductCategory
+	if productCategoryId != nil {
+		if category, err = s.getProductCategory(ctx, *productCategoryId); err != nil {
+			return nil, err
+		}
+	}
+
+	if attributes, err = s.normalizeAttributeFilters(ctx, attributes); err != nil {
+		return nil, err
+	}
+
+	if products, err = s.productRepository.GetProductsByStoreId(ctx, storeID, productTypeId, category, attributes, isIncludeDeactivated); err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	}
+	// the products are listed as they are once the scheduler has applied the schedules due now
+	now := time.Now()
+	for _, p := range products {
+		p.ApplySchedule(now)
 	}
 	if err := s.GetProductImagesInformation(ctx, nil, products); err != nil {
 		return nil, err
 	}
+	if err := s.applyPricing(ctx, products...); err != nil {
+		return nil, err
+	}
+	if err := s.localizeProducts(ctx, products...); err != nil {
+		return nil, err
+	}
 	return products, nil
 }
 
-func (s *service) GetProductCategories(ctx context.Context, isIncludeDeactivated bool) (cat []*prodEntity.ProductCategory, uom []string, err error) {
+// GetProductCategories returns the product categories with the active units of measure products can be sold in.
+func (s *service) GetProductCategories(ctx context.Context, isIncludeDeactivated bool) (cat []*prodEntity.ProductCategory, uom []*prodEntity.UnitOfMeasure, err error) {
 	if cat, err = s.productRepository.GetProductCategories(ctx, isIncludeDeactivated); err != nil {
-		return nil, nil, status.Errorf(codes.Internal, "Error when getting product categories :"+err.Error())
+		return nil, nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
 	}
-
-	// read file uom
-	fileName := "uom.json"
-	var uoms []string
-	err = lib.ReadToFile(fileName, lib.JsonFormat, &uoms)
-	if err != nil {
-		err = nil
-		// return nil, nil, status.Errorf(codes.Internal, err.Error())
+	if uom, err = s.productRepository.GetUnitOfMeasures(ctx, false); err != nil {
+		return nil, nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
 	}
-
-	return cat, uoms, nil
+	if err := s.localizeCategories(ctx, cat); err != nil {
+		return nil, nil, err
+	}
+	if err := s.localizeUnitOfMeasures(ctx, uom); err != nil {
+		return nil, nil, err
+	}
+	return cat, uom, nil
 }
 
 func (s *service) GetProductTypes(ctx context.Context, productCategoryID int64, isIncludeDeactivated bool) (types []*prodEntity.ProductType, err error) {
 	if prodCat, err := s.productRepository.GetProductCategoryById(ctx, productCategoryID); err != nil {
-		return nil, status.Errorf(codes.AlreadyExists, "Error getting product category by id data")
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
 	} else if prodCat == nil {
-		return nil, status.Errorf(codes.NotFound, "Product category id is not found")
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND)
 	}
 
 	if types, err = s.productRepository.GetProductTypes(ctx, productCategoryID, isIncludeDeactivated); err != nil {
-		return nil, status.Errorf(codes.Internal, "Error when getting product types :"+err.Error())
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err)
+	}
+	if err := s.localizeProductTypes(ctx, types); err != nil {
+		return nil, err
 	}
 	return types, nil
 }
 
+// mergeStoredProduct keeps the stored value of the fields of p which are not listed in paths. The stored
+// product is read from the repository, not through GetProductById, so neither the localized names nor
+// the discounted price are written back.
+func (s *service) mergeStoredProduct(ctx context.Context, p *prodEntity.Product, paths []string) error {
+	if p.ID == uuid.Nil {
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED)
+	}
+	stored, err := s.productRepository.GetProductById(ctx, p.ID)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	} else if stored == nil {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND)
+	}
+	if p.Version != 0 && p.Version != stored.Version {
+		// fail before merging, the repository checks the version again when saving
+		return storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT)
+	}
+	return p.ApplyFieldMask(stored, paths)
+}
+
 func (s *service) GetProductById(ctx context.Context, id uuid.UUID) (p *prodEntity.Product, err error) {
 	if p, err = s.productRepository.GetProductById(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "Error when getting product by id :"+err.Error())
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
 	} else if p == nil && err == nil {
-		return nil, status.Errorf(codes.NotFound, "Product id not found")
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND)
 	}
 	if err := s.GetProductImagesInformation(ctx, p, nil); err != nil {
 		return nil, err
 	}
+	if err := s.applyPricing(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := s.localizeProducts(ctx, p); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
 
+// BatchGetProducts returns the products in the order of ids, a missing product is nil. The image urls
+// of every product are resolved with a single call to the image service.
+func (s *service) BatchGetProducts(ctx context.Context, ids []uuid.UUID) ([]*prodEntity.Product, error) {
+	products, err := s.productRepository.GetProductsByIds(ctx, ids)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err)
+	}
+	if err := s.GetProductImagesInformation(ctx, nil, products); err != nil {
+		return nil, err
+	}
+	if err := s.applyPricing(ctx, products...); err != nil {
+		return nil, err
+	}
+	if err := s.localizeProducts(ctx, products...); err != nil {
+		return nil, err
+	}
+	prodMap := make(map[uuid.UUID]*prodEntity.Product, len(products))
+	for _, p := range products {
+		prodMap[p.ID] = p
+	}
+
+	result := make([]*prodEntity.Product, len(ids))
+	for i, id := range ids {
+		result[i] = prodMap[id]
+	}
+	return result, nil
+}
+
 func (s *service) GetProductImagesInformation(ctx context.Context, product *prodEntity.Product, products []*prodEntity.Product) error {
 	prodImages := []*prodEntity.ProductImage{}
 	if product == nil {
@@ -601,23 +1115,21 @@ func (s *service) GetProductImagesInformation(ctx context.Context, product *prod
 		for _, img := range prodImages {
 			ids = append(ids, img.ImageId.String())
 		}
+		// product reads degrade to images without url when the image service can not be reached
 		images, err := s.imageRepository.GetImagesByIds(ctx, ids)
 		if err != nil {
-			return err
+			log.Printf("Error when getting product images, image urls are left empty : %v \n", err)
+			return nil
 		}
 		imgMap := make(map[string]*utilityPb.Image)
 		for _, i := range images {
-			imgMap[i.Id] = i
-		}
-		if product == nil {
-			for _, p := range products {
-				for _, img := range p.Images {
-					img.ImageURL = imgMap[img.ImageId.String()].Path
-				}
+			if i != nil {
+				imgMap[i.Id] = i
 			}
-		} else {
-			for _, img := range product.Images {
-				img.ImageURL = imgMap[img.ImageId.String()].Path
+		}
+		for _, img := range prodImages {
+			if i, ok := imgMap[img.ImageId.String()]; ok {
+				img.ImageURL = i.Path
 			}
 		}
 	}
@@ -627,7 +1139,7 @@ func (s *service) GetProductImagesInformation(ctx context.Context, product *prod
 func (s *service) GetStoreByUserID(ctx context.Context, userID uuid.UUID) (store *entity.Store, err error) {
 	store, err = s.storeRepository.GetStoreByUserID(ctx, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Error when getting store by user id :"+err.Error())
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_STORE, err)
 	}
 	return store, nil
 }
@@ -2,23 +2,36 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"os"
 	"strings"
 	"testing"
 	"time"
 
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	auditRepoMock "github.com/Mitra-Apps/be-store-service/domain/audit/repository/mock"
 	"github.com/Mitra-Apps/be-store-service/domain/base_model"
+	imageEntity "github.com/Mitra-Apps/be-store-service/domain/image/entity"
 	imageRepoMock "github.com/Mitra-Apps/be-store-service/domain/image/repository/mock"
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	outboxRepoMock "github.com/Mitra-Apps/be-store-service/domain/outbox/repository/mock"
+	"github.com/Mitra-Apps/be-store-service/domain/outbox/watch"
 	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	prodRepository "github.com/Mitra-Apps/be-store-service/domain/product/repository"
 	prodRepoMock "github.com/Mitra-Apps/be-store-service/domain/product/repository/mock"
 	prodRepo "github.com/Mitra-Apps/be-store-service/domain/product/repository/postgres"
 	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	pb "github.com/Mitra-Apps/be-store-service/domain/proto/store"
 	"github.com/Mitra-Apps/be-store-service/domain/store/entity"
+	"github.com/Mitra-Apps/be-store-service/domain/store/repository"
 	storeRepoMock "github.com/Mitra-Apps/be-store-service/domain/store/repository/mock"
-	util "github.com/Mitra-Apps/be-utility-service/service"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/domain/transaction"
+	trxMock "github.com/Mitra-Apps/be-store-service/domain/transaction/mock"
+	webhookEntity "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	webhookRepoMock "github.com/Mitra-Apps/be-store-service/domain/webhook/repository/mock"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"github.com/Mitra-Apps/be-store-service/lib"
+	utilityPb "github.com/Mitra-Apps/be-utility-service/domain/proto/utility"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -42,6 +55,79 @@ const (
 	otherproductImageID2 = "7d56be32-70a2-4f49-b66b-63e6f8e719e9"
 )
 
+// runInTransaction stands in for transaction.Manager.Do by running the unit of work directly.
+func runInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// idr returns an amount of rupiah given in whole units.
+func idr(units float64) prodEntity.Money {
+	return prodEntity.MoneyFromFloat(units, "IDR")
+}
+
+// newTransactionMock returns a transaction manager which runs every unit of work directly.
+func newTransactionMock(ctrl *gomock.Controller) *trxMock.MockManager {
+	mockTrx := trxMock.NewMockManager(ctrl)
+	mockTrx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(runInTransaction).AnyTimes()
+	return mockTrx
+}
+
+// unitOfMeasures are the units known to expectUnitOfMeasures, kg is kept in gram.
+var unitOfMeasures = []*prodEntity.UnitOfMeasure{
+	{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 1}, Name: "pieces", Symbol: "pcs", IsActive: true, ConversionFactor: 1},
+	{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 2}, Name: "gram", Symbol: "g", IsActive: true, ConversionFactor: 1},
+	{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 3}, Name: "kilogram", Symbol: "kg", IsActive: true, BaseUnitID: &[]int64{2}[0], ConversionFactor: 1000},
+	{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 4}, Name: "bungkus", Symbol: "bks", IsActive: true, ConversionFactor: 1},
+	{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 5}, Name: "pasang", Symbol: "psg", IsActive: true, ConversionFactor: 1},
+}
+
+// expectUnitOfMeasures makes the product repository find the unitOfMeasures by name, symbol and id.
+func expectUnitOfMeasures(mockProdRepo *prodRepoMock.MockProductRepository) {
+	find := func(match func(u *prodEntity.UnitOfMeasure) bool) *prodEntity.UnitOfMeasure {
+		for _, u := range unitOfMeasures {
+			if match(u) {
+				return u
+			}
+		}
+		return nil
+	}
+	mockProdRepo.EXPECT().GetUnitOfMeasureByName(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, name string) (*prodEntity.UnitOfMeasure, error) {
+		return find(func(u *prodEntity.UnitOfMeasure) bool { return u.Name == name }), nil
+	}).AnyTimes()
+	mockProdRepo.EXPECT().GetUnitOfMeasureBySymbol(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, symbol string) (*prodEntity.UnitOfMeasure, error) {
+		return find(func(u *prodEntity.UnitOfMeasure) bool { return u.Symbol == symbol }), nil
+	}).AnyTimes()
+	mockProdRepo.EXPECT().GetUnitOfMeasuresByIds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids []int64) ([]*prodEntity.UnitOfMeasure, error) {
+		uoms := []*prodEntity.UnitOfMeasure{}
+		for _, id := range ids {
+			if u := find(func(u *prodEntity.UnitOfMeasure) bool { return u.ID == id }); u != nil {
+				uoms = append(uoms, u)
+			}
+		}
+		return uoms, nil
+	}).AnyTimes()
+}
+
+// expectNoProductAttributes makes the product repository find no attribute defined for any product type.
+func expectNoProductAttributes(mockProdRepo *prodRepoMock.MockProductRepository) {
+	mockProdRepo.EXPECT().GetProductAttributeDefinitions(gomock.Any(), gomock.Any()).Return([]*prodEntity.ProductAttributeDefinition{}, nil).AnyTimes()
+	mockProdRepo.EXPECT().ReplaceProductAttributes(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+// newOutboxRepositoryMock returns an outbox repository which accepts every event.
+func newOutboxRepositoryMock(ctrl *gomock.Controller) *outboxRepoMock.MockOutboxRepository {
+	mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return mockOutboxRepo
+}
+
+// newAuditRepositoryMock returns an audit repository which accepts every event.
+func newAuditRepositoryMock(ctrl *gomock.Controller) *auditRepoMock.MockAuditRepository {
+	mockAuditRepo := auditRepoMock.NewMockAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().CreateAuditEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return mockAuditRepo
+}
+
 func Test_service_OpenCloseStore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
@@ -87,7 +173,7 @@ func Test_service_OpenCloseStore(t *testing.T) {
 				roleNames: roleNames,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.InvalidArgument, "store id is required"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STORE_ID_IS_REQUIRED),
 		},
 		{
 			name: "OpenCloseStore_StoreIdIsNotUUID_ReturnValidationError",
@@ -102,7 +188,7 @@ func Test_service_OpenCloseStore(t *testing.T) {
 				roleNames: roleNames,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.InvalidArgument, "store id should be uuid"),
+			expectedError: storeerror.InvalidID("store_id"),
 		},
 		{
 			name: "OpenCloseStore_DifferentUserIDNotAdmin_DontHavePermission",
@@ -117,7 +203,7 @@ func Test_service_OpenCloseStore(t *testing.T) {
 				roleNames: roleNames,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.PermissionDenied, "You do not have permission to open / close this store"),
+			expectedError: storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE),
 		},
 		{
 			name: "OpenCloseStore_DifferentUserIDAdmin_DontHavePermission",
@@ -150,7 +236,7 @@ func Test_service_OpenCloseStore(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(tt.fields.storeRepository, nil, nil, nil)
+			s := New(tt.fields.storeRepository, nil, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 			if err := s.OpenCloseStore(tt.args.ctx, tt.args.userID, tt.args.roleNames, tt.args.storeID, tt.args.isActive); tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Equal(t, tt.expectedError, err)
@@ -249,7 +335,7 @@ func TestCreateStore(t *testing.T) {
 				StoreName: "TestStore",
 			},
 			expectedStore: nil,
-			expectedError: status.Errorf(codes.AlreadyExists, "User already has a store"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_USER_ALREADY_HAS_STORE),
 		},
 	}
 
@@ -260,7 +346,7 @@ func TestCreateStore(t *testing.T) {
 
 			storeRepository := storeRepoMock.NewMockStoreServiceRepository(ctrl)
 			storage := storeRepoMock.NewMockStorage(ctrl)
-			service := New(storeRepository, nil, storage, nil)
+			service := New(storeRepository, nil, storage, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 
 			tc.setupMocks(storeRepository, storage)
 			resultStore, err := service.CreateStore(ctx, tc.inputStore)
@@ -273,8 +359,12 @@ func TestCreateStore(t *testing.T) {
 func Test_service_UpsertProducts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
 	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
 	ctx := context.Background()
 	userIdUuid, _ := uuid.Parse(userID)
 	otherUserIdUuid, _ := uuid.Parse(otherUserID)
@@ -360,6 +450,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 			BaseModel: base_model.BaseModel{
 				ID: productIdUuid,
 			},
+			Version: 1,
 			StoreID: storeIdUuid,
 			Name:    "keju",
 			Stock:   1,
@@ -377,6 +468,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 			BaseModel: base_model.BaseModel{
 				ID: productIdUuid,
 			},
+			Version: 1,
 			StoreID: storeIdUuid,
 			Name:    "keju",
 			Uom:     "kg",
@@ -396,19 +488,19 @@ func Test_service_UpsertProducts(t *testing.T) {
 	}
 	addProdImage1 := &prodEntity.ProductImage{
 		ProductId:      productIdUuid,
-		ImageBase64Str: "aaa",
+		ImageBase64Str: "YWFh",
 	}
 	addProdImage2 := &prodEntity.ProductImage{
 		ProductId:      productIdUuid,
-		ImageBase64Str: "aaa",
+		ImageBase64Str: "YWFh",
 	}
 	removeProdImage1 := &prodEntity.ProductImage{
 		ProductId:      productIdUuid,
-		ImageBase64Str: "aaa",
+		ImageBase64Str: "YWFh",
 	}
 	removeProdImage2 := &prodEntity.ProductImage{
 		ProductId:      productIdUuid,
-		ImageBase64Str: "aaa",
+		ImageBase64Str: "YWFh",
 	}
 	productImagesToBeAdded := []*prodEntity.ProductImage{addProdImage1, addProdImage2}
 	productImagesToBeRemoved := []*prodEntity.ProductImage{removeProdImage1, removeProdImage2}
@@ -416,9 +508,8 @@ func Test_service_UpsertProducts(t *testing.T) {
 	existingProdImagesMap := make(map[uuid.UUID][]*prodEntity.ProductImage)
 	existingProdImagesMap[productIdUuid] = append(existingProdImagesMap[productIdUuid], productImagesToBeRemoved...)
 
-	mockProdRepo.EXPECT().InitiateTransaction(gomock.Any()).Return(true).AnyTimes()
-	mockProdRepo.EXPECT().TransactionCommit().Return(nil).AnyTimes()
-	mockProdRepo.EXPECT().TransactionRollback().AnyTimes()
+	mockTrx := trxMock.NewMockManager(ctrl)
+	mockTrx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(runInTransaction).AnyTimes()
 	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return(prodTypes, nil).AnyTimes()
 	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1, 2}).Return(prodTypes, nil).AnyTimes()
 	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{2}).Return(prodTypes, nil).AnyTimes()
@@ -429,6 +520,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 	mockProdRepo.EXPECT().GetProductsByStoreIdAndNames(gomock.Any(), storeIdUuid, []string{"bantal"}).Return(nil, nil).AnyTimes()
 	mockProdRepo.EXPECT().GetProductsByStoreIdAndNames(gomock.Any(), otherStoreIdUuid, []string{"indomie", "beras"}).Return(nil, nil).AnyTimes()
 
+	mockProdRepo.EXPECT().GetProductById(ctx, gomock.Any()).Return(&prodEntity.Product{Version: 1}, nil).AnyTimes()
 	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil).AnyTimes()
 	mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIdUuid}).Return(productImagesToBeRemoved, existingProdImagesMap, nil).AnyTimes()
 
@@ -436,6 +528,9 @@ func Test_service_UpsertProducts(t *testing.T) {
 	imageIdres = &productImageIDUuid
 	mockImageRepo.EXPECT().UploadImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(imageIdres, nil).AnyTimes()
 	mockImageRepo.EXPECT().RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockImageObjectRepo.EXPECT().AcquireImageObject(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+	mockImageObjectRepo.EXPECT().CreateImageObject(ctx, gomock.Any()).Return(&imageEntity.ImageObject{ImageId: productImageIDUuid}, nil).AnyTimes()
+	mockImageObjectRepo.EXPECT().ReleaseImageObjects(ctx, gomock.Any()).Return([]uuid.UUID{productImageIDUuid}, nil).AnyTimes()
 
 	mockProdRepo.EXPECT().UpsertProductImages(ctx, gomock.Any()).Return(nil).AnyTimes()
 	mockProdRepo.EXPECT().DeleteProductImages(ctx, productImagesToBeRemoved).Return(nil).AnyTimes()
@@ -454,9 +549,10 @@ func Test_service_UpsertProducts(t *testing.T) {
 	}, nil).AnyTimes()
 
 	type fields struct {
-		productRepository *prodRepoMock.MockProductRepository
-		storeRepository   *storeRepoMock.MockStoreServiceRepository
-		imageRepository   *imageRepoMock.MockImageRepository
+		productRepository     *prodRepoMock.MockProductRepository
+		storeRepository       *storeRepoMock.MockStoreServiceRepository
+		imageRepository       *imageRepoMock.MockImageRepository
+		imageObjectRepository *imageRepoMock.MockImageObjectRepository
 	}
 	type args struct {
 		ctx       context.Context
@@ -487,7 +583,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  false,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED.String(), "tidak ada produk yang disimpan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED),
 		},
 		{
 			name: "UpdateProduct_NoProductProvided_ReturnValidationError",
@@ -503,7 +599,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED.String(), "tidak ada produk yang disimpan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_NO_PRODUCT_INSERTED),
 		},
 		{
 			name: "CreateProduct_DifferenStoreIDNotAdmin_DontHavePermission",
@@ -520,7 +616,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  false,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE.String(), "Anda tidak memiliki izin untuk membuat/memperbarui produk untuk toko ini"),
+			expectedError: storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE),
 		},
 		{
 			name: "UpdateProduct_DifferenStoreIDNotAdmin_DontHavePermission",
@@ -537,7 +633,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE.String(), "Anda tidak memiliki izin untuk membuat/memperbarui produk untuk toko ini"),
+			expectedError: storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE),
 		},
 		{
 			name: "CreateProduct_UomNotProvided_Error",
@@ -554,7 +650,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  false,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED),
 		},
 		{
 			name: "UpdateProduct_ProductIdNotProvided_Error",
@@ -571,7 +667,35 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED.String(), "Product id diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_IS_REQUIRED),
+		},
+		{
+			name: "UpdateProduct_VersionNotProvided_Error",
+			fields: fields{
+				productRepository: mockProdRepo,
+				storeRepository:   mockStoreRepo,
+			},
+			args: args{
+				ctx:       ctx,
+				userID:    userIdUuid,
+				storeID:   storeIdUuid,
+				roleNames: roleNames,
+				products: []*prodEntity.Product{
+					&prodEntity.Product{
+						BaseModel: base_model.BaseModel{
+							ID: productIdUuid,
+						},
+						StoreID:       storeIdUuid,
+						Name:          "keju",
+						Uom:           "kg",
+						ProductTypeID: 1,
+						Stock:         1,
+					},
+				},
+				isUpdate: true,
+			},
+			wantErr:       true,
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_VERSION_IS_REQUIRED),
 		},
 		{
 			name: "UpdateProduct_UomNotProvided_Error",
@@ -588,7 +712,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED),
 		},
 		{
 			name: "CreateProduct_ProdTypeNotProvided_Error",
@@ -605,7 +729,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  false,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED.String(), "Product type id diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED),
 		},
 		{
 			name: "UpdateProduct_ProdTypeNotProvided_Error",
@@ -622,7 +746,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate:  true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED.String(), "Product type id diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_TYPE_IS_REQUIRED),
 		},
 		{
 			name: "CreateProduct_StockIsNegative_Error",
@@ -646,7 +770,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				},
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE.String(), "Stock harus positif"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE),
 		},
 		{
 			name: "UpdateProduct_StockIsNegative_Error",
@@ -664,6 +788,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: productIdUuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "bantal",
 						Uom:           "kg",
@@ -674,7 +799,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate: true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE.String(), "Stock harus positif"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_STOCK_SHOULD_BE_POSITIVE),
 		},
 		{
 			name: "CreateProduct_ProductAlreadyExisted_ReturnValidationError",
@@ -689,9 +814,8 @@ func Test_service_UpsertProducts(t *testing.T) {
 				products:  existedProducts,
 				userID:    userIdUuid,
 			},
-			wantErr: true,
-			expectedError: util.NewError(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED.String(),
-				fmt.Sprintf("Produk sudah terdaftar : %s", strings.Join(existedProdNames, ","))),
+			wantErr:       true,
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCTS_ARE_ALREADY_REGISTERED, strings.Join(existedProdNames, ",")),
 		},
 		{
 			name: "CreateProduct_InvalidUOM_Error",
@@ -707,7 +831,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				products:  invalidUomProduct,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED),
 		},
 		{
 			name: "UpdateProduct_InvalidUOM_Error",
@@ -725,6 +849,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: productIdUuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "keju",
 						Uom:           "kg",
@@ -735,6 +860,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: otherProductID2Uuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "tas",
 						Uom:           "",
@@ -745,7 +871,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate: true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED.String(), "Satuan unit diperlukan"),
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_UOM_IS_REQUIRED),
 		},
 		{
 			name: "CreateProduct_InvalidProductType_Error",
@@ -761,7 +887,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				products:  invalidProdTypeProduct,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND.String(), "Product type id tidak ditemukan"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND),
 		},
 		{
 			name: "UpdateProduct_InvalidProductType_Error",
@@ -779,6 +905,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: productIdUuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "keju",
 						Uom:           "kg",
@@ -789,6 +916,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: otherProductID2Uuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "sepatu",
 						Uom:           "kg",
@@ -799,7 +927,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 				isUpdate: true,
 			},
 			wantErr:       true,
-			expectedError: util.NewError(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND.String(), "Product type id tidak ditemukan"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_TYPE_ID_IS_NOT_FOUND),
 		},
 		{
 			name: "CreateProduct_DifferenStoreIDButAdmin_Success",
@@ -819,9 +947,10 @@ func Test_service_UpsertProducts(t *testing.T) {
 		{
 			name: "UpdateProduct_DifferenStoreIDButAdmin_Success",
 			fields: fields{
-				productRepository: mockProdRepo,
-				storeRepository:   mockStoreRepo,
-				imageRepository:   mockImageRepo,
+				productRepository:     mockProdRepo,
+				storeRepository:       mockStoreRepo,
+				imageRepository:       mockImageRepo,
+				imageObjectRepository: mockImageObjectRepo,
 			},
 			args: args{
 				ctx:       ctx,
@@ -833,6 +962,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: productIdUuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "indomie",
 						Uom:           "kg",
@@ -848,9 +978,10 @@ func Test_service_UpsertProducts(t *testing.T) {
 		{
 			name: "CreateProduct_NoError_Success",
 			fields: fields{
-				productRepository: mockProdRepo,
-				storeRepository:   mockStoreRepo,
-				imageRepository:   mockImageRepo,
+				productRepository:     mockProdRepo,
+				storeRepository:       mockStoreRepo,
+				imageRepository:       mockImageRepo,
+				imageObjectRepository: mockImageObjectRepo,
 			},
 			args: args{
 				ctx:       ctx,
@@ -864,9 +995,10 @@ func Test_service_UpsertProducts(t *testing.T) {
 		{
 			name: "UpdateProduct_NoError_Success",
 			fields: fields{
-				productRepository: mockProdRepo,
-				storeRepository:   mockStoreRepo,
-				imageRepository:   mockImageRepo,
+				productRepository:     mockProdRepo,
+				storeRepository:       mockStoreRepo,
+				imageRepository:       mockImageRepo,
+				imageObjectRepository: mockImageObjectRepo,
 			},
 			args: args{
 				ctx:       ctx,
@@ -878,6 +1010,7 @@ func Test_service_UpsertProducts(t *testing.T) {
 						BaseModel: base_model.BaseModel{
 							ID: productIdUuid,
 						},
+						Version:       1,
 						StoreID:       storeIdUuid,
 						Name:          "indomie",
 						Uom:           "kg",
@@ -893,8 +1026,8 @@ func Test_service_UpsertProducts(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(tt.fields.storeRepository, tt.fields.productRepository, nil, tt.fields.imageRepository)
-			if err := s.UpsertProducts(tt.args.ctx, tt.args.userID, tt.args.roleNames, tt.args.storeID, tt.args.isUpdate, tt.args.products...); tt.wantErr {
+			s := New(tt.fields.storeRepository, tt.fields.productRepository, nil, tt.fields.imageRepository, tt.fields.imageObjectRepository, mockTrx, newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+			if err := s.UpsertProducts(tt.args.ctx, tt.args.userID, tt.args.roleNames, tt.args.storeID, tt.args.isUpdate, nil, tt.args.products...); tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Equal(t, tt.expectedError, err)
 			} else {
@@ -904,6 +1037,245 @@ func Test_service_UpsertProducts(t *testing.T) {
 	}
 }
 
+func Test_service_UpsertProducts_ImageOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid, _ := uuid.Parse(userID)
+	storeIdUuid, _ := uuid.Parse(storeID)
+	productIdUuid, _ := uuid.Parse(productID)
+	productImageIDUuid, _ := uuid.Parse(productImageID)
+	otherProductImageIDUuid, _ := uuid.Parse(otherproductImageID2)
+
+	existingImage := &prodEntity.ProductImage{
+		BaseModel: base_model.BaseModel{ID: productImageIDUuid},
+		ProductId: productIdUuid,
+		ImageId:   otherProductImageIDUuid,
+		SortOrder: 0,
+		IsPrimary: true,
+	}
+	existingProdImagesMap := map[uuid.UUID][]*prodEntity.ProductImage{productIdUuid: {existingImage}}
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockTrx := trxMock.NewMockManager(ctrl)
+	mockTrx.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: productIdUuid},
+		Images:    []*prodEntity.ProductImage{existingImage},
+	}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil)
+	mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIdUuid}).Return([]*prodEntity.ProductImage{existingImage}, existingProdImagesMap, nil)
+	mockImageObjectRepo.EXPECT().AcquireImageObject(ctx, gomock.Any()).Return(&imageEntity.ImageObject{ImageId: productImageIDUuid}, nil)
+
+	var savedImages []*prodEntity.ProductImage
+	mockProdRepo.EXPECT().UpsertProductImages(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, images []*prodEntity.ProductImage) error {
+		savedImages = images
+		return nil
+	})
+
+	s := New(mockStoreRepo, mockProdRepo, nil, mockImageRepo, mockImageObjectRepo, mockTrx, newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil, &prodEntity.Product{
+		BaseModel:     base_model.BaseModel{ID: productIdUuid},
+		StoreID:       storeIdUuid,
+		Version:       1,
+		Name:          "indomie",
+		Uom:           "kg",
+		ProductTypeID: 1,
+		Stock:         1,
+		Images: []*prodEntity.ProductImage{
+			{ImageBase64Str: "YWFh", SortOrder: 1, IsPrimary: true},
+			{BaseModel: base_model.BaseModel{ID: productImageIDUuid}, SortOrder: 2, IsPrimary: true},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, savedImages, 2)
+
+	// the kept image is only reordered and loses its primary flag to the first flagged image
+	assert.Equal(t, int32(2), existingImage.SortOrder)
+	assert.False(t, existingImage.IsPrimary)
+	for _, i := range savedImages {
+		if i.ID == uuid.Nil {
+			assert.Equal(t, productImageIDUuid, i.ImageId)
+			assert.Equal(t, int32(1), i.SortOrder)
+			assert.True(t, i.IsPrimary)
+		}
+	}
+}
+
+func Test_service_UpsertProducts_VersionConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid, _ := uuid.Parse(userID)
+	storeIdUuid, _ := uuid.Parse(storeID)
+	productIdUuid, _ := uuid.Parse(productID)
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockTrx := trxMock.NewMockManager(ctrl)
+	mockTrx.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{Version: 2}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(prodRepository.ErrVersionConflict)
+	mockProdRepo.EXPECT().GetProductImagesByProductIds(gomock.Any(), gomock.Any()).Times(0)
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, mockTrx, nil, nil, nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil, &prodEntity.Product{
+		BaseModel:     base_model.BaseModel{ID: productIdUuid},
+		StoreID:       storeIdUuid,
+		Version:       1,
+		Name:          "indomie",
+		Uom:           "kg",
+		ProductTypeID: 1,
+		Stock:         1,
+	})
+	assert.Equal(t, storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT), err)
+}
+
+func Test_service_UpsertProducts_RollbackReleasesUploadedImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid, _ := uuid.Parse(userID)
+	storeIdUuid, _ := uuid.Parse(storeID)
+	productIdUuid, _ := uuid.Parse(productID)
+	productImageIDUuid, _ := uuid.Parse(productImageID)
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockTrx := trxMock.NewMockManager(ctrl)
+	// the image is uploaded before the transaction is opened and released once it is rolled back
+	gomock.InOrder(
+		mockImageObjectRepo.EXPECT().AcquireImageObject(ctx, gomock.Any()).Return(nil, nil),
+		mockImageRepo.EXPECT().UploadImage(ctx, "YWFh", "product", userID).Return(&productImageIDUuid, nil),
+		mockImageObjectRepo.EXPECT().CreateImageObject(ctx, gomock.Any()).Return(&imageEntity.ImageObject{ImageId: productImageIDUuid}, nil),
+		mockTrx.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(runInTransaction),
+		mockImageObjectRepo.EXPECT().ReleaseImageObjects(ctx, []uuid.UUID{productImageIDUuid}).Return([]uuid.UUID{productImageIDUuid}, nil),
+		mockImageRepo.EXPECT().RemoveImage(ctx, []string{productImageID}, "product", userID).Return(nil),
+	)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{Version: 2}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(prodRepository.ErrVersionConflict)
+
+	s := New(mockStoreRepo, mockProdRepo, nil, mockImageRepo, mockImageObjectRepo, mockTrx, nil, nil, nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil, &prodEntity.Product{
+		BaseModel:     base_model.BaseModel{ID: productIdUuid},
+		StoreID:       storeIdUuid,
+		Version:       1,
+		Name:          "indomie",
+		Uom:           "kg",
+		ProductTypeID: 1,
+		Stock:         1,
+		Images:        []*prodEntity.ProductImage{{ImageBase64Str: "YWFh"}},
+	})
+	assert.Equal(t, storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT), err)
+}
+
+func Test_service_UpsertProducts_PartialUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid, _ := uuid.Parse(userID)
+	storeIdUuid, _ := uuid.Parse(storeID)
+	productIdUuid, _ := uuid.Parse(productID)
+
+	stored := func() *prodEntity.Product {
+		return &prodEntity.Product{
+			BaseModel:     base_model.BaseModel{ID: productIdUuid},
+			StoreID:       storeIdUuid,
+			Name:          "indomie",
+			Price:         prodEntity.MoneyFromFloat(3000, prodEntity.DefaultCurrency),
+			Stock:         10,
+			Uom:           "kg",
+			ProductTypeID: 1,
+			Version:       3,
+		}
+	}
+
+	t.Run("Should keep the stored fields which are not listed in the mask", func(t *testing.T) {
+		mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+			BaseModel: base_model.BaseModel{ID: storeIdUuid},
+			UserID:    userIdUuid,
+		}, nil)
+		mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+		mockTrx := trxMock.NewMockManager(ctrl)
+		mockTrx.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+		// once for the merge and once in the transaction for the audited changes
+		mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).DoAndReturn(func(context.Context, uuid.UUID) (*prodEntity.Product, error) {
+			return stored(), nil
+		}).Times(2)
+		var saved *prodEntity.Product
+		mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, products []*prodEntity.Product) error {
+			saved = products[0]
+			return nil
+		})
+		mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIdUuid}).Return(nil, nil, nil)
+		mockProdRepo.EXPECT().UpsertProductImages(ctx, gomock.Any()).Return(nil).AnyTimes()
+
+		s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, mockTrx, newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+		err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, uuid.Nil, true, []string{"price"}, &prodEntity.Product{
+			BaseModel: base_model.BaseModel{ID: productIdUuid},
+			Price:     prodEntity.MoneyFromFloat(3500, prodEntity.DefaultCurrency),
+			Version:   3,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, prodEntity.MoneyFromFloat(3500, prodEntity.DefaultCurrency), saved.Price)
+		assert.Equal(t, "indomie", saved.Name)
+		assert.Equal(t, int64(10), saved.Stock)
+		assert.Equal(t, storeIdUuid, saved.StoreID)
+	})
+
+	t.Run("Should return version conflict before merging a stale product", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(stored(), nil)
+
+		s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, uuid.Nil, true, []string{"price"}, &prodEntity.Product{
+			BaseModel: base_model.BaseModel{ID: productIdUuid},
+			Price:     prodEntity.MoneyFromFloat(3500, prodEntity.DefaultCurrency),
+			Version:   2,
+		})
+		assert.Equal(t, storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT), err)
+	})
+
+	t.Run("Should return not found when the product is not stored", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(nil, nil)
+
+		s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, uuid.Nil, true, []string{"price"}, &prodEntity.Product{
+			BaseModel: base_model.BaseModel{ID: productIdUuid},
+			Version:   3,
+		})
+		assert.Equal(t, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND), err)
+	})
+}
+
 func TestUpdateStore(t *testing.T) {
 	ctx := context.Background()
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -925,6 +1297,10 @@ func TestUpdateStore(t *testing.T) {
 		},
 		UserID:    sessionUserID,
 		StoreName: "Toko Maju",
+		Version:   2,
+		Images: []*entity.StoreImage{
+			{BaseModel: base_model.BaseModel{ID: uuid.New()}, ImageURL: "http://example.com/image.jpg", IsPrimary: true},
+		},
 	}
 
 	updatedStore := &entity.Store{
@@ -933,6 +1309,24 @@ func TestUpdateStore(t *testing.T) {
 		},
 		UserID:    sessionUserID,
 		StoreName: "Toko Sebelah",
+		Version:   2,
+	}
+
+	staleStore := &entity.Store{
+		BaseModel: base_model.BaseModel{
+			ID: storeIDUuid,
+		},
+		UserID:    sessionUserID,
+		StoreName: "Toko Sebelah",
+		Version:   1,
+	}
+
+	noVersionStore := &entity.Store{
+		BaseModel: base_model.BaseModel{
+			ID: storeIDUuid,
+		},
+		UserID:    sessionUserID,
+		StoreName: "Toko Sebelah",
 	}
 
 	updatedStore1 := &entity.Store{
@@ -941,6 +1335,7 @@ func TestUpdateStore(t *testing.T) {
 		},
 		UserID:    sessionUserID,
 		StoreName: "Toko Maju 1",
+		Version:   1,
 	}
 
 	testCases := []struct {
@@ -950,6 +1345,7 @@ func TestUpdateStore(t *testing.T) {
 			storeID string
 			store   *entity.Store
 		}
+		paths         []string
 		expectedStore *entity.Store
 		expectedError error
 	}{
@@ -957,7 +1353,7 @@ func TestUpdateStore(t *testing.T) {
 			name: "Success",
 			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
 				storeRepository.EXPECT().GetStore(ctx, storeID).Return(existingStore, nil).AnyTimes()
-				storeRepository.EXPECT().UpdateStore(ctx, gomock.Any()).Return(updatedStore, nil)
+				storeRepository.EXPECT().UpdateStore(ctx, gomock.Any(), gomock.Any()).Return(updatedStore, nil)
 			},
 			inputStore: struct {
 				storeID string
@@ -969,10 +1365,50 @@ func TestUpdateStore(t *testing.T) {
 			expectedStore: updatedStore,
 			expectedError: nil,
 		},
+		{
+			name: "Success_PartialUpdate",
+			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
+				storeRepository.EXPECT().GetStore(ctx, storeID).Return(existingStore, nil)
+				storeRepository.EXPECT().UpdateStore(ctx, gomock.Any(), []string{"phone"}).
+					DoAndReturn(func(_ context.Context, update *entity.Store, _ []string) (*entity.Store, error) {
+						// fields left out of the mask keep their stored value, stored images are not uploaded again
+						assert.Equal(t, "Toko Maju", update.StoreName)
+						assert.Equal(t, "08123456789", update.Phone)
+						assert.Equal(t, existingStore.Images, update.Images)
+						return updatedStore, nil
+					})
+			},
+			inputStore: struct {
+				storeID string
+				store   *entity.Store
+			}{
+				storeID: storeID,
+				store:   &entity.Store{Phone: "08123456789", Version: 2},
+			},
+			paths:         []string{"phone"},
+			expectedStore: updatedStore,
+			expectedError: nil,
+		},
+		{
+			name: "Error_UnknownMaskField",
+			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
+				storeRepository.EXPECT().GetStore(ctx, storeID).Return(existingStore, nil)
+			},
+			inputStore: struct {
+				storeID string
+				store   *entity.Store
+			}{
+				storeID: storeID,
+				store:   &entity.Store{Version: 2},
+			},
+			paths:         []string{"created_by"},
+			expectedStore: nil,
+			expectedError: storeerror.Invalid("update_mask", errPb.StoreErrorCode_FIELD_CAN_NOT_BE_UPDATED, "created_by"),
+		},
 		{
 			name: "Error_StoreNotFound",
 			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
-				storeRepository.EXPECT().GetStore(ctx, otherStoreID).Return(nil, status.Errorf(codes.NotFound, "Store is not found")).AnyTimes()
+				storeRepository.EXPECT().GetStore(ctx, otherStoreID).Return(nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)).AnyTimes()
 			},
 			inputStore: struct {
 				storeID string
@@ -982,7 +1418,52 @@ func TestUpdateStore(t *testing.T) {
 				store:   updatedStore1,
 			},
 			expectedStore: nil,
-			expectedError: status.Errorf(codes.NotFound, "Store is not found"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND),
+		},
+		{
+			name:       "Error_VersionNotProvided",
+			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {},
+			inputStore: struct {
+				storeID string
+				store   *entity.Store
+			}{
+				storeID: storeID,
+				store:   noVersionStore,
+			},
+			expectedStore: nil,
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_VERSION_IS_REQUIRED),
+		},
+		{
+			name: "Error_StaleVersion",
+			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
+				storeRepository.EXPECT().GetStore(ctx, storeID).Return(existingStore, nil)
+				storeRepository.EXPECT().UpdateStore(ctx, gomock.Any(), gomock.Any()).Times(0)
+			},
+			inputStore: struct {
+				storeID string
+				store   *entity.Store
+			}{
+				storeID: storeID,
+				store:   staleStore,
+			},
+			expectedStore: nil,
+			expectedError: storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT),
+		},
+		{
+			name: "Error_ChangedWhileSaving",
+			setupMocks: func(storeRepository *storeRepoMock.MockStoreServiceRepository, storage *storeRepoMock.MockStorage) {
+				storeRepository.EXPECT().GetStore(ctx, storeID).Return(existingStore, nil)
+				storeRepository.EXPECT().UpdateStore(ctx, gomock.Any(), gomock.Any()).Return(nil, repository.ErrVersionConflict)
+			},
+			inputStore: struct {
+				storeID string
+				store   *entity.Store
+			}{
+				storeID: storeID,
+				store:   updatedStore,
+			},
+			expectedStore: nil,
+			expectedError: storeerror.New(codes.Aborted, errPb.StoreErrorCode_VERSION_CONFLICT),
 		},
 	}
 
@@ -993,10 +1474,10 @@ func TestUpdateStore(t *testing.T) {
 
 			storeRepository := storeRepoMock.NewMockStoreServiceRepository(ctrl)
 			storage := storeRepoMock.NewMockStorage(ctrl)
-			service := New(storeRepository, nil, storage, nil)
+			service := New(storeRepository, nil, storage, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 
 			tc.setupMocks(storeRepository, storage)
-			result, err := service.UpdateStore(ctx, tc.inputStore.storeID, tc.inputStore.store)
+			result, err := service.UpdateStore(ctx, tc.inputStore.storeID, tc.inputStore.store, tc.paths)
 			assert.Equal(t, tc.expectedStore, result)
 			assert.Equal(t, tc.expectedError, err)
 		})
@@ -1059,7 +1540,7 @@ func Test_service_UpsertUnitOfMeasure(t *testing.T) {
 				uom: pcs,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.AlreadyExists, "Uom name is already exist in database"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_NAME_IS_ALREADY_USED),
 		},
 		{
 			name: "UpsertUnitOfMeasure_SymbolIsExist_ReturnTheError",
@@ -1071,7 +1552,7 @@ func Test_service_UpsertUnitOfMeasure(t *testing.T) {
 				uom: gram,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.AlreadyExists, "Uom symbol is already exist in database"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED),
 		},
 		{
 			name: "UpsertUnitOfMeasure_Error_ReturnTheError",
@@ -1083,7 +1564,7 @@ func Test_service_UpsertUnitOfMeasure(t *testing.T) {
 				uom: kg,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when inserting / updating unit of measure :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_UOM, err),
 		},
 		{
 			name: "UpsertUnitOfMeasure_NoError_Success",
@@ -1099,7 +1580,7 @@ func Test_service_UpsertUnitOfMeasure(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 			if err := s.UpsertUnitOfMeasure(tt.args.ctx, tt.args.uom); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Equal(t, tt.expectedError, err)
@@ -1155,7 +1636,7 @@ func Test_service_UpsertProductCategory(t *testing.T) {
 	// 			productCategory: pakaian,
 	// 		},
 	// 		wantErr:       true,
-	// 		expectedError: status.Errorf(codes.AlreadyExists, "Category name is already exist in database"),
+	// 		expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_CATEGORY_NAME_IS_ALREADY_USED),
 	// 	},
 	// 	{
 	// 		name: "UpsertProductCategory_Error_ReturnTheError",
@@ -1167,7 +1648,7 @@ func Test_service_UpsertProductCategory(t *testing.T) {
 	// 			productCategory: komputer,
 	// 		},
 	// 		wantErr:       true,
-	// 		expectedError: status.Errorf(codes.Internal, "Error when inserting / updating product category :"+errMsg),
+	// 		expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, err),
 	// 	},
 	// 	{
 	// 		name: "UpsertProductCategory_NoError_Success",
@@ -1183,7 +1664,7 @@ func Test_service_UpsertProductCategory(t *testing.T) {
 	// }
 	// for _, tt := range tests {
 	// 	t.Run(tt.name, func(t *testing.T) {
-	// 		s := New(nil, tt.fields.productRepository, nil, nil)
+	// 		s := New(nil, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 	// 		if err := s.UpsertProductCategory(tt.args.ctx, tt.args.productCategory); err != nil && tt.wantErr {
 	// 			assert.NotNil(t, err)
 	// 			assert.Equal(t, tt.expectedError, err)
@@ -1201,7 +1682,7 @@ func Test_service_UpsertProductCategory(t *testing.T) {
 	db.AutoMigrate(&prodEntity.ProductCategory{})
 
 	productRepository := prodRepo.NewPostgres(db)
-	svc := New(nil, productRepository, nil, nil)
+	svc := New(nil, productRepository, nil, nil, nil, transaction.NewManager(db), newAuditRepositoryMock(gomock.NewController(t)), newOutboxRepositoryMock(gomock.NewController(t)), nil, nil)
 
 	productCategory := &prodEntity.ProductCategory{
 		Name:     "test",
@@ -1290,7 +1771,7 @@ func Test_service_UpsertProductType(t *testing.T) {
 				productType: sedan,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.AlreadyExists, "Product type is already exist for this product category"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_TYPE_NAME_IS_ALREADY_USED),
 		},
 		{
 			name: "UpsertProductType_ProductCategoryNotFound_ReturnTheError",
@@ -1302,7 +1783,7 @@ func Test_service_UpsertProductType(t *testing.T) {
 				productType: sedan,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.NotFound, "Related product category data is not found"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND),
 		},
 		{
 			name: "UpsertProductType_Error_ReturnTheError",
@@ -1314,7 +1795,7 @@ func Test_service_UpsertProductType(t *testing.T) {
 				productType: mouse,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when inserting / updating product Type :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_TYPE, err),
 		},
 		{
 			name: "UpsertProductType_NoError_Success",
@@ -1330,7 +1811,7 @@ func Test_service_UpsertProductType(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 			if err := s.UpsertProductType(tt.args.ctx, tt.args.productType); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 			} else {
@@ -1376,7 +1857,7 @@ func Test_service_GetUnitOfMeasures(t *testing.T) {
 				isIncludeDeactivated: false,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting unit of measures :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err),
 		},
 		{
 			name: "GetUnitOfMeasures_NoError_Success",
@@ -1392,7 +1873,7 @@ func Test_service_GetUnitOfMeasures(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 			if uom, err := s.GetUnitOfMeasures(tt.args.ctx, tt.args.isIncludeDeactivated); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Nil(t, uom)
@@ -1407,6 +1888,7 @@ func Test_service_GetUnitOfMeasures(t *testing.T) {
 func Test_service_GetProductsByStoreId(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockProdRepo.EXPECT().GetActiveProductDiscounts(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
 	ctx := context.Background()
 	errMsg := "ERROR"
@@ -1431,9 +1913,9 @@ func Test_service_GetProductsByStoreId(t *testing.T) {
 			ID: otherStoreIDUuid,
 		},
 	}, nil).AnyTimes()
-	mockStoreRepo.EXPECT().GetStore(ctx, otherStoreID2).Return(nil, status.Errorf(codes.NotFound, "Not Found")).AnyTimes()
-	mockProdRepo.EXPECT().GetProductsByStoreId(ctx, otherStoreIDUuid, gomock.Any(), false).Return(nil, err).AnyTimes()
-	mockProdRepo.EXPECT().GetProductsByStoreId(ctx, storeIDUuid, gomock.Any(), false).Return(products, nil).AnyTimes()
+	mockStoreRepo.EXPECT().GetStore(ctx, otherStoreID2).Return(nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)).AnyTimes()
+	mockProdRepo.EXPECT().GetProductsByStoreId(ctx, otherStoreIDUuid, gomock.Any(), nil, nil, false).Return(nil, err).AnyTimes()
+	mockProdRepo.EXPECT().GetProductsByStoreId(ctx, storeIDUuid, gomock.Any(), nil, nil, false).Return(products, nil).AnyTimes()
 	type fields struct {
 		productRepository *prodRepoMock.MockProductRepository
 		storeRepository   *storeRepoMock.MockStoreServiceRepository
@@ -1463,7 +1945,7 @@ func Test_service_GetProductsByStoreId(t *testing.T) {
 				storeID: otherStoreID2Uuid,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.NotFound, "Not Found"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND),
 		},
 		{
 			name: "GetProductsByStoreId_Error_ReturnTheError",
@@ -1476,7 +1958,7 @@ func Test_service_GetProductsByStoreId(t *testing.T) {
 				storeID: otherStoreIDUuid,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting product list :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err),
 		},
 		{
 			name: "GetProductsByStoreId_NoError_Success",
@@ -1493,8 +1975,8 @@ func Test_service_GetProductsByStoreId(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(tt.fields.storeRepository, tt.fields.productRepository, nil, nil)
-			if gotProducts, err := s.GetProductsByStoreId(tt.args.ctx, tt.args.storeID, tt.args.productTypeId, tt.args.isIncludeDeactivated); err != nil && tt.wantErr {
+			s := New(tt.fields.storeRepository, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+			if gotProducts, err := s.GetProductsByStoreId(tt.args.ctx, tt.args.storeID, tt.args.productTypeId, nil, nil, tt.args.isIncludeDeactivated); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Nil(t, gotProducts)
 			} else {
@@ -1515,19 +1997,10 @@ func Test_service_GetProductCategories(t *testing.T) {
 	category = append(category, &prodEntity.ProductCategory{
 		Name: "shirt",
 	})
-	uoms := []string{"pieces", "kilogram", "ons", "pound", "botol"}
+	uoms := []*prodEntity.UnitOfMeasure{{Name: "pieces", Symbol: "pcs", IsActive: true}}
 	mockProdRepo.EXPECT().GetProductCategories(ctx, false).Return(nil, err).AnyTimes()
 	mockProdRepo.EXPECT().GetProductCategories(ctx, true).Return(category, nil).AnyTimes()
-
-	// write file
-	fileName := "uom.json"
-	file, _ := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	defer func() {
-		file.Close()
-		_ = os.Remove("uom.json")
-	}()
-	encoder := json.NewEncoder(file)
-	_ = encoder.Encode(uoms)
+	mockProdRepo.EXPECT().GetUnitOfMeasures(ctx, false).Return(uoms, nil).AnyTimes()
 
 	type fields struct {
 		productRepository *prodRepoMock.MockProductRepository
@@ -1553,7 +2026,7 @@ func Test_service_GetProductCategories(t *testing.T) {
 				isIncludeDeactivated: false,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting product categories :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err),
 		},
 		{
 			name: "GetProductCategories_NoError_Success",
@@ -1569,7 +2042,7 @@ func Test_service_GetProductCategories(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 			if cats, uom, err := s.GetProductCategories(tt.args.ctx, tt.args.isIncludeDeactivated); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Nil(t, cats)
@@ -1634,7 +2107,7 @@ func Test_service_GetProductTypes(t *testing.T) {
 				isIncludeDeactivated: false,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.NotFound, "Product category id is not found"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND),
 		},
 		{
 			name: "GetProductTypes_Error_ReturnTheError",
@@ -1647,7 +2120,7 @@ func Test_service_GetProductTypes(t *testing.T) {
 				isIncludeDeactivated: false,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting product types :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err),
 		},
 		{
 			name: "GetProductTypes_NoError_Success",
@@ -1664,7 +2137,7 @@ func Test_service_GetProductTypes(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 			if prodType, err := s.GetProductTypes(tt.args.ctx, tt.args.productCategoryId, tt.args.isIncludeDeactivated); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Nil(t, prodType)
@@ -1690,27 +2163,30 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 		BaseMasterDataModel: base_model.BaseMasterDataModel{
 			ID: 1,
 		},
-		Name:     "celcius",
-		Symbol:   "c",
-		IsActive: true,
+		Name:             "celcius",
+		Symbol:           "c",
+		IsActive:         true,
+		ConversionFactor: 1,
 	}
 
 	updatedUom1 := &prodEntity.UnitOfMeasure{
 		BaseMasterDataModel: base_model.BaseMasterDataModel{
 			ID: 2,
 		},
-		Name:     "fahrenheit",
-		Symbol:   "f",
-		IsActive: true,
+		Name:             "fahrenheit",
+		Symbol:           "f",
+		IsActive:         true,
+		ConversionFactor: 1,
 	}
 
 	updatedUom2 := &prodEntity.UnitOfMeasure{
 		BaseMasterDataModel: base_model.BaseMasterDataModel{
 			ID: 3,
 		},
-		Name:     "kelvin",
-		Symbol:   "k",
-		IsActive: true,
+		Name:             "kelvin",
+		Symbol:           "k",
+		IsActive:         true,
+		ConversionFactor: 1,
 	}
 
 	// 1st scenario: ERROR getting UoM by ID
@@ -1765,7 +2241,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 				uom:   updatedUom1,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting uom: "+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err),
 		},
 		{
 			name: "UpdateUnitOfMeasure_Error_UoMNameAlreadyExists",
@@ -1778,7 +2254,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 				uom:   initialUom,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.AlreadyExists, "Name is already used by another UoM"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_NAME_IS_ALREADY_USED),
 		},
 		{
 			name: "UpdateUnitOfMeasure_Error_SymbolAlreadyExists",
@@ -1791,7 +2267,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 				uom:   updatedUom1,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.AlreadyExists, "Symbol is already used by another UoM"),
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_UOM_SYMBOL_IS_ALREADY_USED),
 		},
 		{
 			name: "UpdateUnitOfMeasure_Error_UnableToUpdateUoM",
@@ -1804,7 +2280,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 				uom:   updatedUom1,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when updating unit of measure: "+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_UOM, err),
 		},
 		{
 			name: "UpdateUnitOfMeasure_NoError_SuccessUpdatingUoM",
@@ -1822,7 +2298,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
 			if err := s.UpdateUnitOfMeasure(tt.args.ctx, tt.args.uomId, tt.args.uom); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Equal(t, tt.expectedError, err)
@@ -1836,6 +2312,7 @@ func Test_service_UpdateUnitOfMeasure(t *testing.T) {
 func Test_service_GetProductById(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockProdRepo.EXPECT().GetActiveProductDiscounts(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ctx := context.Background()
 	errMsg := "ERROR"
 	err := errors.New(errMsg)
@@ -1872,7 +2349,7 @@ func Test_service_GetProductById(t *testing.T) {
 				productId: otherProductIDUuid,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.Internal, "Error when getting product by id :"+errMsg),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT, err),
 		},
 		{
 			name: "GetProductById_ProductIdNotFound_ReturnTheError",
@@ -1884,7 +2361,7 @@ func Test_service_GetProductById(t *testing.T) {
 				productId: otherProductIDUuid2,
 			},
 			wantErr:       true,
-			expectedError: status.Errorf(codes.NotFound, "Product id not found"),
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND),
 		},
 		{
 			name: "GetProductById_NoError_Success",
@@ -1900,7 +2377,7 @@ func Test_service_GetProductById(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := New(nil, tt.fields.productRepository, nil, nil)
+			s := New(nil, tt.fields.productRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 			if p, err := s.GetProductById(tt.args.ctx, tt.args.productId); err != nil && tt.wantErr {
 				assert.NotNil(t, err)
 				assert.Nil(t, p)
@@ -1912,41 +2389,266 @@ func Test_service_GetProductById(t *testing.T) {
 	}
 }
 
-func TestDeleteProductById(t *testing.T) {
+func Test_service_GetProductImagesInformation(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
 	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
-
 	ctx := context.Background()
-	errMsg := "ERROR"
-	err := errors.New(errMsg)
+	imageIDUuid := uuid.MustParse(productImageID)
+	otherImageIDUuid := uuid.MustParse(otherproductImageID2)
+	ids := []string{productImageID, otherproductImageID2}
 
-	productService := New(nil, mockProdRepo, nil, mockImageRepo)
-
-	productIDUuid := uuid.MustParse(productID)
-	userIDUuid := uuid.MustParse(userID)
-	removeProdImage1 := &prodEntity.ProductImage{
-		ProductId:      productIDUuid,
-		ImageBase64Str: "aaa",
+	tests := []struct {
+		name         string
+		mock         func()
+		expectedURLs []string
+	}{
+		{
+			name: "GetProductImagesInformation_ImageServiceError_ImageUrlsAreEmpty",
+			mock: func() {
+				mockImageRepo.EXPECT().GetImagesByIds(ctx, ids).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+			},
+			expectedURLs: []string{"", ""},
+		},
+		{
+			name: "GetProductImagesInformation_ImageNotFound_ImageUrlIsEmpty",
+			mock: func() {
+				mockImageRepo.EXPECT().GetImagesByIds(ctx, ids).Return([]*utilityPb.Image{
+					{Id: productImageID, Path: "http://image/1.png"},
+				}, nil)
+			},
+			expectedURLs: []string{"http://image/1.png", ""},
+		},
+		{
+			name: "GetProductImagesInformation_NoError_Success",
+			mock: func() {
+				mockImageRepo.EXPECT().GetImagesByIds(ctx, ids).Return([]*utilityPb.Image{
+					{Id: productImageID, Path: "http://image/1.png"},
+					{Id: otherproductImageID2, Path: "http://image/2.png"},
+				}, nil)
+			},
+			expectedURLs: []string{"http://image/1.png", "http://image/2.png"},
+		},
 	}
-	removeProdImage2 := &prodEntity.ProductImage{
-		ProductId:      productIDUuid,
-		ImageBase64Str: "aaa",
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			product := &prodEntity.Product{
+				Images: []*prodEntity.ProductImage{
+					{ImageId: imageIDUuid},
+					{ImageId: otherImageIDUuid},
+				},
+			}
+			s := &service{imageRepository: mockImageRepo}
+			err := s.GetProductImagesInformation(ctx, product, nil)
+			assert.Nil(t, err)
+			for i, img := range product.Images {
+				assert.Equal(t, tt.expectedURLs[i], img.ImageURL)
+			}
+		})
 	}
+}
 
-	productImagesToBeRemoved := []*prodEntity.ProductImage{removeProdImage1, removeProdImage2}
-	existingProdImagesMap := make(map[uuid.UUID][]*prodEntity.ProductImage)
-
-	t.Run("Should return empty when success", func(t *testing.T) {
-		mockProdRepo.EXPECT().
+func Test_service_BatchGetProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	ctx := context.Background()
+	s := New(nil, mockProdRepo, nil, mockImageRepo, nil, nil, nil, nil, nil, nil)
+
+	first, second, missing := uuid.New(), uuid.New(), uuid.New()
+	ids := []uuid.UUID{second, missing, first, second}
+	products := []*prodEntity.Product{
+		{BaseModel: base_model.BaseModel{ID: first}, Price: idr(10000), Images: []*prodEntity.ProductImage{{ImageId: uuid.MustParse(productImageID)}}},
+		{BaseModel: base_model.BaseModel{ID: second}, Images: []*prodEntity.ProductImage{{ImageId: uuid.MustParse(otherproductImageID2)}}},
+	}
+
+	t.Run("BatchGetProducts_RepositoryError_ReturnInternal", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductsByIds(ctx, ids).Return(nil, errors.New("db down"))
+		got, err := s.BatchGetProducts(ctx, ids)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, got)
+	})
+
+	t.Run("BatchGetProducts_NoError_RequestOrderWithOneImageCall", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductsByIds(ctx, ids).Return(products, nil)
+		mockImageRepo.EXPECT().GetImagesByIds(ctx, []string{productImageID, otherproductImageID2}).Return([]*utilityPb.Image{
+			{Id: productImageID, Path: "http://image/1.png"},
+			{Id: otherproductImageID2, Path: "http://image/2.png"},
+		}, nil).Times(1)
+		mockProdRepo.EXPECT().GetActiveProductDiscounts(ctx, []uuid.UUID{first, second}, gomock.Any()).Return([]*prodEntity.ProductDiscount{
+			{ProductID: first, DiscountType: prodEntity.DiscountPercentage, Value: 10, StartsAt: time.Now().Add(-time.Hour)},
+		}, nil)
+
+		got, err := s.BatchGetProducts(ctx, ids)
+		assert.Nil(t, err)
+		assert.Len(t, got, 4)
+		assert.Equal(t, second, got[0].ID)
+		assert.Nil(t, got[1])
+		assert.Equal(t, first, got[2].ID)
+		assert.Equal(t, got[0], got[3])
+		assert.Equal(t, "http://image/1.png", got[2].Images[0].ImageURL)
+		assert.Equal(t, "http://image/2.png", got[0].Images[0].ImageURL)
+		assert.Equal(t, idr(9000), got[2].EffectivePrice)
+		assert.Nil(t, got[0].ActiveDiscount)
+	})
+}
+
+func Test_service_BatchGetStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	ctx := context.Background()
+	s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	found, missing := uuid.New(), uuid.New()
+	ids := []uuid.UUID{missing, found}
+	mockStoreRepo.EXPECT().GetStoresByIds(ctx, ids).Return([]*entity.Store{{BaseModel: base_model.BaseModel{ID: found}}}, nil)
+
+	got, err := s.BatchGetStores(ctx, ids)
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Nil(t, got[0])
+	assert.Equal(t, found, got[1].ID)
+}
+
+func Test_service_DeleteStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
+	mockTrx := trxMock.NewMockManager(ctrl)
+	mockTrx.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(runInTransaction).AnyTimes()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"x-user-id": userID}))
+	storeService := New(mockStoreRepo, mockProdRepo, nil, nil, mockImageObjectRepo, mockTrx, newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+
+	productIDUuid := uuid.MustParse(productID)
+	prodImages := []*prodEntity.ProductImage{{ProductId: productIDUuid, ImageId: uuid.New()}}
+	mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(&entity.Store{BaseModel: base_model.BaseModel{ID: uuid.MustParse(storeID)}}, nil).AnyTimes()
+
+	t.Run("DeleteStores_NoClaims_ReturnUnauthenticated", func(t *testing.T) {
+		err := storeService.DeleteStores(context.Background(), []string{storeID})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("DeleteStores_DeleteImagesFailed_StoreNotDeleted", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductsByStoreId(ctx, uuid.MustParse(storeID), nil, nil, nil, true).
+			Return([]*prodEntity.Product{{BaseModel: base_model.BaseModel{ID: productIDUuid}}}, nil)
+		mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIDUuid}).Return(prodImages, nil, nil)
+		mockImageObjectRepo.EXPECT().ReleaseImageObjects(ctx, []uuid.UUID{prodImages[0].ImageId}).Return([]uuid.UUID{}, nil)
+		mockProdRepo.EXPECT().DeleteProductImages(ctx, prodImages).Return(errors.New("error"))
+		mockStoreRepo.EXPECT().DeleteStores(gomock.Any(), gomock.Any()).Times(0)
+
+		err := storeService.DeleteStores(ctx, []string{storeID})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("DeleteStores_NoError_Success", func(t *testing.T) {
+		mockProdRepo.EXPECT().GetProductsByStoreId(ctx, uuid.MustParse(storeID), nil, nil, nil, true).
+			Return([]*prodEntity.Product{{BaseModel: base_model.BaseModel{ID: productIDUuid}}}, nil)
+		mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIDUuid}).Return(prodImages, nil, nil)
+		mockImageObjectRepo.EXPECT().ReleaseImageObjects(ctx, []uuid.UUID{prodImages[0].ImageId}).Return([]uuid.UUID{}, nil)
+		mockProdRepo.EXPECT().DeleteProductImages(ctx, prodImages).Return(nil)
+		mockStoreRepo.EXPECT().DeleteStores(ctx, []string{storeID}).Return(nil)
+
+		err := storeService.DeleteStores(ctx, []string{storeID})
+		assert.Nil(t, err)
+	})
+}
+
+func TestDeleteProductById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
+
+	ctx := context.Background()
+	errMsg := "ERROR"
+	err := errors.New(errMsg)
+
+	mockTrx := trxMock.NewMockManager(ctrl)
+
+	productService := New(nil, mockProdRepo, nil, mockImageRepo, mockImageObjectRepo, mockTrx, newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+
+	productIDUuid := uuid.MustParse(productID)
+	userIDUuid := uuid.MustParse(userID)
+	removeProdImage1 := &prodEntity.ProductImage{
+		ProductId:      productIDUuid,
+		ImageBase64Str: "YWFh",
+	}
+	removeProdImage2 := &prodEntity.ProductImage{
+		ProductId:      productIDUuid,
+		ImageBase64Str: "YWFh",
+	}
+
+	productImagesToBeRemoved := []*prodEntity.ProductImage{removeProdImage1, removeProdImage2}
+	existingProdImagesMap := make(map[uuid.UUID][]*prodEntity.ProductImage)
+
+	t.Run("Should return not found when product does not exist", func(t *testing.T) {
+		mockProdRepo.EXPECT().
+			GetProductById(ctx, productIDUuid).
+			Times(1).
+			Return(nil, nil)
+
+		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	mockProdRepo.EXPECT().
+		GetProductById(ctx, productIDUuid).
+		Return(&prodEntity.Product{BaseModel: base_model.BaseModel{ID: productIDUuid}, Images: productImagesToBeRemoved}, nil).
+		AnyTimes()
+
+	t.Run("Should keep image in storage when it is still referenced", func(t *testing.T) {
+		mockProdRepo.EXPECT().
 			GetProductImagesByProductIds(ctx, []uuid.UUID{productIDUuid}).
 			Times(1).
 			Return(productImagesToBeRemoved, existingProdImagesMap, nil)
 
+		mockTrx.EXPECT().
+			Do(ctx, gomock.Any()).
+			Times(1).
+			DoAndReturn(runInTransaction)
+
+		mockImageObjectRepo.EXPECT().
+			ReleaseImageObjects(ctx, gomock.Any()).
+			Times(1).
+			Return([]uuid.UUID{}, nil)
+
+		mockImageRepo.EXPECT().
+			RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
+
+		mockProdRepo.EXPECT().
+			DeleteProductImages(ctx, productImagesToBeRemoved).
+			Times(1).
+			Return(nil)
+
+		mockProdRepo.EXPECT().
+			DeleteProductById(gomock.Any(), productIDUuid).
+			Times(1).
+			Return(nil)
+
+		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
+
+		assert.NoError(t, err)
+	})
+
+	mockImageObjectRepo.EXPECT().
+		ReleaseImageObjects(ctx, gomock.Any()).
+		Return([]uuid.UUID{uuid.New()}, nil).
+		AnyTimes()
+
+	t.Run("Should return empty when success", func(t *testing.T) {
 		mockProdRepo.EXPECT().
-			InitiateTransaction(gomock.Any()).
+			GetProductImagesByProductIds(ctx, []uuid.UUID{productIDUuid}).
+			Times(1).
+			Return(productImagesToBeRemoved, existingProdImagesMap, nil)
+
+		mockTrx.EXPECT().
+			Do(ctx, gomock.Any()).
 			Times(1).
-			Return(true)
+			DoAndReturn(runInTransaction)
 
 		mockImageRepo.EXPECT().
 			RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
@@ -1963,10 +2665,6 @@ func TestDeleteProductById(t *testing.T) {
 			Times(1).
 			Return(nil)
 
-		mockProdRepo.EXPECT().
-			TransactionCommit().
-			Times(1)
-
 		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
 
 		assert.NoError(t, err)
@@ -1989,53 +2687,56 @@ func TestDeleteProductById(t *testing.T) {
 			Times(1).
 			Return(productImagesToBeRemoved, existingProdImagesMap, nil)
 
-		mockProdRepo.EXPECT().
-			InitiateTransaction(gomock.Any()).
+		mockTrx.EXPECT().
+			Do(ctx, gomock.Any()).
 			Times(1).
-			Return(true)
+			DoAndReturn(runInTransaction)
 
+		// the transaction is rolled back, so the released images are kept in storage
 		mockImageRepo.EXPECT().
 			RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
-			Times(1).
-			Return(nil)
+			Times(0)
 
 		mockProdRepo.EXPECT().
 			DeleteProductImages(ctx, productImagesToBeRemoved).
 			Times(1).
 			Return(err)
 
-		mockProdRepo.EXPECT().
-			TransactionRollback().
-			Times(1)
-
 		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
 
 		assert.Error(t, err)
 	})
 
-	t.Run("Should return error when failed remove image", func(t *testing.T) {
+	t.Run("Should keep the product deleted when failed remove image", func(t *testing.T) {
 		mockProdRepo.EXPECT().
 			GetProductImagesByProductIds(ctx, []uuid.UUID{productIDUuid}).
 			Times(1).
 			Return(productImagesToBeRemoved, existingProdImagesMap, nil)
 
+		mockTrx.EXPECT().
+			Do(ctx, gomock.Any()).
+			Times(1).
+			DoAndReturn(runInTransaction)
+
+		mockProdRepo.EXPECT().
+			DeleteProductImages(ctx, productImagesToBeRemoved).
+			Times(1).
+			Return(nil)
+
 		mockProdRepo.EXPECT().
-			InitiateTransaction(gomock.Any()).
+			DeleteProductById(gomock.Any(), productIDUuid).
 			Times(1).
-			Return(true)
+			Return(nil)
 
+		// the images are removed once the deletion is committed
 		mockImageRepo.EXPECT().
 			RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
 			Times(1).
-			Return(err)
-
-		mockProdRepo.EXPECT().
-			TransactionRollback().
-			Times(1)
+			Return(errors.New("storage down"))
 
 		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
 
-		assert.Error(t, err)
+		assert.NoError(t, err)
 	})
 
 	t.Run("Should return error when failed to delete", func(t *testing.T) {
@@ -2044,15 +2745,14 @@ func TestDeleteProductById(t *testing.T) {
 			Times(1).
 			Return(productImagesToBeRemoved, existingProdImagesMap, nil)
 
-		mockProdRepo.EXPECT().
-			InitiateTransaction(gomock.Any()).
+		mockTrx.EXPECT().
+			Do(ctx, gomock.Any()).
 			Times(1).
-			Return(true)
+			DoAndReturn(runInTransaction)
 
 		mockImageRepo.EXPECT().
 			RemoveImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
-			Times(1).
-			Return(nil)
+			Times(0)
 
 		mockProdRepo.EXPECT().
 			DeleteProductImages(ctx, productImagesToBeRemoved).
@@ -2064,12 +2764,1713 @@ func TestDeleteProductById(t *testing.T) {
 			Times(1).
 			Return(err)
 
-		mockProdRepo.EXPECT().
-			TransactionRollback().
-			Times(1)
-
 		err := productService.DeleteProductById(ctx, userIDUuid, productIDUuid)
 
 		assert.Error(t, err)
 	})
 }
+
+func Test_service_UploadImageToStorage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockImageRepo := imageRepoMock.NewMockImageRepository(ctrl)
+	mockImageObjectRepo := imageRepoMock.NewMockImageObjectRepository(ctrl)
+
+	ctx := context.Background()
+	userIDUuid := uuid.MustParse(userID)
+	imageIDUuid := uuid.MustParse(productImageID)
+	otherImageIDUuid := uuid.MustParse(otherproductImageID2)
+	imageBase64 := "data:image/png;base64,YWFh"
+	contentHash := lib.ContentHash([]byte("aaa"))
+
+	svc := New(nil, mockProdRepo, nil, mockImageRepo, mockImageObjectRepo, nil, nil, nil, nil, nil)
+
+	t.Run("Should reuse stored image with identical content", func(t *testing.T) {
+		mockImageObjectRepo.EXPECT().
+			AcquireImageObject(ctx, contentHash).
+			Times(1).
+			Return(&imageEntity.ImageObject{ContentHash: contentHash, ImageId: imageIDUuid, RefCount: 2}, nil)
+
+		mockImageRepo.EXPECT().
+			UploadImage(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(0)
+
+		imageId, err := svc.(*service).UploadImageToStorage(ctx, imageBase64, userIDUuid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, imageIDUuid, *imageId)
+	})
+
+	t.Run("Should upload and register new image content", func(t *testing.T) {
+		mockImageObjectRepo.EXPECT().
+			AcquireImageObject(ctx, contentHash).
+			Times(1).
+			Return(nil, nil)
+
+		mockImageRepo.EXPECT().
+			UploadImage(ctx, imageBase64, "product", userID).
+			Times(1).
+			Return(&imageIDUuid, nil)
+
+		mockImageObjectRepo.EXPECT().
+			CreateImageObject(ctx, gomock.Any()).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, imageObject *imageEntity.ImageObject) (*imageEntity.ImageObject, error) {
+				assert.Equal(t, contentHash, imageObject.ContentHash)
+				assert.Equal(t, imageIDUuid, imageObject.ImageId)
+				return imageObject, nil
+			})
+
+		imageId, err := svc.(*service).UploadImageToStorage(ctx, imageBase64, userIDUuid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, imageIDUuid, *imageId)
+	})
+
+	t.Run("Should remove duplicate upload when content was registered concurrently", func(t *testing.T) {
+		mockImageObjectRepo.EXPECT().
+			AcquireImageObject(ctx, contentHash).
+			Times(1).
+			Return(nil, nil)
+
+		mockImageRepo.EXPECT().
+			UploadImage(ctx, imageBase64, "product", userID).
+			Times(1).
+			Return(&otherImageIDUuid, nil)
+
+		mockImageObjectRepo.EXPECT().
+			CreateImageObject(ctx, gomock.Any()).
+			Times(1).
+			Return(&imageEntity.ImageObject{ContentHash: contentHash, ImageId: imageIDUuid, RefCount: 2}, nil)
+
+		mockImageRepo.EXPECT().
+			RemoveImage(ctx, []string{otherImageIDUuid.String()}, "product", userID).
+			Times(1).
+			Return(nil)
+
+		imageId, err := svc.(*service).UploadImageToStorage(ctx, imageBase64, userIDUuid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, imageIDUuid, *imageId)
+	})
+
+	t.Run("Should return error when image is not base 64", func(t *testing.T) {
+		_, err := svc.(*service).UploadImageToStorage(ctx, "not base 64", userIDUuid)
+
+		assert.Equal(t, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_IMAGE_SHOULD_BE_IN_BASE_64_FORMAT), err)
+	})
+}
+
+func Test_service_OpenCloseStore_RecordsAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	mockAuditRepo := auditRepoMock.NewMockAuditRepository(ctrl)
+	userIDUuid := uuid.MustParse(userID)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-request-id", "request-1"))
+	s := New(mockStoreRepo, nil, nil, nil, nil, newTransactionMock(ctrl), mockAuditRepo, newOutboxRepositoryMock(ctrl), nil, nil)
+
+	mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: uuid.MustParse(storeID)},
+		UserID:    userIDUuid,
+		IsActive:  true,
+	}, nil).Times(2)
+	mockStoreRepo.EXPECT().OpenCloseStore(ctx, uuid.MustParse(storeID), false).Return(nil).Times(2)
+
+	t.Run("Success_EventRecorded", func(t *testing.T) {
+		mockAuditRepo.EXPECT().CreateAuditEvent(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, event *auditEntity.AuditEvent) error {
+				assert.Equal(t, auditEntity.ActionUpdate, event.Action)
+				assert.Equal(t, auditEntity.EntityStore, event.EntityType)
+				assert.Equal(t, storeID, event.EntityID)
+				assert.Equal(t, userIDUuid, event.ActorID)
+				assert.Equal(t, "request-1", event.RequestID)
+				assert.JSONEq(t, `{"is_active": {"before": true, "after": false}}`, event.Changes)
+				return nil
+			})
+
+		assert.NoError(t, s.OpenCloseStore(ctx, userIDUuid, nil, storeID, false))
+	})
+
+	t.Run("RecordFailed_ChangeFails", func(t *testing.T) {
+		mockAuditRepo.EXPECT().CreateAuditEvent(ctx, gomock.Any()).Return(errors.New("error"))
+
+		err := s.OpenCloseStore(ctx, userIDUuid, nil, storeID, false)
+		assert.Equal(t, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_RECORDING_AUDIT_EVENT, errors.New("error")), err)
+	})
+}
+
+func Test_service_ListAuditEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditRepo := auditRepoMock.NewMockAuditRepository(ctrl)
+	s := New(nil, nil, nil, nil, nil, nil, mockAuditRepo, nil, nil, nil)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("NoClaims_Unauthenticated", func(t *testing.T) {
+		_, err := s.ListAuditEvents(context.Background(), auditEntity.AuditEventFilter{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("NotAdmin_PermissionDenied", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+		_, err := s.ListAuditEvents(ctx, auditEntity.AuditEventFilter{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Admin_DefaultPageApplied", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "admin"))
+		events := []*auditEntity.AuditEvent{{EntityType: auditEntity.EntityProduct, EntityID: productID}}
+		mockAuditRepo.EXPECT().ListAuditEvents(ctx, auditEntity.AuditEventFilter{
+			EntityType: auditEntity.EntityProduct,
+			From:       from,
+			Page:       1,
+			Limit:      20,
+		}).Return(events, nil)
+
+		result, err := s.ListAuditEvents(ctx, auditEntity.AuditEventFilter{EntityType: auditEntity.EntityProduct, From: from})
+		assert.NoError(t, err)
+		assert.Equal(t, events, result)
+	})
+}
+
+func Test_service_UpsertProducts_WritesDomainEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	productIdUuid := uuid.MustParse(productID)
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: productIdUuid},
+		StoreID:   storeIdUuid,
+		Stock:     5,
+		BaseStock: 5000,
+		UomID:     3,
+		Version:   1,
+	}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil)
+	mockProdRepo.EXPECT().GetAvailableProductLots(ctx, productIdUuid).Return(nil, nil)
+	mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIdUuid}).Return(nil, nil, nil)
+
+	var events []*outboxEntity.OutboxEvent
+	mockOutboxRepo.EXPECT().CreateOutboxEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event *outboxEntity.OutboxEvent) error {
+		events = append(events, event)
+		return nil
+	}).Times(2)
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), mockOutboxRepo, nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil, &prodEntity.Product{
+		BaseModel:     base_model.BaseModel{ID: productIdUuid},
+		Version:       1,
+		Name:          "indomie",
+		Uom:           "kg",
+		ProductTypeID: 1,
+		Stock:         3,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, outboxEntity.ProductUpserted, events[0].EventType)
+	assert.Equal(t, productID, events[0].AggregateID)
+	assert.Equal(t, outboxEntity.StockChanged, events[1].EventType)
+	assert.JSONEq(t, `{"product_id": "`+productID+`", "store_id": "`+storeID+`", "previous_stock": 5, "stock": 3, "previous_base_stock": 5000, "base_stock": 3000}`, events[1].Payload)
+}
+
+func Test_service_UpsertProducts_RecordsPriceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	productIdUuid := uuid.MustParse(productID)
+	otherProductIdUuid := uuid.MustParse(otherProductID)
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: productIdUuid},
+		StoreID:   storeIdUuid,
+		Price:     idr(1000),
+		Version:   1,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductById(ctx, otherProductIdUuid).Return(&prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: otherProductIdUuid},
+		StoreID:   storeIdUuid,
+		Price:     idr(500),
+		Version:   1,
+	}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil)
+	mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, gomock.Any()).Return(nil, nil, nil)
+
+	var histories []*prodEntity.ProductPriceHistory
+	mockProdRepo.EXPECT().CreateProductPriceHistories(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, h []*prodEntity.ProductPriceHistory) error {
+		histories = h
+		return nil
+	})
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil,
+		&prodEntity.Product{BaseModel: base_model.BaseModel{ID: productIdUuid}, Version: 1, Name: "indomie", Uom: "kg", ProductTypeID: 1, Price: idr(1200)},
+		&prodEntity.Product{BaseModel: base_model.BaseModel{ID: otherProductIdUuid}, Version: 1, Name: "teh", Uom: "kg", ProductTypeID: 1, Price: idr(500)},
+	)
+	assert.NoError(t, err)
+
+	assert.Len(t, histories, 1)
+	assert.Equal(t, productIdUuid, histories[0].ProductID)
+	assert.Equal(t, idr(1200), histories[0].Price)
+	assert.Equal(t, idr(1000), histories[0].PreviousPrice)
+	assert.Equal(t, userIdUuid, histories[0].ChangedBy)
+}
+
+func Test_service_UpsertProducts_UnitOfMeasure(t *testing.T) {
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	inactive := &prodEntity.UnitOfMeasure{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 9}, Name: "botol", Symbol: "btl", ConversionFactor: 1}
+
+	tests := []struct {
+		name          string
+		product       *prodEntity.Product
+		wantUomID     int64
+		wantUom       string
+		wantBaseStock int64
+		expectedError error
+	}{
+		{
+			name:          "UpsertProducts_UomId_StockConvertedToBaseUnit",
+			product:       &prodEntity.Product{Name: "beras", UomID: 3, ProductTypeID: 1, Stock: 2},
+			wantUomID:     3,
+			wantUom:       "kilogram",
+			wantBaseStock: 2000,
+		},
+		{
+			name:          "UpsertProducts_UomSymbol_ResolvedToUomId",
+			product:       &prodEntity.Product{Name: "beras", Uom: "kg", ProductTypeID: 1, Stock: 2},
+			wantUomID:     3,
+			wantUom:       "kilogram",
+			wantBaseStock: 2000,
+		},
+		{
+			name:          "UpsertProducts_BaseUnit_StockKept",
+			product:       &prodEntity.Product{Name: "beras", UomID: 1, ProductTypeID: 1, Stock: 2},
+			wantUomID:     1,
+			wantUom:       "pieces",
+			wantBaseStock: 2,
+		},
+		{
+			name:          "UpsertProducts_UnknownUomId_Error",
+			product:       &prodEntity.Product{Name: "beras", UomID: 99, ProductTypeID: 1, Stock: 2},
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND, int64(99)),
+		},
+		{
+			name:          "UpsertProducts_InactiveUom_Error",
+			product:       &prodEntity.Product{Name: "beras", UomID: inactive.ID, ProductTypeID: 1, Stock: 2},
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND, inactive.ID),
+		},
+		{
+			name:          "UpsertProducts_UnknownUomName_Error",
+			product:       &prodEntity.Product{Name: "beras", Uom: "karung", ProductTypeID: 1, Stock: 2},
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND, "karung"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			mockProdRepo.EXPECT().GetUnitOfMeasuresByIds(gomock.Any(), []int64{inactive.ID}).Return([]*prodEntity.UnitOfMeasure{inactive}, nil).AnyTimes()
+			expectUnitOfMeasures(mockProdRepo)
+			expectNoProductAttributes(mockProdRepo)
+			mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+				BaseModel: base_model.BaseModel{ID: storeIdUuid},
+				UserID:    userIdUuid,
+			}, nil)
+			mockProdRepo.EXPECT().GetProductsByStoreIdAndNames(gomock.Any(), storeIdUuid, []string{"beras"}).Return(nil, nil)
+			mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+			mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+			err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, false, nil, tt.product)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUomID, tt.product.UomID)
+			assert.Equal(t, tt.wantUom, tt.product.Uom)
+			assert.Equal(t, tt.wantBaseStock, tt.product.BaseStock)
+		})
+	}
+}
+
+func Test_service_UpsertProducts_ProductAttributes(t *testing.T) {
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	definitions := []*prodEntity.ProductAttributeDefinition{
+		{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 1}, ProductTypeID: 1, Name: "Brand", DataType: prodEntity.AttributeText, IsRequired: true, AllowedValues: []string{"Ultra", "Bear Brand"}},
+		{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 2}, ProductTypeID: 1, Name: "Weight", DataType: prodEntity.AttributeNumber},
+		{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 3}, ProductTypeID: 1, Name: "Expiry", DataType: prodEntity.AttributeDate},
+	}
+
+	tests := []struct {
+		name           string
+		attributes     []*prodEntity.ProductAttribute
+		wantValues     []string
+		wantViolations []*storeerror.Violation
+	}{
+		{
+			name: "UpsertProducts_ValidAttributes_Normalized",
+			attributes: []*prodEntity.ProductAttribute{
+				{AttributeID: 1, Value: "ultra"}, {AttributeID: 2, Value: "250.0"}, {AttributeID: 3, Value: "2026-12-31"},
+			},
+			wantValues: []string{"Ultra", "250", "2026-12-31"},
+		},
+		{
+			name: "UpsertProducts_InvalidAttributes_EveryViolation",
+			attributes: []*prodEntity.ProductAttribute{
+				{AttributeID: 2, Value: "heavy"}, {AttributeID: 3, Value: "31/12/2026"}, {AttributeID: 9, Value: "x"}, {AttributeID: 2, Value: "1"},
+			},
+			wantViolations: []*storeerror.Violation{
+				storeerror.Field("product_list[0].attributes[0].value", errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE, "heavy", "number"),
+				storeerror.Field("product_list[0].attributes[1].value", errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE, "31/12/2026", "date"),
+				storeerror.Field("product_list[0].attributes[2].attribute_id", errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_NOT_DEFINED_FOR_PRODUCT_TYPE, int64(9)),
+				storeerror.Field("product_list[0].attributes[3].attribute_id", errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_DUPLICATED, "Weight"),
+				storeerror.Field("product_list[0].attributes", errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_IS_REQUIRED, "Brand"),
+			},
+		},
+		{
+			name:       "UpsertProducts_ValueNotAllowed_Error",
+			attributes: []*prodEntity.ProductAttribute{{AttributeID: 1, Value: "Dancow"}},
+			wantViolations: []*storeerror.Violation{
+				storeerror.Field("product_list[0].attributes[0].value", errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_VALUE_IS_NOT_ALLOWED, "Brand", "Ultra, Bear Brand"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			expectUnitOfMeasures(mockProdRepo)
+			mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+				BaseModel: base_model.BaseModel{ID: storeIdUuid},
+				UserID:    userIdUuid,
+			}, nil)
+			mockProdRepo.EXPECT().GetProductsByStoreIdAndNames(gomock.Any(), storeIdUuid, []string{"susu"}).Return(nil, nil)
+			mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+			mockProdRepo.EXPECT().GetProductAttributeDefinitions(gomock.Any(), []int64{1}).Return(definitions, nil)
+			mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			var saved []*prodEntity.Product
+			mockProdRepo.EXPECT().ReplaceProductAttributes(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, products []*prodEntity.Product) error {
+				saved = products
+				return nil
+			}).Times(len(tt.wantValues) / 3)
+
+			s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+			err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, false, nil, &prodEntity.Product{
+				Name: "susu", UomID: 1, ProductTypeID: 1, Stock: 2, Attributes: tt.attributes,
+			})
+			if tt.wantViolations != nil {
+				assert.Equal(t, storeerror.BadRequest(errPb.StoreErrorCode_PRODUCT_ATTRIBUTES_ARE_NOT_VALID, tt.wantViolations...), err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, saved, 1)
+			values := []string{}
+			for _, a := range saved[0].Attributes {
+				values = append(values, a.Value)
+			}
+			assert.Equal(t, tt.wantValues, values)
+		})
+	}
+}
+
+func Test_service_UpsertProductAttributeDefinition(t *testing.T) {
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	stored := &prodEntity.ProductAttributeDefinition{
+		BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 4, CreatedBy: userIdUuid},
+		ProductTypeID:       1,
+		Name:                "Halal",
+		DataType:            prodEntity.AttributeBoolean,
+	}
+
+	tests := []struct {
+		name          string
+		definition    *prodEntity.ProductAttributeDefinition
+		mock          func(mockProdRepo *prodRepoMock.MockProductRepository)
+		wantAllowed   []string
+		expectedError error
+	}{
+		{
+			name:       "UpsertProductAttributeDefinition_New_AllowedValuesNormalized",
+			definition: &prodEntity.ProductAttributeDefinition{ProductTypeID: 1, Name: "Weight", DataType: prodEntity.AttributeNumber, AllowedValues: []string{"250.00", " 1e3"}},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionByName(ctx, int64(1), "Weight").Return(nil, nil)
+				mockProdRepo.EXPECT().UpsertProductAttributeDefinition(ctx, gomock.Any()).Return(nil)
+			},
+			wantAllowed: []string{"250", "1000"},
+		},
+		{
+			name:       "UpsertProductAttributeDefinition_Update_KeepsCreator",
+			definition: &prodEntity.ProductAttributeDefinition{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 4}, ProductTypeID: 1, Name: "halal", DataType: prodEntity.AttributeBoolean, IsRequired: true},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionByName(ctx, int64(1), "halal").Return(stored, nil)
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionById(ctx, int64(4)).Return(stored, nil)
+				mockProdRepo.EXPECT().UpsertProductAttributeDefinition(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *prodEntity.ProductAttributeDefinition) error {
+					assert.Equal(t, userIdUuid, d.CreatedBy)
+					assert.Equal(t, userIdUuid, d.UpdatedBy)
+					return nil
+				})
+			},
+		},
+		{
+			name:       "UpsertProductAttributeDefinition_NameUsed_Error",
+			definition: &prodEntity.ProductAttributeDefinition{ProductTypeID: 1, Name: "HALAL", DataType: prodEntity.AttributeText},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionByName(ctx, int64(1), "HALAL").Return(stored, nil)
+			},
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NAME_IS_ALREADY_USED),
+		},
+		{
+			name:       "UpsertProductAttributeDefinition_OtherProductType_Error",
+			definition: &prodEntity.ProductAttributeDefinition{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 7}, ProductTypeID: 1, Name: "Brand", DataType: prodEntity.AttributeText},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionByName(ctx, int64(1), "Brand").Return(nil, nil)
+				mockProdRepo.EXPECT().GetProductAttributeDefinitionById(ctx, int64(7)).Return(&prodEntity.ProductAttributeDefinition{ProductTypeID: 2}, nil)
+			},
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_ATTRIBUTE_NOT_FOUND),
+		},
+		{
+			name:       "UpsertProductAttributeDefinition_AllowedValueNotOfDataType_Error",
+			definition: &prodEntity.ProductAttributeDefinition{ProductTypeID: 1, Name: "Expiry", DataType: prodEntity.AttributeDate, AllowedValues: []string{"2026-12-31", "tomorrow"}},
+			mock:       func(mockProdRepo *prodRepoMock.MockProductRepository) {},
+			expectedError: storeerror.BadRequest(errPb.StoreErrorCode_INVALID_ARGUMENT,
+				storeerror.Field("attribute.allowed_values[1]", errPb.StoreErrorCode_ATTRIBUTE_VALUE_DOES_NOT_MATCH_DATA_TYPE, "tomorrow", "date")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockProdRepo.EXPECT().GetProductTypesByIds(ctx, []int64{1}).Return([]*prodEntity.ProductType{{Name: "Susu"}}, nil)
+			tt.mock(mockProdRepo)
+
+			s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+			saved, err := s.UpsertProductAttributeDefinition(ctx, userIdUuid, tt.definition)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantAllowed != nil {
+				assert.Equal(t, tt.wantAllowed, saved.AllowedValues)
+			}
+		})
+	}
+}
+
+func Test_service_UpsertProducts_ProductCodes(t *testing.T) {
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	productIdUuid := uuid.MustParse(productID)
+	stored := &prodEntity.Product{BaseModel: base_model.BaseModel{ID: productIdUuid}, Name: "kopi", SKU: "KOPI-250", Barcode: "8991002101630"}
+
+	tests := []struct {
+		name          string
+		isUpdate      bool
+		products      []*prodEntity.Product
+		skus          []string
+		barcodes      []string
+		expectedError error
+	}{
+		{
+			name:     "UpsertProducts_NewCodes_Success",
+			products: []*prodEntity.Product{{Name: "teh", SKU: "TEH-1", Barcode: "96385074"}},
+			skus:     []string{"TEH-1"},
+			barcodes: []string{"96385074"},
+		},
+		{
+			name:          "UpsertProducts_SkuOfOtherProduct_AlreadyExists",
+			products:      []*prodEntity.Product{{Name: "teh", SKU: "kopi-250"}},
+			skus:          []string{"kopi-250"},
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_SKUS_ARE_ALREADY_REGISTERED, "KOPI-250"),
+		},
+		{
+			name:          "UpsertProducts_BarcodeOfOtherProduct_AlreadyExists",
+			products:      []*prodEntity.Product{{Name: "teh", Barcode: "8991002101630"}},
+			barcodes:      []string{"8991002101630"},
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_BARCODES_ARE_ALREADY_REGISTERED, "8991002101630"),
+		},
+		{
+			name:          "UpsertProducts_SkuTwiceInRequest_AlreadyExists",
+			products:      []*prodEntity.Product{{Name: "teh", SKU: "TEH-1"}, {Name: "teh tarik", SKU: "teh-1"}},
+			skus:          []string{"TEH-1", "teh-1"},
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_SKUS_ARE_ALREADY_REGISTERED, "teh-1"),
+		},
+		{
+			name:     "UpsertProducts_UpdateKeepsItsCodes_Success",
+			isUpdate: true,
+			products: []*prodEntity.Product{{BaseModel: base_model.BaseModel{ID: productIdUuid}, Name: "kopi", SKU: "KOPI-250", Barcode: "8991002101630", Version: 1}},
+			skus:     []string{"KOPI-250"},
+			barcodes: []string{"8991002101630"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			expectUnitOfMeasures(mockProdRepo)
+			expectNoProductAttributes(mockProdRepo)
+			mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+				BaseModel: base_model.BaseModel{ID: storeIdUuid},
+				UserID:    userIdUuid,
+			}, nil)
+			mockProdRepo.EXPECT().GetProductsByStoreIdAndNames(gomock.Any(), storeIdUuid, gomock.Any()).Return(nil, nil).AnyTimes()
+			// the stored product is found by its own sku and barcode only
+			mockProdRepo.EXPECT().GetProductsByStoreIdAndSkus(ctx, storeIdUuid, tt.skus).DoAndReturn(func(_ context.Context, _ uuid.UUID, skus []string) ([]*prodEntity.Product, error) {
+				for _, sku := range skus {
+					if strings.EqualFold(sku, stored.SKU) {
+						return []*prodEntity.Product{stored}, nil
+					}
+				}
+				return nil, nil
+			}).MaxTimes(1)
+			mockProdRepo.EXPECT().GetProductsByStoreIdAndBarcodes(ctx, storeIdUuid, tt.barcodes).DoAndReturn(func(_ context.Context, _ uuid.UUID, barcodes []string) ([]*prodEntity.Product, error) {
+				for _, barcode := range barcodes {
+					if barcode == stored.Barcode {
+						return []*prodEntity.Product{stored}, nil
+					}
+				}
+				return nil, nil
+			}).MaxTimes(1)
+			mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil).AnyTimes()
+			mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{Version: 1}, nil).AnyTimes()
+			mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, gomock.Any()).Return(nil, nil, nil).AnyTimes()
+			mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			for _, p := range tt.products {
+				p.UomID = 1
+				p.ProductTypeID = 1
+			}
+
+			s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+			err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, tt.isUpdate, nil, tt.products...)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func Test_service_GetProductByBarcode(t *testing.T) {
+	ctx := context.Background()
+	storeIdUuid := uuid.MustParse(storeID)
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	product := &prodEntity.Product{BaseModel: base_model.BaseModel{ID: uuid.MustParse(productID)}, StoreID: storeIdUuid, Name: "kopi", Barcode: "8991002101630"}
+
+	mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(&entity.Store{BaseModel: base_model.BaseModel{ID: storeIdUuid}}, nil).Times(2)
+	mockProdRepo.EXPECT().GetProductByBarcode(ctx, storeIdUuid, "8991002101630").Return(product, nil)
+	mockProdRepo.EXPECT().GetProductByBarcode(ctx, storeIdUuid, "96385074").Return(nil, nil)
+	mockProdRepo.EXPECT().GetActiveProductDiscounts(ctx, []uuid.UUID{product.ID}, gomock.Any()).Return(nil, nil)
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	got, err := s.GetProductByBarcode(ctx, storeIdUuid, "8991002101630")
+	assert.NoError(t, err)
+	assert.Equal(t, "kopi", got.Name)
+
+	_, err = s.GetProductByBarcode(ctx, storeIdUuid, "96385074")
+	assert.Equal(t, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_NOT_FOUND), err)
+}
+
+func TestConsumeLots(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	lots := []*prodEntity.ProductLot{
+		{LotNumber: "late", RemainingQuantity: 5, ExpiryDate: day(25), ReceivedDate: day(1)},
+		{LotNumber: "second", RemainingQuantity: 2, ExpiryDate: day(20), ReceivedDate: day(5)},
+		{LotNumber: "first", RemainingQuantity: 3, ExpiryDate: day(20), ReceivedDate: day(1)},
+	}
+
+	consumed := prodEntity.ConsumeLots(lots, 4)
+	assert.Len(t, consumed, 2)
+	assert.Equal(t, "first", consumed[0].LotNumber)
+	assert.Equal(t, int64(0), consumed[0].RemainingQuantity)
+	assert.Equal(t, "second", consumed[1].LotNumber)
+	assert.Equal(t, int64(1), consumed[1].RemainingQuantity)
+
+	// the quantity the lots do not hold is left untracked
+	consumed = prodEntity.ConsumeLots(lots, 10)
+	assert.Len(t, consumed, 2)
+	assert.Equal(t, int64(0), consumed[1].RemainingQuantity)
+}
+
+func Test_service_UpsertProducts_ConsumesLots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	expectUnitOfMeasures(mockProdRepo)
+	expectNoProductAttributes(mockProdRepo)
+	mockProdRepo.EXPECT().CreateProductPriceHistories(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	storeIdUuid := uuid.MustParse(storeID)
+	productIdUuid := uuid.MustParse(productID)
+	lots := []*prodEntity.ProductLot{
+		{LotNumber: "L-2", RemainingQuantity: 4, ExpiryDate: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{LotNumber: "L-1", RemainingQuantity: 2, ExpiryDate: time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	mockStoreRepo.EXPECT().GetStore(gomock.Any(), storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    userIdUuid,
+	}, nil)
+	mockProdRepo.EXPECT().GetProductTypesByIds(gomock.Any(), []int64{1}).Return([]*prodEntity.ProductType{{ProductCategoryID: 1}}, nil)
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(&prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: productIdUuid},
+		StoreID:   storeIdUuid,
+		Stock:     6,
+		UomID:     1,
+		Version:   1,
+	}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, gomock.Any()).Return(nil)
+	mockProdRepo.EXPECT().GetAvailableProductLots(ctx, productIdUuid).Return(lots, nil)
+	mockProdRepo.EXPECT().UpdateProductLotsRemainingQuantity(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, consumed []*prodEntity.ProductLot) error {
+		assert.Len(t, consumed, 2)
+		assert.Equal(t, "L-1", consumed[0].LotNumber)
+		assert.Equal(t, int64(0), consumed[0].RemainingQuantity)
+		assert.Equal(t, "L-2", consumed[1].LotNumber)
+		assert.Equal(t, int64(3), consumed[1].RemainingQuantity)
+		return nil
+	})
+	mockProdRepo.EXPECT().GetProductImagesByProductIds(ctx, []uuid.UUID{productIdUuid}).Return(nil, nil, nil)
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+	err := s.UpsertProducts(ctx, userIdUuid, []string{"merchant"}, storeIdUuid, true, nil, &prodEntity.Product{
+		BaseModel:     base_model.BaseModel{ID: productIdUuid},
+		Version:       1,
+		Name:          "susu",
+		Uom:           "pcs",
+		ProductTypeID: 1,
+		Stock:         3,
+	})
+	assert.NoError(t, err)
+}
+
+func Test_service_CreateProductLot(t *testing.T) {
+	ownerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+	otherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", otherUserID, "x-role-names", "merchant"))
+	productIdUuid := uuid.MustParse(productID)
+	storeIdUuid := uuid.MustParse(storeID)
+	expiry := time.Now().AddDate(0, 1, 0)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		lot      *prodEntity.ProductLot
+		wantCode codes.Code
+	}{
+		{
+			name:     "CreateProductLot_NotOwner_ReturnPermissionDenied",
+			ctx:      otherCtx,
+			lot:      &prodEntity.ProductLot{ProductID: productIdUuid, LotNumber: "L-2", Quantity: 2, ExpiryDate: expiry},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "CreateProductLot_ExpiresBeforeReceived_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			lot:      &prodEntity.ProductLot{ProductID: productIdUuid, LotNumber: "L-2", Quantity: 2, ExpiryDate: expiry, ReceivedDate: expiry.AddDate(0, 0, 1)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateProductLot_LotNumberUsed_ReturnAlreadyExists",
+			ctx:      ownerCtx,
+			lot:      &prodEntity.ProductLot{ProductID: productIdUuid, LotNumber: "l-1", Quantity: 2, ExpiryDate: expiry},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "CreateProductLot_NoError_Success",
+			ctx:      ownerCtx,
+			lot:      &prodEntity.ProductLot{ProductID: productIdUuid, LotNumber: "L-2", Quantity: 2, ExpiryDate: expiry},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			mockProdRepo.EXPECT().GetProductById(tt.ctx, productIdUuid).Return(&prodEntity.Product{
+				BaseModel: base_model.BaseModel{ID: productIdUuid},
+				StoreID:   storeIdUuid,
+				Stock:     3,
+				BaseStock: 3000,
+				UomID:     3,
+			}, nil)
+			mockStoreRepo.EXPECT().GetStore(tt.ctx, storeID).Return(&entity.Store{
+				BaseModel: base_model.BaseModel{ID: storeIdUuid},
+				UserID:    uuid.MustParse(userID),
+			}, nil)
+			mockProdRepo.EXPECT().GetProductLots(tt.ctx, productIdUuid).Return([]*prodEntity.ProductLot{{LotNumber: "L-1"}}, nil).AnyTimes()
+			if tt.wantCode == codes.OK {
+				mockProdRepo.EXPECT().GetUnitOfMeasureById(tt.ctx, int64(3)).Return(unitOfMeasures[2], nil)
+				mockProdRepo.EXPECT().UpsertProducts(tt.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, products []*prodEntity.Product) error {
+					assert.Equal(t, int64(5), products[0].Stock)
+					assert.Equal(t, int64(5000), products[0].BaseStock)
+					return nil
+				})
+				mockProdRepo.EXPECT().CreateProductLot(tt.ctx, tt.lot).Return(nil)
+			}
+
+			s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), newOutboxRepositoryMock(ctrl), nil, nil)
+			got, err := s.CreateProductLot(tt.ctx, tt.lot)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, int64(2), got.RemainingQuantity)
+				assert.Equal(t, uuid.MustParse(userID), got.CreatedBy)
+				assert.False(t, got.ReceivedDate.IsZero())
+			}
+		})
+	}
+}
+
+func Test_service_GetExpiringProducts(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+	storeIdUuid := uuid.MustParse(storeID)
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+	lots := []*prodEntity.ProductLot{{LotNumber: "L-1", ProductName: "susu"}}
+
+	mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(&entity.Store{
+		BaseModel: base_model.BaseModel{ID: storeIdUuid},
+		UserID:    uuid.MustParse(userID),
+	}, nil)
+	mockProdRepo.EXPECT().GetExpiringProductLots(ctx, storeIdUuid, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, until time.Time) ([]*prodEntity.ProductLot, error) {
+		y, m, d := time.Now().AddDate(0, 0, 7).Date()
+		assert.Equal(t, time.Date(y, m, d, 0, 0, 0, 0, time.UTC), until)
+		return lots, nil
+	})
+
+	s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	got, err := s.GetExpiringProducts(ctx, storeIdUuid, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, lots, got)
+}
+
+func TestProduct_ApplySchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	earlier := now.Add(-2 * time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		product    prodEntity.Product
+		wantDue    bool
+		wantOnSale bool
+	}{
+		{name: "nothing due", product: prodEntity.Product{SaleStatus: true, UnpublishAt: &future}, wantOnSale: true},
+		{name: "publish due", product: prodEntity.Product{PublishAt: &past, UnpublishAt: &future}, wantDue: true, wantOnSale: true},
+		{name: "unpublish due", product: prodEntity.Product{SaleStatus: true, UnpublishAt: &now}, wantDue: true},
+		{name: "publish after unpublish", product: prodEntity.Product{PublishAt: &past, UnpublishAt: &earlier}, wantDue: true, wantOnSale: true},
+		{name: "unpublish after publish", product: prodEntity.Product{PublishAt: &earlier, UnpublishAt: &past}, wantDue: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.product
+			assert.Equal(t, tt.wantDue, p.ApplySchedule(now))
+			assert.Equal(t, tt.wantOnSale, p.SaleStatus)
+			// the due schedules are cleared, the others are kept
+			assert.True(t, p.PublishAt == nil || p.PublishAt.After(now))
+			assert.True(t, p.UnpublishAt == nil || p.UnpublishAt.After(now))
+		})
+	}
+}
+
+func Test_service_ApplyProductSchedules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	publishAt := now.Add(-time.Minute)
+	product := &prodEntity.Product{
+		BaseModel: base_model.BaseModel{ID: uuid.MustParse(productID)},
+		StoreID:   uuid.MustParse(storeID),
+		PublishAt: &publishAt,
+		Version:   1,
+	}
+
+	mockProdRepo.EXPECT().ClaimScheduledProducts(ctx, now, 50).Return([]*prodEntity.Product{product}, nil)
+	mockProdRepo.EXPECT().UpsertProducts(ctx, []*prodEntity.Product{product}).DoAndReturn(func(_ context.Context, products []*prodEntity.Product) error {
+		assert.True(t, products[0].SaleStatus)
+		assert.Nil(t, products[0].PublishAt)
+		return nil
+	})
+	mockOutboxRepo.EXPECT().CreateOutboxEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event *outboxEntity.OutboxEvent) error {
+		assert.Equal(t, outboxEntity.ProductUpserted, event.EventType)
+		return nil
+	})
+
+	s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), mockOutboxRepo, nil, nil)
+	claimed, err := s.ApplyProductSchedules(ctx, now, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+}
+
+func Test_service_UpsertUnitOfMeasure_Conversion(t *testing.T) {
+	ctx := context.Background()
+	gramID := int64(2)
+	kgID := int64(3)
+	missingID := int64(99)
+
+	tests := []struct {
+		name          string
+		uom           *prodEntity.UnitOfMeasure
+		wantFactor    float64
+		expectedError error
+	}{
+		{
+			name:       "UpsertUnitOfMeasure_BaseUnit_FactorDefaultsToOne",
+			uom:        &prodEntity.UnitOfMeasure{Name: "liter", Symbol: "l"},
+			wantFactor: 1,
+		},
+		{
+			name:       "UpsertUnitOfMeasure_ConvertedToBaseUnit_Success",
+			uom:        &prodEntity.UnitOfMeasure{Name: "ons", Symbol: "ons", BaseUnitID: &gramID, ConversionFactor: 100},
+			wantFactor: 100,
+		},
+		{
+			name:          "UpsertUnitOfMeasure_BaseUnitWithFactor_Error",
+			uom:           &prodEntity.UnitOfMeasure{Name: "liter", Symbol: "l", ConversionFactor: 10},
+			expectedError: storeerror.Invalid("uom.conversion_factor", errPb.StoreErrorCode_INVALID_UOM_CONVERSION),
+		},
+		{
+			name:          "UpsertUnitOfMeasure_NoFactor_Error",
+			uom:           &prodEntity.UnitOfMeasure{Name: "ons", Symbol: "ons", BaseUnitID: &gramID},
+			expectedError: storeerror.Invalid("uom.conversion_factor", errPb.StoreErrorCode_UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE),
+		},
+		{
+			name:          "UpsertUnitOfMeasure_BaseUnitNotFound_Error",
+			uom:           &prodEntity.UnitOfMeasure{Name: "ons", Symbol: "ons", BaseUnitID: &missingID, ConversionFactor: 100},
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_BASE_UOM_IS_NOT_FOUND),
+		},
+		{
+			name:          "UpsertUnitOfMeasure_BaseUnitIsConverted_Error",
+			uom:           &prodEntity.UnitOfMeasure{Name: "ton", Symbol: "t", BaseUnitID: &kgID, ConversionFactor: 1000},
+			expectedError: storeerror.Invalid("uom.base_unit_id", errPb.StoreErrorCode_BASE_UOM_CAN_NOT_HAVE_BASE_UOM),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockProdRepo.EXPECT().GetUnitOfMeasureByName(ctx, tt.uom.Name).Return(nil, nil)
+			mockProdRepo.EXPECT().GetUnitOfMeasureBySymbol(ctx, tt.uom.Symbol).Return(nil, nil)
+			mockProdRepo.EXPECT().GetUnitOfMeasureById(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, id int64) (*prodEntity.UnitOfMeasure, error) {
+				for _, u := range unitOfMeasures {
+					if u.ID == id {
+						return u, nil
+					}
+				}
+				return nil, nil
+			}).AnyTimes()
+			mockProdRepo.EXPECT().UpsertUnitOfMeasure(ctx, tt.uom).Return(nil).AnyTimes()
+
+			s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+			err := s.UpsertUnitOfMeasure(ctx, tt.uom)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFactor, tt.uom.ConversionFactor)
+		})
+	}
+}
+
+func Test_service_UpdateUnitOfMeasure_ConvertsBaseStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	ctx := context.Background()
+	gramID := int64(2)
+	stored := &prodEntity.UnitOfMeasure{
+		BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 7},
+		Name:                "pound",
+		Symbol:              "lb",
+		IsActive:            true,
+		BaseUnitID:          &gramID,
+		ConversionFactor:    450,
+	}
+
+	mockProdRepo.EXPECT().GetUnitOfMeasureById(ctx, stored.ID).Return(stored, nil)
+	mockProdRepo.EXPECT().GetUnitOfMeasureById(ctx, gramID).Return(unitOfMeasures[1], nil)
+	mockProdRepo.EXPECT().GetUnitOfMeasureByName(ctx, "pound").Return(stored, nil)
+	mockProdRepo.EXPECT().GetUnitOfMeasureBySymbol(ctx, "lb").Return(stored, nil)
+	mockProdRepo.EXPECT().GetUnitOfMeasures(ctx, true).Return(unitOfMeasures, nil)
+	mockProdRepo.EXPECT().UpsertUnitOfMeasure(ctx, stored).Return(nil)
+	mockProdRepo.EXPECT().UpdateProductsBaseStock(ctx, stored.ID, 453.59237).Return(nil)
+
+	s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+	err := s.UpdateUnitOfMeasure(ctx, stored.ID, &prodEntity.UnitOfMeasure{
+		Name:             "pound",
+		Symbol:           "lb",
+		IsActive:         true,
+		BaseUnitID:       &gramID,
+		ConversionFactor: 453.59237,
+	})
+	assert.NoError(t, err)
+}
+
+// newCategoryTree returns Food > Beverages > Coffee and the root category Snacks, keyed by name.
+func newCategoryTree() map[string]*prodEntity.ProductCategory {
+	categories := map[string]*prodEntity.ProductCategory{}
+	for i, c := range [][2]string{{"Food", ""}, {"Beverages", "Food"}, {"Coffee", "Beverages"}, {"Snacks", ""}} {
+		category := &prodEntity.ProductCategory{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: int64(i + 1)}, Name: c[0], IsActive: true}
+		category.SetParent(categories[c[1]])
+		categories[c[0]] = category
+	}
+	return categories
+}
+
+func Test_service_MoveProductCategory(t *testing.T) {
+	ctx := context.Background()
+	userIdUuid := uuid.MustParse(userID)
+	missingID := int64(99)
+
+	tests := []struct {
+		name          string
+		category      string
+		parent        string
+		parentID      *int64
+		nameTaken     bool
+		lockErr       error
+		wantPath      string
+		expectedError error
+	}{
+		{
+			name:     "MoveProductCategory_BelowOtherParent_Success",
+			category: "Beverages",
+			parent:   "Snacks",
+			wantPath: "/4/",
+		},
+		{
+			name:     "MoveProductCategory_ToRoot_Success",
+			category: "Coffee",
+			wantPath: "/",
+		},
+		{
+			name:          "MoveProductCategory_BelowItsDescendant_Error",
+			category:      "Food",
+			parent:        "Coffee",
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF),
+		},
+		{
+			name:          "MoveProductCategory_BelowItself_Error",
+			category:      "Food",
+			parent:        "Food",
+			expectedError: storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_PRODUCT_CATEGORY_CAN_NOT_BE_MOVED_BELOW_ITSELF),
+		},
+		{
+			name:          "MoveProductCategory_ParentNotFound_Error",
+			category:      "Coffee",
+			parentID:      &missingID,
+			expectedError: storeerror.New(codes.NotFound, errPb.StoreErrorCode_PARENT_PRODUCT_CATEGORY_NOT_FOUND),
+		},
+		{
+			name:          "MoveProductCategory_NameUsedBelowParent_Error",
+			category:      "Coffee",
+			parent:        "Snacks",
+			nameTaken:     true,
+			expectedError: storeerror.New(codes.AlreadyExists, errPb.StoreErrorCode_PRODUCT_CATEGORY_NAME_IS_ALREADY_USED),
+		},
+		{
+			name:          "MoveProductCategory_LockError_ReturnTheError",
+			category:      "Coffee",
+			parent:        "Snacks",
+			lockErr:       errors.New("ERROR"),
+			expectedError: storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_PRODUCT_CATEGORY, errors.New("ERROR")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			categories := newCategoryTree()
+			category := categories[tt.category]
+			parentID := tt.parentID
+			if parent := categories[tt.parent]; parent != nil {
+				parentID = &parent.ID
+			}
+
+			// the categories are read after the tree is locked
+			lock := mockProdRepo.EXPECT().LockProductCategoryTree(ctx).Return(tt.lockErr)
+			mockProdRepo.EXPECT().GetProductCategoryById(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, id int64) (*prodEntity.ProductCategory, error) {
+				for _, c := range categories {
+					if c.ID == id {
+						return c, nil
+					}
+				}
+				return nil, nil
+			}).After(lock).AnyTimes()
+			var taken *prodEntity.ProductCategory
+			if tt.nameTaken {
+				taken = &prodEntity.ProductCategory{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 50}, Name: category.Name}
+			}
+			mockProdRepo.EXPECT().GetProductCategoryByName(ctx, parentID, strings.ToLower(category.Name)).Return(taken, nil).AnyTimes()
+			if tt.expectedError == nil {
+				mockProdRepo.EXPECT().MoveProductCategory(ctx, category, category.SubtreePath()).Return(nil)
+			}
+
+			s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+			moved, err := s.MoveProductCategory(ctx, userIdUuid, category.ID, parentID)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPath, moved.Path)
+			assert.Equal(t, parentID, moved.ParentID)
+		})
+	}
+}
+
+func Test_service_GetCategoryTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	ctx := context.Background()
+	categories := newCategoryTree()
+	all := []*prodEntity.ProductCategory{categories["Beverages"], categories["Coffee"], categories["Food"], categories["Snacks"]}
+
+	mockProdRepo.EXPECT().GetProductCategorySubtree(ctx, nil, false).Return(all, nil)
+	mockProdRepo.EXPECT().GetProductCategoryById(ctx, categories["Beverages"].ID).Return(categories["Beverages"], nil)
+	mockProdRepo.EXPECT().GetProductCategorySubtree(ctx, categories["Beverages"], false).Return([]*prodEntity.ProductCategory{categories["Beverages"], categories["Coffee"]}, nil)
+	mockProdRepo.EXPECT().GetProductCategoryById(ctx, int64(99)).Return(nil, nil)
+
+	s := New(nil, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	tree, err := s.GetCategoryTree(ctx, 0, false)
+	assert.NoError(t, err)
+	assert.Len(t, tree, 2)
+	assert.Equal(t, "Food", tree[0].Name)
+	assert.Equal(t, "Beverages", tree[0].Children[0].Name)
+	assert.Equal(t, "Coffee", tree[0].Children[0].Children[0].Name)
+	assert.Equal(t, "Snacks", tree[1].Name)
+	assert.Empty(t, tree[1].Children)
+
+	tree, err = s.GetCategoryTree(ctx, categories["Beverages"].ID, false)
+	assert.NoError(t, err)
+	assert.Len(t, tree, 1)
+	assert.Equal(t, "Beverages", tree[0].Name)
+	assert.Equal(t, "Coffee", tree[0].Children[0].Name)
+
+	_, err = s.GetCategoryTree(ctx, 99, false)
+	assert.Equal(t, storeerror.New(codes.NotFound, errPb.StoreErrorCode_PRODUCT_CATEGORY_NOT_FOUND), err)
+}
+
+func Test_service_GetProductCategories_Localized(t *testing.T) {
+	newCategories := func() []*prodEntity.ProductCategory {
+		return []*prodEntity.ProductCategory{
+			{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 1}, Name: "makanan", IsActive: true},
+			{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 2}, Name: "minuman", IsActive: true},
+		}
+	}
+	newUoms := func() []*prodEntity.UnitOfMeasure {
+		return []*prodEntity.UnitOfMeasure{
+			{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 6}, Name: "lusin", Symbol: "lsn", IsActive: true},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		wantCategories []string
+		wantUoms       []string
+	}{
+		{
+			name:           "GetProductCategories_NoLocale_ReturnStoredNames",
+			wantCategories: []string{"makanan", "minuman"},
+			wantUoms:       []string{"lusin"},
+		},
+		{
+			name:           "GetProductCategories_Indonesian_ReturnStoredNames",
+			acceptLanguage: "id-ID,id;q=0.9",
+			wantCategories: []string{"makanan", "minuman"},
+			wantUoms:       []string{"lusin"},
+		},
+		{
+			name:           "GetProductCategories_English_ReturnTranslatedNames",
+			acceptLanguage: "en-US,en;q=0.9,id;q=0.8",
+			wantCategories: []string{"food", "minuman"},
+			wantUoms:       []string{"dozen"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(middleware.LocaleKey, tt.acceptLanguage))
+
+			mockProdRepo.EXPECT().GetProductCategories(ctx, false).Return(newCategories(), nil)
+			mockProdRepo.EXPECT().GetUnitOfMeasures(ctx, false).Return(newUoms(), nil)
+			mockProdRepo.EXPECT().GetTranslationsByLocale(ctx, prodEntity.TranslationProductCategory, "en", []int64{1, 2}).
+				Return([]*prodEntity.Translation{{EntityType: prodEntity.TranslationProductCategory, EntityID: 1, Locale: "en", Name: "food"}}, nil).AnyTimes()
+			mockProdRepo.EXPECT().GetTranslationsByLocale(ctx, prodEntity.TranslationUnitOfMeasure, "en", []int64{6}).
+				Return([]*prodEntity.Translation{{EntityType: prodEntity.TranslationUnitOfMeasure, EntityID: 6, Locale: "en", Name: "dozen"}}, nil).AnyTimes()
+
+			s := New(nil, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			categories, uoms, err := s.GetProductCategories(ctx, false)
+			assert.NoError(t, err)
+			names := []string{}
+			for _, c := range categories {
+				names = append(names, c.Name)
+			}
+			assert.Equal(t, tt.wantCategories, names)
+			names = []string{}
+			for _, u := range uoms {
+				names = append(names, u.Name)
+			}
+			assert.Equal(t, tt.wantUoms, names)
+		})
+	}
+}
+
+func Test_service_GetProductById_Localized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(middleware.LocaleKey, "en"))
+	productIdUuid := uuid.MustParse(productID)
+	product := &prodEntity.Product{
+		BaseModel:           base_model.BaseModel{ID: productIdUuid},
+		Name:                "Kopi Susu",
+		ProductTypeID:       3,
+		ProductTypeName:     "kopi",
+		ProductCategoryID:   2,
+		ProductCategoryName: "minuman",
+		UomID:               7,
+		Uom:                 "botol",
+	}
+
+	mockProdRepo.EXPECT().GetProductById(ctx, productIdUuid).Return(product, nil)
+	mockProdRepo.EXPECT().GetActiveProductDiscounts(ctx, []uuid.UUID{productIdUuid}, gomock.Any()).Return(nil, nil)
+	mockProdRepo.EXPECT().GetTranslationsByLocale(ctx, prodEntity.TranslationProductType, "en", []int64{3}).
+		Return([]*prodEntity.Translation{{EntityID: 3, Locale: "en", Name: "coffee"}}, nil)
+	mockProdRepo.EXPECT().GetTranslationsByLocale(ctx, prodEntity.TranslationProductCategory, "en", []int64{2}).
+		Return([]*prodEntity.Translation{}, nil)
+	mockProdRepo.EXPECT().GetTranslationsByLocale(ctx, prodEntity.TranslationUnitOfMeasure, "en", []int64{7}).
+		Return([]*prodEntity.Translation{{EntityID: 7, Locale: "en", Name: "bottle"}}, nil)
+
+	s := New(nil, mockProdRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	got, err := s.GetProductById(ctx, productIdUuid)
+	assert.NoError(t, err)
+	assert.Equal(t, "Kopi Susu", got.Name)
+	assert.Equal(t, "coffee", got.ProductTypeName)
+	assert.Equal(t, "minuman", got.ProductCategoryName)
+	assert.Equal(t, "bottle", got.Uom)
+}
+
+func Test_service_UpsertTranslations(t *testing.T) {
+	ctx := context.Background()
+	stored := []*prodEntity.Translation{{EntityType: prodEntity.TranslationProductType, EntityID: 3, Locale: "en", Name: "coffee"}}
+
+	tests := []struct {
+		name         string
+		entityType   string
+		translations []*prodEntity.Translation
+		mock         func(mockProdRepo *prodRepoMock.MockProductRepository)
+		wantErr      error
+	}{
+		{
+			name:         "UpsertTranslations_UnsupportedLocale_ReturnInvalidArgument",
+			entityType:   prodEntity.TranslationProductType,
+			translations: []*prodEntity.Translation{{Locale: "fr", Name: "café"}},
+			mock:         func(mockProdRepo *prodRepoMock.MockProductRepository) {},
+			wantErr:      storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_LOCALE_IS_NOT_SUPPORTED, "fr"),
+		},
+		{
+			name:         "UpsertTranslations_UnknownEntityType_ReturnInvalidArgument",
+			entityType:   "store",
+			translations: []*prodEntity.Translation{{Locale: "en", Name: "coffee"}},
+			mock:         func(mockProdRepo *prodRepoMock.MockProductRepository) {},
+			wantErr:      storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED, "store"),
+		},
+		{
+			name:         "UpsertTranslations_EntityNotFound_ReturnNotFound",
+			entityType:   prodEntity.TranslationProductType,
+			translations: []*prodEntity.Translation{{Locale: "en", Name: "coffee"}},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductTypesByIds(ctx, []int64{3}).Return([]*prodEntity.ProductType{}, nil)
+			},
+			wantErr: storeerror.New(codes.NotFound, errPb.StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND, "product_type", int64(3)),
+		},
+		{
+			name:       "UpsertTranslations_DuplicateLocale_SaveLastName",
+			entityType: prodEntity.TranslationProductType,
+			translations: []*prodEntity.Translation{
+				{Locale: "en", Name: "cofee"},
+				{Locale: "en", Name: "coffee"},
+			},
+			mock: func(mockProdRepo *prodRepoMock.MockProductRepository) {
+				mockProdRepo.EXPECT().GetProductTypesByIds(ctx, []int64{3}).Return([]*prodEntity.ProductType{{BaseMasterDataModel: base_model.BaseMasterDataModel{ID: 3}, Name: "kopi"}}, nil)
+				gomock.InOrder(
+					mockProdRepo.EXPECT().GetTranslations(ctx, prodEntity.TranslationProductType, int64(3)).Return([]*prodEntity.Translation{}, nil),
+					mockProdRepo.EXPECT().UpsertTranslations(ctx, stored).Return(nil),
+					mockProdRepo.EXPECT().GetTranslations(ctx, prodEntity.TranslationProductType, int64(3)).Return(stored, nil),
+				)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			tt.mock(mockProdRepo)
+
+			s := New(nil, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+			got, err := s.UpsertTranslations(ctx, tt.entityType, 3, tt.translations)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, stored, got)
+			}
+		})
+	}
+}
+
+func Test_service_CreateProductDiscount(t *testing.T) {
+	ownerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+	otherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", otherUserID, "x-role-names", "merchant"))
+	productIdUuid := uuid.MustParse(productID)
+	storeIdUuid := uuid.MustParse(storeID)
+	startsAt := time.Now()
+	endsAt := startsAt.Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		discount   *prodEntity.ProductDiscount
+		wantCode   codes.Code
+		wantAmount prodEntity.Money
+	}{
+		{
+			name:     "CreateProductDiscount_NotOwner_ReturnPermissionDenied",
+			ctx:      otherCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Value: 100},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "CreateProductDiscount_UnknownType_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: "bogo", Value: 10},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateProductDiscount_PercentageAbove100_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountPercentage, Value: 120},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateProductDiscount_EndsBeforeStart_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountPercentage, Value: 10, StartsAt: startsAt, EndsAt: &endsAt},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateProductDiscount_FixedInOtherCurrency_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: prodEntity.NewMoney(100, "USD")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateProductDiscount_FixedZeroAmount_ReturnInvalidArgument",
+			ctx:      ownerCtx,
+			discount: &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: idr(0)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:       "CreateProductDiscount_NoError_Success",
+			ctx:        ownerCtx,
+			discount:   &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountPercentage, Value: 10},
+			wantCode:   codes.OK,
+			wantAmount: idr(0),
+		},
+		{
+			name:       "CreateProductDiscount_FixedAmount_Success",
+			ctx:        ownerCtx,
+			discount:   &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: idr(1500.5)},
+			wantCode:   codes.OK,
+			wantAmount: idr(1500.5),
+		},
+		{
+			name:       "CreateProductDiscount_FixedValueOnly_AmountInPriceCurrency",
+			ctx:        ownerCtx,
+			discount:   &prodEntity.ProductDiscount{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Value: 1500.5},
+			wantCode:   codes.OK,
+			wantAmount: idr(1500.5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProdRepo := prodRepoMock.NewMockProductRepository(ctrl)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			mockProdRepo.EXPECT().GetProductById(tt.ctx, productIdUuid).Return(&prodEntity.Product{
+				BaseModel: base_model.BaseModel{ID: productIdUuid},
+				StoreID:   storeIdUuid,
+				Price:     idr(10000),
+			}, nil)
+			mockStoreRepo.EXPECT().GetStore(tt.ctx, storeID).Return(&entity.Store{
+				BaseModel: base_model.BaseModel{ID: storeIdUuid},
+				UserID:    uuid.MustParse(userID),
+			}, nil)
+			if tt.wantCode == codes.OK {
+				mockProdRepo.EXPECT().CreateProductDiscount(tt.ctx, tt.discount).Return(nil)
+			}
+
+			s := New(mockStoreRepo, mockProdRepo, nil, nil, nil, newTransactionMock(ctrl), newAuditRepositoryMock(ctrl), nil, nil, nil)
+			got, err := s.CreateProductDiscount(tt.ctx, tt.discount)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, uuid.MustParse(userID), got.CreatedBy)
+				assert.False(t, got.StartsAt.IsZero())
+				assert.Equal(t, tt.wantAmount, got.Amount)
+				if got.DiscountType == prodEntity.DiscountFixed {
+					assert.Zero(t, got.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestProduct_ApplyDiscounts(t *testing.T) {
+	now := time.Now()
+	productIdUuid := uuid.MustParse(productID)
+	ended := now.Add(-time.Minute)
+	product := &prodEntity.Product{BaseModel: base_model.BaseModel{ID: productIdUuid}, Price: idr(10000)}
+
+	product.ApplyDiscounts([]*prodEntity.ProductDiscount{
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountPercentage, Value: 10, StartsAt: now.Add(-time.Hour)},
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: idr(2500), StartsAt: now.Add(-time.Hour)},
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: idr(9000), StartsAt: now.Add(-time.Hour), EndsAt: &ended},
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: prodEntity.NewMoney(900000, "USD"), StartsAt: now.Add(-time.Hour)},
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountPercentage, Value: 90, StartsAt: now.Add(time.Hour)},
+	}, now)
+	assert.Equal(t, idr(7500), product.EffectivePrice)
+	assert.Equal(t, idr(2500), product.ActiveDiscount.Amount)
+
+	data := product.ToProto()
+	assert.Equal(t, int64(10000), data.OriginalPrice.Units)
+	assert.Equal(t, int64(7500), data.EffectivePrice.Units)
+	assert.Equal(t, int64(2500), data.ActiveDiscount.Amount.Units)
+	assert.Equal(t, float64(2500), data.ActiveDiscount.Value)
+
+	product.ApplyDiscounts([]*prodEntity.ProductDiscount{
+		{ProductID: productIdUuid, DiscountType: prodEntity.DiscountFixed, Amount: idr(20000), StartsAt: now.Add(-time.Hour)},
+	}, now)
+	assert.Equal(t, idr(0), product.EffectivePrice)
+}
+
+func TestMoneyFromProto(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    *pb.Money
+		want     prodEntity.Money
+		wantCode codes.Code
+	}{
+		{name: "MoneyFromProto_Empty_DefaultCurrency", money: nil, want: prodEntity.NewMoney(0, "IDR")},
+		{name: "MoneyFromProto_Cents_Exact", money: &pb.Money{CurrencyCode: "USD", Units: 12, Nanos: 340000000}, want: prodEntity.NewMoney(1234, "USD")},
+		{name: "MoneyFromProto_Negative_Exact", money: &pb.Money{CurrencyCode: "USD", Units: -1, Nanos: -500000000}, want: prodEntity.NewMoney(-150, "USD")},
+		{name: "MoneyFromProto_SubCent_InvalidArgument", money: &pb.Money{Units: 1, Nanos: 1}, wantCode: codes.InvalidArgument},
+		{name: "MoneyFromProto_MixedSigns_InvalidArgument", money: &pb.Money{Units: 1, Nanos: -10000000}, wantCode: codes.InvalidArgument},
+		{name: "MoneyFromProto_InvalidCurrency_InvalidArgument", money: &pb.Money{CurrencyCode: "rupiah", Units: 1}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := prodEntity.MoneyFromProto(tt.money)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.money.GetUnits(), got.ToProto().Units)
+				assert.Equal(t, tt.money.GetNanos(), got.ToProto().Nanos)
+			}
+		})
+	}
+}
+
+func TestValidBarcodeCheckDigit(t *testing.T) {
+	for barcode, want := range map[string]bool{
+		"96385074":       true,  // EAN-8
+		"036000291452":   true,  // UPC-A
+		"8991002101630":  true,  // EAN-13
+		"10614141000415": true,  // GTIN-14
+		"8991002101631":  false, // wrong check digit
+		"899100210163a":  false,
+		"":               false,
+	} {
+		assert.Equal(t, want, prodEntity.ValidBarcodeCheckDigit(barcode), barcode)
+	}
+}
+
+func Test_service_CreateWebhookSubscription(t *testing.T) {
+	ownerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+	otherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", otherUserID, "x-role-names", "merchant"))
+	storeIdUuid := uuid.MustParse(storeID)
+	store := &entity.Store{BaseModel: base_model.BaseModel{ID: storeIdUuid}, UserID: uuid.MustParse(userID)}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		sub      *webhookEntity.WebhookSubscription
+		mock     func(repo *webhookRepoMock.MockWebhookRepository)
+		wantCode codes.Code
+	}{
+		{
+			name: "created with a generated secret",
+			ctx:  ownerCtx,
+			sub:  &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "https://pos.example.com/hook", EventTypes: []string{outboxEntity.StockChanged}},
+			mock: func(repo *webhookRepoMock.MockWebhookRepository) {
+				repo.EXPECT().CreateWebhookSubscription(ownerCtx, gomock.Any()).DoAndReturn(func(_ context.Context, sub *webhookEntity.WebhookSubscription) error {
+					assert.True(t, strings.HasPrefix(sub.Secret, "whsec_"))
+					assert.True(t, sub.IsActive)
+					assert.Equal(t, uuid.MustParse(userID), sub.CreatedBy)
+					return nil
+				})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "not the store owner",
+			ctx:      otherCtx,
+			sub:      &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "https://pos.example.com/hook"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "invalid url",
+			ctx:      ownerCtx,
+			sub:      &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "ftp://pos.example.com"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "internal address",
+			ctx:      ownerCtx,
+			sub:      &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "http://169.254.169.254/latest/meta-data/"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "localhost",
+			ctx:      ownerCtx,
+			sub:      &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "http://localhost:8080/hook"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "unknown event type",
+			ctx:      ownerCtx,
+			sub:      &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, URL: "https://pos.example.com/hook", EventTypes: []string{"StoreRenamed"}},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			mockWebhookRepo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+			mockStoreRepo.EXPECT().GetStore(tt.ctx, storeID).Return(store, nil)
+			if tt.mock != nil {
+				tt.mock(mockWebhookRepo)
+			}
+
+			s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, nil, mockWebhookRepo, nil)
+			_, err := s.CreateWebhookSubscription(tt.ctx, tt.sub)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_service_ReplayWebhookDelivery(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+	storeIdUuid := uuid.MustParse(storeID)
+	subscriptionID := uuid.New()
+	deliveryID := uuid.New()
+
+	newSubscription := func(isActive bool) *webhookEntity.WebhookSubscription {
+		sub := &webhookEntity.WebhookSubscription{StoreID: storeIdUuid, IsActive: isActive}
+		sub.ID = subscriptionID
+		return sub
+	}
+
+	tests := []struct {
+		name     string
+		mock     func(repo *webhookRepoMock.MockWebhookRepository)
+		wantCode codes.Code
+	}{
+		{
+			name: "failed delivery queued again",
+			mock: func(repo *webhookRepoMock.MockWebhookRepository) {
+				repo.EXPECT().GetWebhookSubscription(ctx, subscriptionID).Return(newSubscription(true), nil)
+				repo.EXPECT().GetWebhookDelivery(ctx, deliveryID).Return(&webhookEntity.WebhookDelivery{
+					ID:             deliveryID,
+					SubscriptionID: subscriptionID,
+					Status:         webhookEntity.DeliveryFailed,
+					Attempts:       8,
+					LastError:      "webhook responded 500 Internal Server Error",
+				}, nil)
+				repo.EXPECT().UpdateWebhookDelivery(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *webhookEntity.WebhookDelivery) error {
+					assert.Equal(t, webhookEntity.DeliveryPending, d.Status)
+					assert.Equal(t, 0, d.Attempts)
+					assert.Empty(t, d.LastError)
+					return nil
+				})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "webhook disabled",
+			mock: func(repo *webhookRepoMock.MockWebhookRepository) {
+				repo.EXPECT().GetWebhookSubscription(ctx, subscriptionID).Return(newSubscription(false), nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "delivery of another webhook",
+			mock: func(repo *webhookRepoMock.MockWebhookRepository) {
+				repo.EXPECT().GetWebhookSubscription(ctx, subscriptionID).Return(newSubscription(true), nil)
+				repo.EXPECT().GetWebhookDelivery(ctx, deliveryID).Return(&webhookEntity.WebhookDelivery{ID: deliveryID, SubscriptionID: uuid.New()}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "webhook of another store",
+			mock: func(repo *webhookRepoMock.MockWebhookRepository) {
+				sub := newSubscription(true)
+				sub.StoreID = uuid.MustParse(otherStoreID)
+				repo.EXPECT().GetWebhookSubscription(ctx, subscriptionID).Return(sub, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+			mockWebhookRepo := webhookRepoMock.NewMockWebhookRepository(ctrl)
+			mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(&entity.Store{UserID: uuid.MustParse(userID)}, nil)
+			tt.mock(mockWebhookRepo)
+
+			s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, nil, mockWebhookRepo, nil)
+			_, err := s.ReplayWebhookDelivery(ctx, storeIdUuid, subscriptionID, deliveryID)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_service_WatchStoreEvents(t *testing.T) {
+	storeIdUuid := uuid.MustParse(storeID)
+	store := &entity.Store{BaseModel: base_model.BaseModel{ID: storeIdUuid}, UserID: uuid.MustParse(userID)}
+	ownerCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-role-names", "merchant"))
+
+	t.Run("resumes after the sequence then streams new events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+		mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+		broker := watch.NewBroker()
+		ctx, cancel := context.WithCancel(ownerCtx)
+		defer cancel()
+
+		mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(store, nil)
+		mockOutboxRepo.EXPECT().GetOldestOutboxSequence(ctx).Return(int64(3), nil)
+		gomock.InOrder(
+			mockOutboxRepo.EXPECT().ListStoreOutboxEvents(ctx, storeIdUuid, outboxEntity.ProductEventTypes, int64(5), 100).
+				Return([]*outboxEntity.OutboxEvent{{Sequence: 7}, {Sequence: 9}}, nil),
+			mockOutboxRepo.EXPECT().ListStoreOutboxEvents(ctx, storeIdUuid, outboxEntity.ProductEventTypes, int64(9), 100).
+				Return([]*outboxEntity.OutboxEvent{{Sequence: 12}}, nil),
+		)
+
+		var sent []int64
+		s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, nil, broker)
+		err := s.WatchStoreEvents(ctx, storeIdUuid, outboxEntity.ProductEventTypes, 5, func(e *outboxEntity.OutboxEvent) error {
+			sent = append(sent, e.Sequence)
+			if e.Sequence == 9 {
+				// an event of the store is committed, the watcher is woken once the backlog is sent
+				broker.Notify(storeIdUuid)
+			}
+			if e.Sequence == 12 {
+				return context.Canceled
+			}
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []int64{7, 9, 12}, sent)
+	})
+
+	t.Run("starts after the latest event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+		mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+		ctx, cancel := context.WithCancel(ownerCtx)
+
+		mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(store, nil)
+		mockOutboxRepo.EXPECT().GetLastStoreOutboxSequence(ctx, storeIdUuid).Return(int64(42), nil)
+		mockOutboxRepo.EXPECT().ListStoreOutboxEvents(ctx, storeIdUuid, outboxEntity.StoreEventTypes, int64(42), 100).
+			DoAndReturn(func(context.Context, uuid.UUID, []string, int64, int) ([]*outboxEntity.OutboxEvent, error) {
+				cancel()
+				return nil, nil
+			})
+
+		s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, nil, watch.NewBroker())
+		err := s.WatchStoreEvents(ctx, storeIdUuid, outboxEntity.StoreEventTypes, 0, func(e *outboxEntity.OutboxEvent) error {
+			t.Fatalf("unexpected event %d", e.Sequence)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("resume sequence purged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+		mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+		ctx := ownerCtx
+
+		mockStoreRepo.EXPECT().GetStore(ctx, storeID).Return(store, nil)
+		mockOutboxRepo.EXPECT().GetOldestOutboxSequence(ctx).Return(int64(100), nil)
+
+		s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, nil, watch.NewBroker())
+		err := s.WatchStoreEvents(ctx, storeIdUuid, outboxEntity.StoreEventTypes, 5, nil)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+	t.Run("only the store owner can watch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStoreRepo := storeRepoMock.NewMockStoreServiceRepository(ctrl)
+		mockOutboxRepo := outboxRepoMock.NewMockOutboxRepository(ctrl)
+		otherCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", otherUserID, "x-role-names", "merchant"))
+
+		mockStoreRepo.EXPECT().GetStore(otherCtx, storeID).Return(store, nil)
+		mockOutboxRepo.EXPECT().GetOldestOutboxSequence(gomock.Any()).Times(0)
+		mockOutboxRepo.EXPECT().ListStoreOutboxEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		s := New(mockStoreRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, nil, watch.NewBroker())
+		err := s.WatchStoreEvents(context.Background(), storeIdUuid, outboxEntity.StoreEventTypes, 5, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		err = s.WatchStoreEvents(otherCtx, storeIdUuid, outboxEntity.StoreEventTypes, 5, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
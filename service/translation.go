@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	auditEntity "github.com/Mitra-Apps/be-store-service/domain/audit/entity"
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"google.golang.org/grpc/codes"
+)
+
+// localizedNames returns the names of the entities in the locale of the caller by entity id. It is
+// nil for the default locale, whose names are the ones stored with the entities, and entities without
+// a translation keep their stored name.
+func (s *service) localizedNames(ctx context.Context, entityType string, ids []int64) (map[int64]string, error) {
+	locale := middleware.LocaleFromContext(ctx)
+	if locale == middleware.DefaultLocale || len(ids) == 0 {
+		return nil, nil
+	}
+	translations, err := s.productRepository.GetTranslationsByLocale(ctx, entityType, locale, ids)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION, err)
+	}
+	names := make(map[int64]string, len(translations))
+	for _, t := range translations {
+		names[t.EntityID] = t.Name
+	}
+	return names, nil
+}
+
+// localize replaces *name with the translation of id when there is one.
+func localize(names map[int64]string, id int64, name *string) {
+	if translated, ok := names[id]; ok {
+		*name = translated
+	}
+}
+
+func (s *service) localizeCategories(ctx context.Context, categories []*prodEntity.ProductCategory) error {
+	ids := make([]int64, len(categories))
+	for i, c := range categories {
+		ids[i] = c.ID
+	}
+	names, err := s.localizedNames(ctx, prodEntity.TranslationProductCategory, ids)
+	if err != nil {
+		return err
+	}
+	for _, c := range categories {
+		localize(names, c.ID, &c.Name)
+	}
+	return nil
+}
+
+func (s *service) localizeProductTypes(ctx context.Context, types []*prodEntity.ProductType) error {
+	ids := make([]int64, len(types))
+	for i, t := range types {
+		ids[i] = t.ID
+	}
+	names, err := s.localizedNames(ctx, prodEntity.TranslationProductType, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range types {
+		localize(names, t.ID, &t.Name)
+	}
+	return nil
+}
+
+func (s *service) localizeUnitOfMeasures(ctx context.Context, uoms []*prodEntity.UnitOfMeasure) error {
+	ids := make([]int64, len(uoms))
+	for i, u := range uoms {
+		ids[i] = u.ID
+	}
+	names, err := s.localizedNames(ctx, prodEntity.TranslationUnitOfMeasure, ids)
+	if err != nil {
+		return err
+	}
+	for _, u := range uoms {
+		localize(names, u.ID, &u.Name)
+	}
+	return nil
+}
+
+// localizeProducts sets the names of the product type, category and unit of measure of the products
+// in the locale of the caller.
+func (s *service) localizeProducts(ctx context.Context, products ...*prodEntity.Product) error {
+	if middleware.LocaleFromContext(ctx) == middleware.DefaultLocale {
+		return nil
+	}
+	typeIds, categoryIds, uomIds := []int64{}, []int64{}, []int64{}
+	for _, p := range products {
+		if p != nil {
+			typeIds = append(typeIds, p.ProductTypeID)
+			categoryIds = append(categoryIds, p.ProductCategoryID)
+			uomIds = append(uomIds, p.UomID)
+		}
+	}
+
+	typeNames, err := s.localizedNames(ctx, prodEntity.TranslationProductType, typeIds)
+	if err != nil {
+		return err
+	}
+	categoryNames, err := s.localizedNames(ctx, prodEntity.TranslationProductCategory, categoryIds)
+	if err != nil {
+		return err
+	}
+	uomNames, err := s.localizedNames(ctx, prodEntity.TranslationUnitOfMeasure, uomIds)
+	if err != nil {
+		return err
+	}
+	for _, p := range products {
+		if p != nil {
+			localize(typeNames, p.ProductTypeID, &p.ProductTypeName)
+			localize(categoryNames, p.ProductCategoryID, &p.ProductCategoryName)
+			localize(uomNames, p.UomID, &p.Uom)
+		}
+	}
+	return nil
+}
+
+// getUnitOfMeasureByTranslation returns the unit whose name in the locale of the caller is name, so
+// products can be sent with the localised unit names returned by GetProductCategories.
+func (s *service) getUnitOfMeasureByTranslation(ctx context.Context, name string) (*prodEntity.UnitOfMeasure, error) {
+	locale := middleware.LocaleFromContext(ctx)
+	if locale == middleware.DefaultLocale {
+		return nil, nil
+	}
+	translation, err := s.productRepository.GetTranslationByName(ctx, prodEntity.TranslationUnitOfMeasure, locale, name)
+	if err != nil || translation == nil {
+		return nil, err
+	}
+	return s.productRepository.GetUnitOfMeasureById(ctx, translation.EntityID)
+}
+
+// checkTranslatedEntity makes sure the entity which names are translated exists.
+func (s *service) checkTranslatedEntity(ctx context.Context, entityType string, entityID int64) error {
+	exists := false
+	switch entityType {
+	case prodEntity.TranslationProductCategory:
+		category, err := s.productRepository.GetProductCategoryById(ctx, entityID)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_PRODUCT_CATEGORY, err)
+		}
+		exists = category != nil
+	case prodEntity.TranslationProductType:
+		types, err := s.productRepository.GetProductTypesByIds(ctx, []int64{entityID})
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_RELATED_PRODUCT_TYPE, err)
+		}
+		exists = len(types) > 0
+	case prodEntity.TranslationUnitOfMeasure:
+		uom, err := s.productRepository.GetUnitOfMeasureById(ctx, entityID)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+		}
+		exists = uom != nil
+	default:
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_TRANSLATION_ENTITY_TYPE_IS_NOT_SUPPORTED, entityType)
+	}
+	if !exists {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_TRANSLATION_ENTITY_NOT_FOUND, entityType, entityID)
+	}
+	return nil
+}
+
+func (s *service) GetTranslations(ctx context.Context, entityType string, entityID int64) ([]*prodEntity.Translation, error) {
+	if err := s.checkTranslatedEntity(ctx, entityType, entityID); err != nil {
+		return nil, err
+	}
+	translations, err := s.productRepository.GetTranslations(ctx, entityType, entityID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION, err)
+	}
+	return translations, nil
+}
+
+// UpsertTranslations sets the names of an entity in the given locales, the translations of other
+// locales are kept. It returns the translations of the entity in every locale.
+func (s *service) UpsertTranslations(ctx context.Context, entityType string, entityID int64, translations []*prodEntity.Translation) ([]*prodEntity.Translation, error) {
+	// a locale sent twice takes the last name, a locale can only be upserted once per statement
+	byLocale := make(map[string]*prodEntity.Translation)
+	locales := []string{}
+	for _, t := range translations {
+		if !middleware.IsSupportedLocale(t.Locale) {
+			return nil, storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_LOCALE_IS_NOT_SUPPORTED, t.Locale)
+		}
+		t.EntityType = entityType
+		t.EntityID = entityID
+		if byLocale[t.Locale] == nil {
+			locales = append(locales, t.Locale)
+		}
+		byLocale[t.Locale] = t
+	}
+	unique := make([]*prodEntity.Translation, len(locales))
+	for i, locale := range locales {
+		unique[i] = byLocale[locale]
+	}
+	before, err := s.GetTranslations(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var after []*prodEntity.Translation
+	err = s.transaction.Do(ctx, func(ctx context.Context) error {
+		if err := s.productRepository.UpsertTranslations(ctx, unique); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION, err)
+		}
+		stored, err := s.productRepository.GetTranslations(ctx, entityType, entityID)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_TRANSLATION, err)
+		}
+		after = stored
+		return s.recordAudit(ctx, auditEntity.ActionUpdate, entityType, strconv.FormatInt(entityID, 10),
+			prodEntity.TranslationsProto(entityType, entityID, before), prodEntity.TranslationsProto(entityType, entityID, after))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	prodEntity "github.com/Mitra-Apps/be-store-service/domain/product/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"google.golang.org/grpc/codes"
+)
+
+// resolveProductUoms links the products to their active unit of measure and converts their stock to
+// the base unit. Products sent with only a uom name are looked up by the name or the symbol of the unit,
+// or by its name in the locale of the caller.
+func (s *service) resolveProductUoms(ctx context.Context, products []*prodEntity.Product) error {
+	idsByName := make(map[string]int64)
+	uomIds := []int64{}
+	uomIdsMap := make(map[int64]bool)
+	for _, p := range products {
+		if p.UomID == 0 {
+			name := strings.ToLower(p.Uom)
+			if _, ok := idsByName[name]; !ok {
+				uom, err := s.getUnitOfMeasureByNameOrSymbol(ctx, p.Uom)
+				if err != nil {
+					return err
+				}
+				idsByName[name] = uom.ID
+			}
+			p.UomID = idsByName[name]
+		}
+		if !uomIdsMap[p.UomID] {
+			uomIds = append(uomIds, p.UomID)
+			uomIdsMap[p.UomID] = true
+		}
+	}
+
+	uoms, err := s.productRepository.GetUnitOfMeasuresByIds(ctx, uomIds)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	uomsById := make(map[int64]*prodEntity.UnitOfMeasure)
+	for _, u := range uoms {
+		uomsById[u.ID] = u
+	}
+	for _, p := range products {
+		uom := uomsById[p.UomID]
+		if uom == nil || !uom.IsActive {
+			return storeerror.New(codes.NotFound, errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND, p.UomID)
+		}
+		p.Uom = uom.Name
+		p.BaseStock = uom.ToBase(p.Stock)
+	}
+	return nil
+}
+
+func (s *service) getUnitOfMeasureByNameOrSymbol(ctx context.Context, value string) (*prodEntity.UnitOfMeasure, error) {
+	uom, err := s.productRepository.GetUnitOfMeasureByName(ctx, value)
+	if err == nil && uom == nil {
+		uom, err = s.productRepository.GetUnitOfMeasureBySymbol(ctx, value)
+	}
+	if err == nil && uom == nil {
+		uom, err = s.getUnitOfMeasureByTranslation(ctx, value)
+	}
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	if uom == nil {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_UOM_ID_IS_NOT_FOUND, value)
+	}
+	return uom, nil
+}
+
+// validateUomConversion checks that a unit is either a base unit with a conversion factor of 1 or is
+// converted to a base unit with a positive factor, units are never converted in chains.
+func (s *service) validateUomConversion(ctx context.Context, uom *prodEntity.UnitOfMeasure) error {
+	if uom.IsBaseUnit() {
+		if uom.ConversionFactor == 0 {
+			uom.ConversionFactor = 1
+		}
+		if uom.ConversionFactor != 1 {
+			return storeerror.Invalid("uom.conversion_factor", errPb.StoreErrorCode_INVALID_UOM_CONVERSION)
+		}
+		return nil
+	}
+
+	if uom.ConversionFactor <= 0 {
+		return storeerror.Invalid("uom.conversion_factor", errPb.StoreErrorCode_UOM_CONVERSION_FACTOR_SHOULD_BE_POSITIVE)
+	}
+	if *uom.BaseUnitID == uom.ID {
+		return storeerror.Invalid("uom.base_unit_id", errPb.StoreErrorCode_UOM_CAN_NOT_BE_ITS_OWN_BASE)
+	}
+	baseUnit, err := s.productRepository.GetUnitOfMeasureById(ctx, *uom.BaseUnitID)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	if baseUnit == nil {
+		return storeerror.New(codes.NotFound, errPb.StoreErrorCode_BASE_UOM_IS_NOT_FOUND)
+	}
+	if !baseUnit.IsBaseUnit() {
+		return storeerror.Invalid("uom.base_unit_id", errPb.StoreErrorCode_BASE_UOM_CAN_NOT_HAVE_BASE_UOM)
+	}
+
+	if uom.ID == 0 {
+		return nil
+	}
+	uoms, err := s.productRepository.GetUnitOfMeasures(ctx, true)
+	if err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_GETTING_UOM, err)
+	}
+	for _, u := range uoms {
+		if u.BaseUnitID != nil && *u.BaseUnitID == uom.ID {
+			return storeerror.Invalid("uom.base_unit_id", errPb.StoreErrorCode_UOM_IS_USED_AS_BASE_UOM, u.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	watchBatchSize = 100
+	// watchPollInterval bounds the delay of an event whose notification was missed
+	watchPollInterval = 30 * time.Second
+)
+
+// WatchStoreEvents sends the events of the given types of a store until ctx is done or send fails.
+// It resumes after afterSequence, 0 sends only the events committed from now on. The events are read
+// from the outbox, the notifier only wakes the watcher when new events may be available.
+func (s *service) WatchStoreEvents(ctx context.Context, storeID uuid.UUID, eventTypes []string, afterSequence int64, send func(*outboxEntity.OutboxEvent) error) error {
+	// the events of a store are only streamed to its owner
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return err
+	}
+
+	// subscribe before reading the outbox, so no event committed meanwhile is missed
+	wake, unsubscribe := s.notifier.Subscribe(storeID)
+	defer unsubscribe()
+
+	if afterSequence == 0 {
+		var err error
+		if afterSequence, err = s.outboxRepository.GetLastStoreOutboxSequence(ctx, storeID); err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_WATCHING_STORE, err)
+		}
+	} else {
+		oldest, err := s.outboxRepository.GetOldestOutboxSequence(ctx)
+		if err != nil {
+			return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_WATCHING_STORE, err)
+		}
+		if oldest > afterSequence+1 {
+			return storeerror.New(codes.OutOfRange, errPb.StoreErrorCode_WATCH_RESUME_SEQUENCE_EXPIRED)
+		}
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			events, err := s.outboxRepository.ListStoreOutboxEvents(ctx, storeID, eventTypes, afterSequence, watchBatchSize)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_WATCHING_STORE, err)
+			}
+			for _, event := range events {
+				if err := send(event); err != nil {
+					return err
+				}
+				afterSequence = event.Sequence
+			}
+			if len(events) < watchBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	outboxEntity "github.com/Mitra-Apps/be-store-service/domain/outbox/entity"
+	errPb "github.com/Mitra-Apps/be-store-service/domain/proto"
+	"github.com/Mitra-Apps/be-store-service/domain/storeerror"
+	webhookDispatcher "github.com/Mitra-Apps/be-store-service/domain/webhook/dispatcher"
+	webhookEntity "github.com/Mitra-Apps/be-store-service/domain/webhook/entity"
+	"github.com/Mitra-Apps/be-store-service/handler/grpc/middleware"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// authorizeStoreOwner returns the claims of the caller when they own the store or are an admin.
+func (s *service) authorizeStoreOwner(ctx context.Context, storeID uuid.UUID) (*middleware.JwtClaims, error) {
+	claims, err := middleware.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, storeerror.New(codes.Unauthenticated, errPb.StoreErrorCode_ERROR_WHEN_GETTING_CLAIMS_FROM_JWT_TOKEN)
+	}
+
+	store, err := s.storeRepository.GetStore(ctx, storeID.String())
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_STORE_NOT_FOUND)
+	}
+	if store.UserID != claims.UserID && !claims.IsAdmin {
+		return nil, storeerror.New(codes.PermissionDenied, errPb.StoreErrorCode_DONT_HAVE_PERMISSION_TO_CREATE_OR_UPDATE_STORE)
+	}
+	return claims, nil
+}
+
+// getStoreWebhook returns the subscription of the store, a subscription of another store is reported
+// as not found.
+func (s *service) getStoreWebhook(ctx context.Context, storeID, id uuid.UUID) (*webhookEntity.WebhookSubscription, error) {
+	sub, err := s.webhookRepository.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	if sub == nil || sub.StoreID != storeID {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_WEBHOOK_SUBSCRIPTION_NOT_FOUND)
+	}
+	return sub, nil
+}
+
+func validateWebhook(sub *webhookEntity.WebhookSubscription) error {
+	// webhooks can not be used to reach the internal network of the service
+	if err := webhookDispatcher.ValidateURL(sub.URL); err != nil {
+		return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_INVALID_WEBHOOK_URL)
+	}
+	for _, t := range sub.EventTypes {
+		if !outboxEntity.IsEventType(t) {
+			return storeerror.New(codes.InvalidArgument, errPb.StoreErrorCode_INVALID_WEBHOOK_EVENT_TYPE, t)
+		}
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// CreateWebhookSubscription registers a webhook for a store, the returned subscription is the only one
+// holding the secret.
+func (s *service) CreateWebhookSubscription(ctx context.Context, sub *webhookEntity.WebhookSubscription) (*webhookEntity.WebhookSubscription, error) {
+	claims, err := s.authorizeStoreOwner(ctx, sub.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWebhook(sub); err != nil {
+		return nil, err
+	}
+
+	if sub.Secret == "" {
+		if sub.Secret, err = generateWebhookSecret(); err != nil {
+			return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+		}
+	}
+	sub.IsActive = true
+	sub.CreatedBy = claims.UserID
+
+	if err := s.webhookRepository.CreateWebhookSubscription(ctx, sub); err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return sub, nil
+}
+
+func (s *service) ListWebhookSubscriptions(ctx context.Context, storeID uuid.UUID) ([]*webhookEntity.WebhookSubscription, error) {
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return nil, err
+	}
+
+	subs, err := s.webhookRepository.ListWebhookSubscriptions(ctx, storeID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return subs, nil
+}
+
+// UpdateWebhookSubscription replaces the url, event types and state of a webhook. Enabling a disabled
+// webhook clears its failures, its pending deliveries are then delivered again.
+func (s *service) UpdateWebhookSubscription(ctx context.Context, update *webhookEntity.WebhookSubscription) (*webhookEntity.WebhookSubscription, error) {
+	claims, err := s.authorizeStoreOwner(ctx, update.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWebhook(update); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.getStoreWebhook(ctx, update.StoreID, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.IsActive && !sub.IsActive {
+		sub.ConsecutiveFailures = 0
+		sub.DisabledAt = nil
+		sub.DisabledReason = ""
+	}
+	sub.URL = update.URL
+	sub.EventTypes = update.EventTypes
+	sub.IsActive = update.IsActive
+	sub.UpdatedBy = claims.UserID
+
+	if err := s.webhookRepository.UpdateWebhookSubscription(ctx, sub); err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return sub, nil
+}
+
+func (s *service) DeleteWebhookSubscription(ctx context.Context, storeID, id uuid.UUID) error {
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return err
+	}
+	if _, err := s.getStoreWebhook(ctx, storeID, id); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepository.DeleteWebhookSubscription(ctx, id); err != nil {
+		return storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, the latest delivery first.
+func (s *service) ListWebhookDeliveries(ctx context.Context, storeID, subscriptionID uuid.UUID, page, limit int) ([]*webhookEntity.WebhookDelivery, error) {
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return nil, err
+	}
+	if _, err := s.getStoreWebhook(ctx, storeID, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	deliveries, err := s.webhookRepository.ListWebhookDeliveries(ctx, subscriptionID, page, limit)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return deliveries, nil
+}
+
+// ReplayWebhookDelivery queues a delivery to be sent again right away with a fresh set of attempts.
+func (s *service) ReplayWebhookDelivery(ctx context.Context, storeID, subscriptionID, deliveryID uuid.UUID) (*webhookEntity.WebhookDelivery, error) {
+	if _, err := s.authorizeStoreOwner(ctx, storeID); err != nil {
+		return nil, err
+	}
+	sub, err := s.getStoreWebhook(ctx, storeID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !sub.IsActive {
+		return nil, storeerror.New(codes.FailedPrecondition, errPb.StoreErrorCode_WEBHOOK_SUBSCRIPTION_IS_DISABLED)
+	}
+
+	delivery, err := s.webhookRepository.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	if delivery == nil || delivery.SubscriptionID != subscriptionID {
+		return nil, storeerror.New(codes.NotFound, errPb.StoreErrorCode_WEBHOOK_DELIVERY_NOT_FOUND)
+	}
+
+	delivery.Status = webhookEntity.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.LastError = ""
+	if err := s.webhookRepository.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, storeerror.New(codes.Internal, errPb.StoreErrorCode_ERROR_WHEN_SAVING_WEBHOOK_SUBSCRIPTION, err)
+	}
+	return delivery, nil
+}